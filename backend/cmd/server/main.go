@@ -71,6 +71,7 @@ func main() {
 	pRepo := infra.NewProjectRepository(queries)
 	rmRepo := infra.NewRoadmapItemRepository(queries)
 	cRepo := infra.NewContractRepository(queries)
+	cRevRepo := infra.NewContractRevisionRepository(dbConn)
 	sRepo := infra.NewSnapshotRepository(queries)
 	propRepo := infra.NewAiProposalRepository(queries)
 	auditRepo := infra.NewAuditLogRepository(queries)
@@ -87,6 +88,19 @@ func main() {
 	// Intelligence Alignment Repos (using sql.DB for now)
 	alignmentRepo := infra.NewAlignmentRepository(dbConn)
 	depRepo := infra.NewRoadmapDependencyRepository(dbConn)
+	specDriftRepo := infra.NewSpecDriftCheckRepository(dbConn)
+	driftPolicyRepo := infra.NewDriftPolicyRepository(dbConn)
+	govPolicyRepo := infra.NewGovernancePolicyRepository(dbConn)
+	govWaiverRepo := infra.NewGovernanceWaiverRepository(dbConn)
+	lintRulesetRepo := infra.NewSchemaLintRulesetRepository(dbConn)
+	rmTransitionRepo := infra.NewRoadmapStatusTransitionRepository(dbConn)
+	propReviewRepo := infra.NewProposalReviewRepository(dbConn)
+	trafficDriftRepo := infra.NewTrafficDriftCheckRepository(dbConn)
+	driftMonitorRepo := infra.NewDriftMonitorRepository(dbConn)
+
+	// Multi-entity writes (proposal approval, bootstrap ingestion, status
+	// transitions) commit or roll back together.
+	uow := infra.NewUnitOfWork(dbConn)
 
 	diffEngine := drift.NewDiffEngine()
 
@@ -94,8 +108,14 @@ func main() {
 	authService := app.NewAuthService(userRepo, string(authCfg.SigningKey), authCfg.Issuer, authCfg.Audience)
 	auditService := app.NewAuditLogService(auditRepo)
 
+	// LLM & Refinement
+	llmFactory := infra.NewLLMFactory()
+	llmService := app.NewLLMService(llmRepo, llmFactory)
+
+	propService := app.NewAiProposalService(propRepo, propReviewRepo, rmRepo, govPolicyRepo, uow, auditService)
+
 	// Drift
-	driftService := drift.NewDriftService(cRepo, sRepo, diffEngine, auditService)
+	driftService := drift.NewDriftService(cRepo, rmRepo, sRepo, specDriftRepo, driftPolicyRepo, trafficDriftRepo, driftMonitorRepo, llmService, propService, diffEngine, auditService)
 
 	// Notifications
 	notifyService := app.NewNotificationService()
@@ -104,24 +124,25 @@ func main() {
 	fiService := app.NewFeatureIntelligenceService(fiRepo, rmRepo, cRepo, varRepo, reqRepo, driftService, notifyService)
 	vlService := app.NewVariableLineageService(vlRepo)
 
+	// Scheduled drift monitoring
+	monitorCtx, stopMonitor := context.WithCancel(context.Background())
+	defer stopMonitor()
+	go drift.NewMonitor(driftService, driftMonitorRepo, fiService, notifyService).Start(monitorCtx)
+
 	// NEW: Alignment & Dependency Services
 	alignmentService := app.NewAlignmentService(alignmentRepo, rmRepo, depRepo, cRepo, varRepo, valRepo)
 	depService := app.NewRoadmapDependencyService(depRepo, rmRepo, auditService)
 
-	govService := app.NewGovernanceService(fiService, propRepo, varRepo, cRepo)
-
-	// LLM & Refinement
-	llmFactory := infra.NewLLMFactory()
-	llmService := app.NewLLMService(llmRepo, llmFactory)
+	govService := app.NewGovernanceService(fiService, propRepo, varRepo, cRepo, rmRepo, reqRepo, govPolicyRepo, govWaiverRepo, alignmentService, driftService, auditService)
 
 	wsService := app.NewWorkspaceService(wsRepo, auditService)
 	pService := app.NewProjectService(pRepo, auditService, llmService)
-	rmService := app.NewRoadmapItemService(depRepo, rmRepo, auditService, fiService, govService, alignmentService)
-	cService := app.NewContractService(cRepo, rmRepo, fiService, govService, alignmentService)
+	rmService := app.NewRoadmapItemService(depRepo, rmRepo, rmTransitionRepo, uow, auditService, fiService, govService, alignmentService)
+	cService := app.NewContractService(cRepo, cRevRepo, rmRepo, fiService, govService, alignmentService, lintRulesetRepo, uow)
 	sService := app.NewSnapshotService(sRepo)
-	propService := app.NewAiProposalService(propRepo, rmRepo, sRepo, varRepo, cRepo, auditService)
 	reqService := app.NewRequirementService(reqRepo, auditService)
 	varService := app.NewVariableService(varRepo, cRepo, rmRepo, auditService, fiService, alignmentService)
+	oasImportService := app.NewOpenAPIImportService(rmService, cService, varService)
 	whService := app.NewWebhookService(whRepo, auditService)
 	valService := app.NewValidationRuleService(valRepo, auditService)
 
@@ -129,7 +150,7 @@ func main() {
 
 	// Bootstrap Intelligence
 	bootstrapRepo := infra.NewBootstrapRepository(queries)
-	bootstrapService := app.NewBootstrapService(bootstrapRepo, pRepo, sessionRepo)
+	bootstrapService := app.NewBootstrapService(bootstrapRepo, pRepo, sessionRepo, uow)
 
 	// Build Artifact Export
 	artifactExporter := infra.NewArtifactExporter()
@@ -147,7 +168,7 @@ func main() {
 	// MCP Server Integration
 	mcpRepo := infra.NewMCPRepository(dbConn)
 	importService := app.NewImportService(pRepo, mcpRepo, alignmentService, app.NewDiffService(), bootstrapRepo, sessionRepo)
-	mcpHandlers := mcp.NewHandlers(mcpRepo, importService)
+	mcpHandlers := mcp.NewHandlers(mcpRepo, importService, driftService, alignmentService)
 	mcpConfig := mcp.Config{
 		Port:         8081,
 		BindAddress:  "0.0.0.0",
@@ -170,6 +191,7 @@ func main() {
 	pHandler := api.NewProjectHandler(pService)
 	rmHandler := api.NewRoadmapItemHandler(rmService, artifactService, artifactExporter)
 	cHandler := api.NewContractHandler(cService)
+	oasImportHandler := api.NewOpenAPIImportHandler(oasImportService)
 	sHandler := api.NewSnapshotHandler(sService)
 	propHandler := api.NewAiProposalHandler(propService)
 	auditHandler := api.NewAuditLogHandler(auditService)
@@ -178,7 +200,10 @@ func main() {
 	whHandler := api.NewWebhookHandler(whService)
 	valHandler := api.NewValidationRuleHandler(valService)
 	driftHandler := api.NewDriftHandler(driftService)
+	specDriftHandler := api.NewSpecDriftHandler(driftService)
+	trafficDriftHandler := api.NewTrafficDriftHandler(driftService)
 	fiHandler := api.NewFeatureIntelligenceHandler(fiService)
+	govHandler := api.NewGovernanceHandler(govService)
 	vlHandler := api.NewVariableLineageHandler(vlService)
 	allowedOrigins := []string{"http://localhost:3000"}
 	webSocketHandler := api.NewWSHandler(notifyService, validator, allowedOrigins)
@@ -225,11 +250,16 @@ func main() {
 	protected.POST("/projects/:projectId/roadmap-items", rmHandler.CreateRoadmapItem, requireRole(domain.RoleOwner, domain.RoleAdmin, domain.RoleEngineer, domain.RoleReviewer))
 	protected.GET("/projects/:projectId/contracts", cHandler.ListContractsByProject)
 	protected.POST("/projects/:projectId/contracts", cHandler.CreateContractByProject, requireRole(domain.RoleOwner, domain.RoleAdmin, domain.RoleEngineer))
+	protected.GET("/projects/:projectId/contracts/lint-ruleset", cHandler.GetLintRuleset, requireRole(domain.RoleOwner, domain.RoleAdmin, domain.RoleEngineer, domain.RoleReviewer))
+	protected.PUT("/projects/:projectId/contracts/lint-ruleset", cHandler.UpdateLintRuleset, requireRole(domain.RoleOwner, domain.RoleAdmin))
+	protected.POST("/projects/:projectId/contracts/import/openapi", oasImportHandler.ImportOpenAPI, requireRole(domain.RoleOwner, domain.RoleAdmin, domain.RoleEngineer))
 	protected.GET("/projects/:projectId/variables", varHandler.ListVariablesByProject)
 	protected.POST("/projects/:projectId/variables", varHandler.CreateVariableByProject, requireRole(domain.RoleOwner, domain.RoleAdmin, domain.RoleEngineer))
 	protected.GET("/projects/:projectId/snapshots", sHandler.ListSnapshotsByProject)
 	protected.GET("/roadmap-items/:roadmapItemId", rmHandler.GetRoadmapItem)
 	protected.PATCH("/roadmap-items/:roadmapItemId", rmHandler.UpdateRoadmapItem, requireRole(domain.RoleOwner, domain.RoleAdmin, domain.RoleEngineer, domain.RoleReviewer))
+	protected.POST("/roadmap-items/:roadmapItemId/transitions", rmHandler.TransitionRoadmapItem, requireRole(domain.RoleOwner, domain.RoleAdmin, domain.RoleEngineer, domain.RoleReviewer))
+	protected.GET("/roadmap-items/:roadmapItemId/transitions", rmHandler.ListStatusTransitions)
 	protected.DELETE("/roadmap-items/:roadmapItemId", rmHandler.DeleteRoadmapItem, requireRole(domain.RoleOwner, domain.RoleAdmin, domain.RoleEngineer))
 	protected.GET("/roadmap-items/:roadmapItemId/export", rmHandler.ExportRoadmapItem)
 
@@ -244,12 +274,24 @@ func main() {
 	protected.GET("/ai-proposals/:proposalId", propHandler.GetProposal)
 	protected.POST("/ai-proposals/:proposalId/approve", propHandler.ApproveProposal, requireRole(domain.RoleOwner, domain.RoleAdmin, domain.RoleReviewer))
 	protected.POST("/ai-proposals/:proposalId/reject", propHandler.RejectProposal, requireRole(domain.RoleOwner, domain.RoleAdmin, domain.RoleReviewer))
+	protected.POST("/ai-proposals/:proposalId/request-changes", propHandler.RequestChanges, requireRole(domain.RoleOwner, domain.RoleAdmin, domain.RoleReviewer))
+	protected.POST("/ai-proposals/:proposalId/resubmit", propHandler.ResubmitProposal, requireRole(domain.RoleAIAgent, domain.RoleEngineer, domain.RoleOwner, domain.RoleAdmin))
+	protected.POST("/ai-proposals/:proposalId/rebase", propHandler.RebaseProposal, requireRole(domain.RoleAIAgent, domain.RoleEngineer, domain.RoleOwner, domain.RoleAdmin))
+	protected.GET("/ai-proposals/:proposalId/reviews", propHandler.GetReviewState)
+	protected.GET("/ai-proposals/:proposalId/preview", propHandler.PreviewProposal)
 
 	protected.GET("/roadmap-items/:roadmapItemId/contracts", cHandler.ListContracts)
 	protected.POST("/roadmap-items/:roadmapItemId/contracts", cHandler.CreateContract)
+	protected.POST("/roadmap-items/:roadmapItemId/contracts/lint", cHandler.LintContractDraft)
 	protected.GET("/contracts/:contractId", cHandler.GetContract)
 	protected.PATCH("/contracts/:contractId", cHandler.UpdateContract, requireRole(domain.RoleOwner, domain.RoleAdmin, domain.RoleEngineer))
 	protected.DELETE("/contracts/:contractId", cHandler.DeleteContract)
+	protected.GET("/contracts/:contractId/revisions", cHandler.ListRevisions)
+	protected.GET("/contracts/:contractId/revisions/diff", cHandler.DiffRevisions)
+	protected.GET("/contracts/:contractId/revisions/:revision", cHandler.GetRevision)
+	protected.POST("/contracts/:contractId/validate-payloads", cHandler.ValidatePayloads)
+	protected.GET("/contracts/:contractId/examples", cHandler.GenerateExamples)
+	protected.GET("/contracts/:contractId/lint", cHandler.LintContract)
 
 	protected.GET("/roadmap-items/:roadmapItemId/snapshots", sHandler.ListSnapshots)
 	protected.POST("/roadmap-items/:roadmapItemId/snapshots", sHandler.CreateSnapshot, requireRole(domain.RoleOwner, domain.RoleAdmin, domain.RoleEngineer, domain.RoleAIAgent))
@@ -268,6 +310,16 @@ func main() {
 	protected.DELETE("/variables/:variableId", varHandler.DeleteVariable, requireRole(domain.RoleOwner, domain.RoleAdmin))
 
 	protected.GET("/roadmap-items/:roadmapItemId/intelligence", fiHandler.GetFeatureIntelligence)
+	protected.GET("/roadmap-items/:roadmapItemId/governance", govHandler.EvaluateGovernance)
+	protected.GET("/projects/:projectId/governance/policy", govHandler.GetGovernancePolicy)
+	protected.PUT("/projects/:projectId/governance/policy", govHandler.UpdateGovernancePolicy, requireRole(domain.RoleOwner, domain.RoleAdmin))
+	protected.GET("/projects/:projectId/governance/policy/versions", govHandler.ListGovernancePolicyVersions)
+	protected.GET("/projects/:projectId/governance/waivers", govHandler.ListWaivers)
+	protected.POST("/roadmap-items/:roadmapItemId/governance/waivers", govHandler.RequestWaiver, requireRole(domain.RoleEngineer, domain.RoleOwner, domain.RoleAdmin))
+	protected.GET("/governance/waivers/:waiverId", govHandler.GetWaiver)
+	protected.POST("/governance/waivers/:waiverId/approve", govHandler.ApproveWaiver, requireRole(domain.RoleOwner, domain.RoleAdmin))
+	protected.POST("/governance/waivers/:waiverId/reject", govHandler.RejectWaiver, requireRole(domain.RoleOwner, domain.RoleAdmin))
+	protected.POST("/governance/waivers/:waiverId/revoke", govHandler.RevokeWaiver, requireRole(domain.RoleOwner, domain.RoleAdmin))
 	protected.GET("/variables/:variableId/events", vlHandler.GetLineageEvents)
 	protected.GET("/variables/:variableId/lineage", vlHandler.GetLineageGraph)
 
@@ -279,6 +331,7 @@ func main() {
 
 	protected.GET("/projects/:projectId/validation-rules", valHandler.ListValidationRules)
 	protected.POST("/projects/:projectId/validation-rules", valHandler.CreateValidationRule, requireRole(domain.RoleOwner, domain.RoleAdmin))
+	protected.POST("/projects/:projectId/validation-rules/evaluate", valHandler.EvaluateValidationRules)
 	protected.GET("/validation-rules/:ruleId", valHandler.GetValidationRule)
 	protected.PATCH("/validation-rules/:ruleId", valHandler.UpdateValidationRule, requireRole(domain.RoleOwner, domain.RoleAdmin))
 	protected.DELETE("/validation-rules/:ruleId", valHandler.DeleteValidationRule, requireRole(domain.RoleOwner))
@@ -302,6 +355,17 @@ func main() {
 	protected.POST("/contracts/:contractId/drift-check", driftHandler.RunDriftCheck, requireRole(domain.RoleOwner, domain.RoleAdmin, domain.RoleEngineer, domain.RoleReviewer))
 	protected.GET("/drift/history", driftHandler.GetDriftHistory, requireRole(domain.RoleOwner, domain.RoleAdmin, domain.RoleEngineer, domain.RoleReviewer))
 	protected.POST("/drift/generate-fixes", driftHandler.GenerateDriftFixes, requireRole(domain.RoleOwner, domain.RoleAdmin, domain.RoleEngineer, domain.RoleReviewer))
+	protected.POST("/projects/:projectId/drift/spec-checks", specDriftHandler.RunSpecDriftCheck, requireRole(domain.RoleOwner, domain.RoleAdmin, domain.RoleEngineer, domain.RoleReviewer))
+	protected.GET("/projects/:projectId/drift/spec-checks", specDriftHandler.ListSpecDriftChecks, requireRole(domain.RoleOwner, domain.RoleAdmin, domain.RoleEngineer, domain.RoleReviewer))
+	protected.GET("/drift/spec-checks/:checkId", specDriftHandler.GetSpecDriftCheck, requireRole(domain.RoleOwner, domain.RoleAdmin, domain.RoleEngineer, domain.RoleReviewer))
+	protected.GET("/projects/:projectId/drift/policy", specDriftHandler.GetDriftPolicy, requireRole(domain.RoleOwner, domain.RoleAdmin, domain.RoleEngineer, domain.RoleReviewer))
+	protected.PUT("/projects/:projectId/drift/policy", specDriftHandler.UpdateDriftPolicy, requireRole(domain.RoleOwner, domain.RoleAdmin))
+	protected.POST("/projects/:projectId/drift/traffic-checks", trafficDriftHandler.RunTrafficDriftCheck, requireRole(domain.RoleOwner, domain.RoleAdmin, domain.RoleEngineer, domain.RoleReviewer))
+	protected.GET("/projects/:projectId/drift/traffic-checks", trafficDriftHandler.ListTrafficDriftChecks, requireRole(domain.RoleOwner, domain.RoleAdmin, domain.RoleEngineer, domain.RoleReviewer))
+	protected.GET("/drift/traffic-checks/:checkId", trafficDriftHandler.GetTrafficDriftCheck, requireRole(domain.RoleOwner, domain.RoleAdmin, domain.RoleEngineer, domain.RoleReviewer))
+	protected.GET("/projects/:projectId/drift/monitor", driftHandler.GetDriftMonitor, requireRole(domain.RoleOwner, domain.RoleAdmin, domain.RoleEngineer, domain.RoleReviewer))
+	protected.PUT("/projects/:projectId/drift/monitor", driftHandler.UpdateDriftMonitor, requireRole(domain.RoleOwner, domain.RoleAdmin))
+	protected.GET("/projects/:projectId/drift/timeline", driftHandler.GetDriftTimeline, requireRole(domain.RoleOwner, domain.RoleAdmin, domain.RoleEngineer, domain.RoleReviewer))
 
 	protected.GET("/roadmap-items/:roadmapItemId/activity", auditHandler.GetRoadmapItemActivity)
 
@@ -354,6 +418,7 @@ func main() {
 	quit := make(chan os.Signal, 1)
 	signal.Notify(quit, os.Interrupt)
 	<-quit
+	stopMonitor()
 	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
 	defer cancel()
 	if err := e.Shutdown(ctx); err != nil {
@@ -4,6 +4,7 @@ import (
 	"net/http"
 
 	"github.com/SpecForgeVC/SpecForge/internal/app"
+	"github.com/SpecForgeVC/SpecForge/internal/cireport"
 	"github.com/google/uuid"
 	"github.com/labstack/echo/v4"
 )
@@ -22,6 +23,11 @@ func (h *AlignmentHandler) GetAlignmentReport(c echo.Context) error {
 		return echo.NewHTTPError(http.StatusBadRequest, "Invalid project ID")
 	}
 
+	format, download, err := ciReportFormat(c)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+	}
+
 	report, err := h.service.GetAlignmentReport(c.Request().Context(), projectID)
 	if err != nil {
 		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
@@ -31,6 +37,10 @@ func (h *AlignmentHandler) GetAlignmentReport(c echo.Context) error {
 		return c.JSON(http.StatusNotFound, map[string]string{"message": "No alignment report found"})
 	}
 
+	if download {
+		artifact := ciReportArtifact(c, "projects/"+projectID.String()+"/alignment")
+		return sendCIReport(c, format, "alignment-"+projectID.String(), cireport.FromAlignmentReport("alignment "+projectID.String(), artifact, *report))
+	}
 	return c.JSON(http.StatusOK, report)
 }
 
@@ -0,0 +1,38 @@
+package api
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/SpecForgeVC/SpecForge/internal/cireport"
+	"github.com/labstack/echo/v4"
+)
+
+// ciReportFormat reads ?format=sarif|junit. ok is false when the caller asked
+// for the default JSON response.
+func ciReportFormat(c echo.Context) (format cireport.Format, ok bool, err error) {
+	v := c.QueryParam("format")
+	if v == "" || v == "json" {
+		return "", false, nil
+	}
+	format, err = cireport.ParseFormat(v)
+	return format, err == nil, err
+}
+
+// ciReportArtifact returns the ?artifact= URI findings are attributed to, or def.
+func ciReportArtifact(c echo.Context, def string) string {
+	if v := c.QueryParam("artifact"); v != "" {
+		return v
+	}
+	return def
+}
+
+// sendCIReport responds with the report as a SARIF or JUnit XML download.
+func sendCIReport(c echo.Context, format cireport.Format, fileBase string, r cireport.Report) error {
+	data, contentType, err := cireport.Render(format, r)
+	if err != nil {
+		return ErrorResponse(c, http.StatusInternalServerError, "INTERNAL_ERROR", "failed to render report", err.Error())
+	}
+	c.Response().Header().Set(echo.HeaderContentDisposition, fmt.Sprintf("attachment; filename=%q", cireport.FileName(fileBase, format)))
+	return c.Blob(http.StatusOK, contentType, data)
+}
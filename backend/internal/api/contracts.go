@@ -1,10 +1,16 @@
 package api
 
 import (
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
 	"net/http"
+	"strconv"
 
 	"github.com/SpecForgeVC/SpecForge/internal/app"
 	"github.com/SpecForgeVC/SpecForge/internal/domain"
+	"github.com/SpecForgeVC/SpecForge/internal/schemalint"
 	"github.com/google/uuid"
 	"github.com/labstack/echo/v4"
 )
@@ -61,6 +67,15 @@ type contractCreateRequest struct {
 	ErrorSchema  map[string]interface{} `json:"error_schema"`
 }
 
+type contractUpdateRequest struct {
+	ContractType     domain.ContractType    `json:"contract_type"`
+	Version          string                 `json:"version"`
+	InputSchema      map[string]interface{} `json:"input_schema"`
+	OutputSchema     map[string]interface{} `json:"output_schema"`
+	ErrorSchema      map[string]interface{} `json:"error_schema"`
+	DeprecatedFields []string               `json:"deprecated_fields"`
+}
+
 func (h *ContractHandler) CreateContract(c echo.Context) error {
 	roadmapItemID, err := uuid.Parse(c.Param("roadmapItemId"))
 	if err != nil {
@@ -70,8 +85,18 @@ func (h *ContractHandler) CreateContract(c echo.Context) error {
 	if err := c.Bind(req); err != nil {
 		return ErrorResponse(c, http.StatusBadRequest, "INVALID_BODY", "failed to bind request", err.Error())
 	}
-	contract, err := h.service.CreateContract(c.Request().Context(), roadmapItemID, req.ContractType, req.Version, req.InputSchema, req.OutputSchema, req.ErrorSchema)
+	contract, err := h.service.CreateContract(c.Request().Context(), roadmapItemID, req.ContractType, req.Version, req.InputSchema, req.OutputSchema, req.ErrorSchema, GetUserID(c))
 	if err != nil {
+		var lintErr *app.SchemaLintError
+		if errors.As(err, &lintErr) {
+			return schemaLintResponse(c, lintErr)
+		}
+		if errors.Is(err, app.ErrInvalidContractVersion) {
+			return ErrorResponse(c, http.StatusBadRequest, "INVALID_VERSION", "invalid contract version", err.Error())
+		}
+		if errors.Is(err, app.ErrInvalidContractSchema) {
+			return ErrorResponse(c, http.StatusBadRequest, "INVALID_SCHEMA", "invalid contract schema", err.Error())
+		}
 		return ErrorResponse(c, http.StatusInternalServerError, "INTERNAL_ERROR", "failed to create contract", err.Error())
 	}
 	return SuccessResponse(c, http.StatusCreated, contract)
@@ -96,8 +121,18 @@ func (h *ContractHandler) CreateContractByProject(c echo.Context) error {
 	if req.RoadmapItemID == uuid.Nil {
 		return ErrorResponse(c, http.StatusBadRequest, "MISSING_FIELD", "roadmap_item_id is required", "")
 	}
-	contract, err := h.service.CreateContract(c.Request().Context(), req.RoadmapItemID, req.ContractType, req.Version, req.InputSchema, req.OutputSchema, req.ErrorSchema)
+	contract, err := h.service.CreateContract(c.Request().Context(), req.RoadmapItemID, req.ContractType, req.Version, req.InputSchema, req.OutputSchema, req.ErrorSchema, GetUserID(c))
 	if err != nil {
+		var lintErr *app.SchemaLintError
+		if errors.As(err, &lintErr) {
+			return schemaLintResponse(c, lintErr)
+		}
+		if errors.Is(err, app.ErrInvalidContractVersion) {
+			return ErrorResponse(c, http.StatusBadRequest, "INVALID_VERSION", "invalid contract version", err.Error())
+		}
+		if errors.Is(err, app.ErrInvalidContractSchema) {
+			return ErrorResponse(c, http.StatusBadRequest, "INVALID_SCHEMA", "invalid contract schema", err.Error())
+		}
 		return ErrorResponse(c, http.StatusInternalServerError, "INTERNAL_ERROR", "failed to create contract", err.Error())
 	}
 	return SuccessResponse(c, http.StatusCreated, contract)
@@ -108,12 +143,30 @@ func (h *ContractHandler) UpdateContract(c echo.Context) error {
 	if err != nil {
 		return ErrorResponse(c, http.StatusBadRequest, "INVALID_ID", "invalid contract id", err.Error())
 	}
-	req := new(contractCreateRequest) // Reuse create request struct as fields are same
+	req := new(contractUpdateRequest)
 	if err := c.Bind(req); err != nil {
 		return ErrorResponse(c, http.StatusBadRequest, "INVALID_BODY", "failed to bind request", err.Error())
 	}
-	contract, err := h.service.UpdateContract(c.Request().Context(), id, req.ContractType, req.Version, req.InputSchema, req.OutputSchema, req.ErrorSchema)
+	contract, err := h.service.UpdateContract(c.Request().Context(), id, req.ContractType, req.Version, req.InputSchema, req.OutputSchema, req.ErrorSchema, req.DeprecatedFields, GetUserID(c))
 	if err != nil {
+		var bumpErr *app.VersionBumpError
+		if errors.As(err, &bumpErr) {
+			return c.JSON(http.StatusUnprocessableEntity, Response{
+				Success: false,
+				Error:   &Error{Code: "VERSION_BUMP_REQUIRED", Message: "version change does not match detected drift", Details: err.Error()},
+				Meta:    bumpErr,
+			})
+		}
+		var lintErr *app.SchemaLintError
+		if errors.As(err, &lintErr) {
+			return schemaLintResponse(c, lintErr)
+		}
+		if errors.Is(err, app.ErrInvalidContractVersion) {
+			return ErrorResponse(c, http.StatusBadRequest, "INVALID_VERSION", "invalid contract version", err.Error())
+		}
+		if errors.Is(err, app.ErrInvalidContractSchema) {
+			return ErrorResponse(c, http.StatusBadRequest, "INVALID_SCHEMA", "invalid contract schema", err.Error())
+		}
 		return ErrorResponse(c, http.StatusInternalServerError, "INTERNAL_ERROR", "failed to update contract", err.Error())
 	}
 	return SuccessResponse(c, http.StatusOK, contract)
@@ -129,3 +182,218 @@ func (h *ContractHandler) DeleteContract(c echo.Context) error {
 	}
 	return c.NoContent(http.StatusNoContent)
 }
+
+func (h *ContractHandler) ListRevisions(c echo.Context) error {
+	id, err := uuid.Parse(c.Param("contractId"))
+	if err != nil {
+		return ErrorResponse(c, http.StatusBadRequest, "INVALID_ID", "invalid contract id", err.Error())
+	}
+	revisions, err := h.service.ListRevisions(c.Request().Context(), id)
+	if err != nil {
+		return ErrorResponse(c, http.StatusInternalServerError, "INTERNAL_ERROR", "failed to list contract revisions", err.Error())
+	}
+	return SuccessResponse(c, http.StatusOK, revisions)
+}
+
+func (h *ContractHandler) GetRevision(c echo.Context) error {
+	id, err := uuid.Parse(c.Param("contractId"))
+	if err != nil {
+		return ErrorResponse(c, http.StatusBadRequest, "INVALID_ID", "invalid contract id", err.Error())
+	}
+	revision, err := strconv.Atoi(c.Param("revision"))
+	if err != nil {
+		return ErrorResponse(c, http.StatusBadRequest, "INVALID_ID", "invalid revision number", err.Error())
+	}
+	rev, err := h.service.GetRevision(c.Request().Context(), id, revision)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return ErrorResponse(c, http.StatusNotFound, "NOT_FOUND", "contract revision not found", err.Error())
+		}
+		return ErrorResponse(c, http.StatusInternalServerError, "INTERNAL_ERROR", "failed to get contract revision", err.Error())
+	}
+	return SuccessResponse(c, http.StatusOK, rev)
+}
+
+// DiffRevisions compares two revisions given as ?from=N&to=M.
+func (h *ContractHandler) DiffRevisions(c echo.Context) error {
+	id, err := uuid.Parse(c.Param("contractId"))
+	if err != nil {
+		return ErrorResponse(c, http.StatusBadRequest, "INVALID_ID", "invalid contract id", err.Error())
+	}
+	from, err := strconv.Atoi(c.QueryParam("from"))
+	if err != nil {
+		return ErrorResponse(c, http.StatusBadRequest, "INVALID_PARAM", "from must be a revision number", err.Error())
+	}
+	to, err := strconv.Atoi(c.QueryParam("to"))
+	if err != nil {
+		return ErrorResponse(c, http.StatusBadRequest, "INVALID_PARAM", "to must be a revision number", err.Error())
+	}
+	diff, err := h.service.DiffRevisions(c.Request().Context(), id, from, to)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return ErrorResponse(c, http.StatusNotFound, "NOT_FOUND", "contract revision not found", err.Error())
+		}
+		return ErrorResponse(c, http.StatusInternalServerError, "INTERNAL_ERROR", "failed to diff contract revisions", err.Error())
+	}
+	return SuccessResponse(c, http.StatusOK, diff)
+}
+
+// payloadValidationRequest carries either a single payload or a batch. Version
+// selects a saved version of the contract; omitted, the current one is used.
+type payloadValidationRequest struct {
+	Kind     string            `json:"kind"`
+	Version  string            `json:"version"`
+	Payload  json.RawMessage   `json:"payload"`
+	Payloads []json.RawMessage `json:"payloads"`
+}
+
+// ValidatePayloads checks payloads against the contract's input, output or
+// error schema. Invalid payloads are reported with 200; the response is only
+// an error when the check itself cannot run.
+func (h *ContractHandler) ValidatePayloads(c echo.Context) error {
+	id, err := uuid.Parse(c.Param("contractId"))
+	if err != nil {
+		return ErrorResponse(c, http.StatusBadRequest, "INVALID_ID", "invalid contract id", err.Error())
+	}
+	req := new(payloadValidationRequest)
+	if err := c.Bind(req); err != nil {
+		return ErrorResponse(c, http.StatusBadRequest, "INVALID_BODY", "failed to bind request", err.Error())
+	}
+	kind, err := domain.ParsePayloadKind(req.Kind)
+	if err != nil {
+		return ErrorResponse(c, http.StatusBadRequest, "INVALID_PARAM", "invalid payload kind", err.Error())
+	}
+	raw := req.Payloads
+	if len(req.Payload) > 0 {
+		if len(raw) > 0 {
+			return ErrorResponse(c, http.StatusBadRequest, "INVALID_BODY", "send either payload or payloads", "")
+		}
+		raw = []json.RawMessage{req.Payload}
+	}
+	payloads := make([]interface{}, len(raw))
+	for i, r := range raw {
+		if err := json.Unmarshal(r, &payloads[i]); err != nil {
+			return ErrorResponse(c, http.StatusBadRequest, "INVALID_BODY", "payload is not valid JSON", err.Error())
+		}
+	}
+
+	report, err := h.service.ValidatePayloads(c.Request().Context(), id, req.Version, kind, payloads)
+	if err != nil {
+		switch {
+		case errors.Is(err, sql.ErrNoRows):
+			return ErrorResponse(c, http.StatusNotFound, "NOT_FOUND", "contract version not found", err.Error())
+		case errors.Is(err, app.ErrInvalidPayloadRequest):
+			return ErrorResponse(c, http.StatusBadRequest, "INVALID_BODY", "invalid payload validation request", err.Error())
+		case errors.Is(err, app.ErrNoPayloadSchema), errors.Is(err, app.ErrInvalidContractSchema):
+			return ErrorResponse(c, http.StatusUnprocessableEntity, "SCHEMA_UNAVAILABLE", "contract schema cannot validate payloads", err.Error())
+		}
+		return ErrorResponse(c, http.StatusInternalServerError, "INTERNAL_ERROR", "failed to validate payloads", err.Error())
+	}
+	return SuccessResponse(c, http.StatusOK, report)
+}
+
+// GenerateExamples returns valid and labelled invalid sample payloads for the
+// contract at ?version= (the current contract when omitted). With
+// ?download=true the samples are sent as a JSON file.
+func (h *ContractHandler) GenerateExamples(c echo.Context) error {
+	id, err := uuid.Parse(c.Param("contractId"))
+	if err != nil {
+		return ErrorResponse(c, http.StatusBadRequest, "INVALID_ID", "invalid contract id", err.Error())
+	}
+	examples, err := h.service.GenerateExamples(c.Request().Context(), id, c.QueryParam("version"))
+	if err != nil {
+		switch {
+		case errors.Is(err, sql.ErrNoRows):
+			return ErrorResponse(c, http.StatusNotFound, "NOT_FOUND", "contract version not found", err.Error())
+		case errors.Is(err, app.ErrInvalidContractSchema):
+			return ErrorResponse(c, http.StatusUnprocessableEntity, "SCHEMA_UNAVAILABLE", "contract schema cannot generate payloads", err.Error())
+		}
+		return ErrorResponse(c, http.StatusInternalServerError, "INTERNAL_ERROR", "failed to generate payload samples", err.Error())
+	}
+	if c.QueryParam("download") != "true" {
+		return SuccessResponse(c, http.StatusOK, examples)
+	}
+	data, err := json.MarshalIndent(examples, "", "  ")
+	if err != nil {
+		return ErrorResponse(c, http.StatusInternalServerError, "INTERNAL_ERROR", "failed to encode payload samples", err.Error())
+	}
+	c.Response().Header().Set(echo.HeaderContentDisposition, fmt.Sprintf("attachment; filename=%q", fmt.Sprintf("contract-%s-%s-samples.json", id, examples.Version)))
+	return c.Blob(http.StatusOK, echo.MIMEApplicationJSON, data)
+}
+
+// schemaLintResponse rejects a save with the lint report as metadata.
+func schemaLintResponse(c echo.Context, lintErr *app.SchemaLintError) error {
+	return c.JSON(http.StatusBadRequest, Response{
+		Success: false,
+		Error:   &Error{Code: "SCHEMA_LINT_FAILED", Message: "contract schemas have lint errors", Details: lintErr.Error()},
+		Meta:    lintErr.Report,
+	})
+}
+
+// LintContract lints the stored contract with its project's ruleset.
+func (h *ContractHandler) LintContract(c echo.Context) error {
+	id, err := uuid.Parse(c.Param("contractId"))
+	if err != nil {
+		return ErrorResponse(c, http.StatusBadRequest, "INVALID_ID", "invalid contract id", err.Error())
+	}
+	lint, err := h.service.LintContract(c.Request().Context(), id)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return ErrorResponse(c, http.StatusNotFound, "NOT_FOUND", "contract not found", err.Error())
+		}
+		return ErrorResponse(c, http.StatusInternalServerError, "INTERNAL_ERROR", "failed to lint contract", err.Error())
+	}
+	return SuccessResponse(c, http.StatusOK, lint)
+}
+
+// LintContractDraft lints the schemas of a create request without saving
+// them. Lint errors are reported with 200 in the report.
+func (h *ContractHandler) LintContractDraft(c echo.Context) error {
+	roadmapItemID, err := uuid.Parse(c.Param("roadmapItemId"))
+	if err != nil {
+		return ErrorResponse(c, http.StatusBadRequest, "INVALID_ID", "invalid roadmap item id", err.Error())
+	}
+	req := new(contractCreateRequest)
+	if err := c.Bind(req); err != nil {
+		return ErrorResponse(c, http.StatusBadRequest, "INVALID_BODY", "failed to bind request", err.Error())
+	}
+	lint, err := h.service.LintContractDraft(c.Request().Context(), roadmapItemID, req.ContractType, req.InputSchema, req.OutputSchema, req.ErrorSchema)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return ErrorResponse(c, http.StatusNotFound, "NOT_FOUND", "roadmap item not found", err.Error())
+		}
+		return ErrorResponse(c, http.StatusInternalServerError, "INTERNAL_ERROR", "failed to lint contract", err.Error())
+	}
+	return SuccessResponse(c, http.StatusOK, lint)
+}
+
+func (h *ContractHandler) GetLintRuleset(c echo.Context) error {
+	projectID, err := uuid.Parse(c.Param("projectId"))
+	if err != nil {
+		return ErrorResponse(c, http.StatusBadRequest, "INVALID_ID", "invalid project id", err.Error())
+	}
+	ruleset, err := h.service.GetLintRuleset(c.Request().Context(), projectID)
+	if err != nil {
+		return ErrorResponse(c, http.StatusInternalServerError, "INTERNAL_ERROR", "failed to get lint ruleset", err.Error())
+	}
+	return SuccessResponse(c, http.StatusOK, ruleset)
+}
+
+func (h *ContractHandler) UpdateLintRuleset(c echo.Context) error {
+	projectID, err := uuid.Parse(c.Param("projectId"))
+	if err != nil {
+		return ErrorResponse(c, http.StatusBadRequest, "INVALID_ID", "invalid project id", err.Error())
+	}
+	var ruleset schemalint.Ruleset
+	if err := c.Bind(&ruleset); err != nil {
+		return ErrorResponse(c, http.StatusBadRequest, "INVALID_BODY", "failed to bind request", err.Error())
+	}
+	updated, err := h.service.UpdateLintRuleset(c.Request().Context(), projectID, ruleset, GetUserID(c))
+	if err != nil {
+		if errors.Is(err, app.ErrInvalidLintRuleset) {
+			return ErrorResponse(c, http.StatusBadRequest, "INVALID_RULESET", "invalid lint ruleset", err.Error())
+		}
+		return ErrorResponse(c, http.StatusInternalServerError, "INTERNAL_ERROR", "failed to update lint ruleset", err.Error())
+	}
+	return SuccessResponse(c, http.StatusOK, updated)
+}
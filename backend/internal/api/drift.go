@@ -1,8 +1,10 @@
 package api
 
 import (
+	"errors"
 	"net/http"
 
+	"github.com/SpecForgeVC/SpecForge/internal/cireport"
 	"github.com/SpecForgeVC/SpecForge/internal/domain"
 	"github.com/SpecForgeVC/SpecForge/internal/drift"
 	"github.com/google/uuid"
@@ -30,11 +32,20 @@ func (h *DriftHandler) RunDriftCheck(c echo.Context) error {
 		return c.JSON(http.StatusBadRequest, map[string]string{"error": "invalid request body"})
 	}
 
+	format, download, err := ciReportFormat(c)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": err.Error()})
+	}
+
 	report, err := h.service.RunDriftCheck(c.Request().Context(), contractID, req.AgainstVersion)
 	if err != nil {
 		return c.JSON(http.StatusInternalServerError, map[string]string{"error": err.Error()})
 	}
 
+	if download {
+		artifact := ciReportArtifact(c, "contracts/"+contractID.String()+".json")
+		return sendCIReport(c, format, "contract-drift-"+contractID.String(), cireport.FromLegacyDriftReport("contract-drift "+contractID.String(), artifact, *report))
+	}
 	return c.JSON(http.StatusOK, report)
 }
 
@@ -46,17 +57,19 @@ func (h *DriftHandler) GetDriftHistory(c echo.Context) error {
 	return c.JSON(http.StatusOK, history)
 }
 
+// GenerateDriftFixes asks the configured LLM for JSON Patch fixes to a drift
+// report's breaking changes. With create_proposals each valid fix is filed as
+// an AI proposal for review.
 func (h *DriftHandler) GenerateDriftFixes(c echo.Context) error {
 	var req struct {
 		DriftReport struct {
-			DriftDetected   bool `json:"drift_detected"`
-			BreakingChanges []struct {
-				Field string `json:"field"`
-				Issue string `json:"issue"`
-			} `json:"breaking_changes"`
-			RiskScore float64 `json:"risk_score"`
+			DriftDetected   bool                    `json:"drift_detected"`
+			BreakingChanges []domain.BreakingChange `json:"breaking_changes"`
+			RiskScore       float64                 `json:"risk_score"`
 		} `json:"drift_report"`
-		RoadmapItemID uuid.UUID `json:"roadmap_item_id"`
+		RoadmapItemID   uuid.UUID `json:"roadmap_item_id"`
+		ContractID      uuid.UUID `json:"contract_id"`
+		CreateProposals bool      `json:"create_proposals"`
 	}
 	if err := c.Bind(&req); err != nil {
 		return c.JSON(http.StatusBadRequest, map[string]string{"error": "invalid request body"})
@@ -66,14 +79,23 @@ func (h *DriftHandler) GenerateDriftFixes(c echo.Context) error {
 	report := &domain.DriftReport{
 		DriftDetected:   req.DriftReport.DriftDetected,
 		RiskScore:       req.DriftReport.RiskScore,
-		BreakingChanges: make([]domain.BreakingChange, len(req.DriftReport.BreakingChanges)),
-	}
-	for i, bc := range req.DriftReport.BreakingChanges {
-		report.BreakingChanges[i] = domain.BreakingChange{Field: bc.Field, Issue: bc.Issue}
+		BreakingChanges: req.DriftReport.BreakingChanges,
 	}
 
-	fixes, err := h.service.GenerateDriftFixes(c.Request().Context(), report, req.RoadmapItemID)
+	fixes, err := h.service.GenerateDriftFixes(c.Request().Context(), drift.DriftFixInput{
+		Report:          report,
+		RoadmapItemID:   req.RoadmapItemID,
+		ContractID:      req.ContractID,
+		CreateProposals: req.CreateProposals,
+		UserID:          GetUserID(c),
+	})
 	if err != nil {
+		switch {
+		case errors.Is(err, drift.ErrLLMUnavailable):
+			return c.JSON(http.StatusServiceUnavailable, map[string]string{"error": err.Error()})
+		case errors.Is(err, drift.ErrInvalidFixResponse):
+			return c.JSON(http.StatusBadGateway, map[string]string{"error": err.Error()})
+		}
 		return c.JSON(http.StatusInternalServerError, map[string]string{"error": err.Error()})
 	}
 
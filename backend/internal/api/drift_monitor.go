@@ -0,0 +1,81 @@
+package api
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+
+	"github.com/SpecForgeVC/SpecForge/internal/drift"
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+)
+
+const defaultTimelineLimit = 100
+
+func (h *DriftHandler) GetDriftMonitor(c echo.Context) error {
+	projectID, err := uuid.Parse(c.Param("projectId"))
+	if err != nil {
+		return ErrorResponse(c, http.StatusBadRequest, "INVALID_ID", "invalid project id", err.Error())
+	}
+	schedule, err := h.service.GetDriftMonitorSchedule(c.Request().Context(), projectID)
+	if err != nil {
+		return ErrorResponse(c, http.StatusInternalServerError, "INTERNAL_ERROR", "failed to get drift monitor schedule", err.Error())
+	}
+	return SuccessResponse(c, http.StatusOK, schedule)
+}
+
+func (h *DriftHandler) UpdateDriftMonitor(c echo.Context) error {
+	projectID, err := uuid.Parse(c.Param("projectId"))
+	if err != nil {
+		return ErrorResponse(c, http.StatusBadRequest, "INVALID_ID", "invalid project id", err.Error())
+	}
+
+	var req struct {
+		CronExpression string `json:"cron_expression"`
+		Enabled        *bool  `json:"enabled"`
+	}
+	if err := c.Bind(&req); err != nil {
+		return ErrorResponse(c, http.StatusBadRequest, "INVALID_BODY", "invalid request body", err.Error())
+	}
+	enabled := true
+	if req.Enabled != nil {
+		enabled = *req.Enabled
+	}
+
+	schedule, err := h.service.UpdateDriftMonitorSchedule(c.Request().Context(), projectID, req.CronExpression, enabled, GetUserID(c))
+	if err != nil {
+		if errors.Is(err, drift.ErrInvalidCronExpression) {
+			return ErrorResponse(c, http.StatusBadRequest, "INVALID_CRON", "cron_expression must be a five-field cron expression or a descriptor such as @hourly", err.Error())
+		}
+		return ErrorResponse(c, http.StatusInternalServerError, "INTERNAL_ERROR", "failed to update drift monitor schedule", err.Error())
+	}
+	return SuccessResponse(c, http.StatusOK, schedule)
+}
+
+// GetDriftTimeline lists monitored drift results newest first, optionally
+// filtered by ?contract_id= and capped by ?limit=.
+func (h *DriftHandler) GetDriftTimeline(c echo.Context) error {
+	projectID, err := uuid.Parse(c.Param("projectId"))
+	if err != nil {
+		return ErrorResponse(c, http.StatusBadRequest, "INVALID_ID", "invalid project id", err.Error())
+	}
+
+	var contractID uuid.UUID
+	if v := c.QueryParam("contract_id"); v != "" {
+		if contractID, err = uuid.Parse(v); err != nil {
+			return ErrorResponse(c, http.StatusBadRequest, "INVALID_ID", "invalid contract id", err.Error())
+		}
+	}
+	limit := defaultTimelineLimit
+	if v := c.QueryParam("limit"); v != "" {
+		if limit, err = strconv.Atoi(v); err != nil || limit <= 0 {
+			return ErrorResponse(c, http.StatusBadRequest, "INVALID_LIMIT", "limit must be a positive integer", v)
+		}
+	}
+
+	entries, err := h.service.ListDriftTimeline(c.Request().Context(), projectID, contractID, limit)
+	if err != nil {
+		return ErrorResponse(c, http.StatusInternalServerError, "INTERNAL_ERROR", "failed to list drift timeline", err.Error())
+	}
+	return SuccessResponse(c, http.StatusOK, entries)
+}
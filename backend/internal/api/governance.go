@@ -0,0 +1,198 @@
+package api
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/SpecForgeVC/SpecForge/internal/app"
+	"github.com/SpecForgeVC/SpecForge/internal/domain"
+	"github.com/SpecForgeVC/SpecForge/internal/domain/governance"
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+)
+
+type GovernanceHandler struct {
+	service app.GovernanceService
+}
+
+func NewGovernanceHandler(service app.GovernanceService) *GovernanceHandler {
+	return &GovernanceHandler{service: service}
+}
+
+// GetGovernancePolicy returns the policy in force for the project.
+func (h *GovernanceHandler) GetGovernancePolicy(c echo.Context) error {
+	projectID, err := uuid.Parse(c.Param("projectId"))
+	if err != nil {
+		return ErrorResponse(c, http.StatusBadRequest, "INVALID_ID", "invalid project id", err.Error())
+	}
+	policy, err := h.service.GetPolicy(c.Request().Context(), projectID)
+	if err != nil {
+		return ErrorResponse(c, http.StatusInternalServerError, "INTERNAL_ERROR", "failed to get governance policy", err.Error())
+	}
+	return SuccessResponse(c, http.StatusOK, policy)
+}
+
+// ListGovernancePolicyVersions returns every stored policy version, newest first.
+func (h *GovernanceHandler) ListGovernancePolicyVersions(c echo.Context) error {
+	projectID, err := uuid.Parse(c.Param("projectId"))
+	if err != nil {
+		return ErrorResponse(c, http.StatusBadRequest, "INVALID_ID", "invalid project id", err.Error())
+	}
+	versions, err := h.service.ListPolicyVersions(c.Request().Context(), projectID)
+	if err != nil {
+		return ErrorResponse(c, http.StatusInternalServerError, "INTERNAL_ERROR", "failed to list governance policy versions", err.Error())
+	}
+	return SuccessResponse(c, http.StatusOK, versions)
+}
+
+// UpdateGovernancePolicy stores the posted policy as the project's next version.
+func (h *GovernanceHandler) UpdateGovernancePolicy(c echo.Context) error {
+	projectID, err := uuid.Parse(c.Param("projectId"))
+	if err != nil {
+		return ErrorResponse(c, http.StatusBadRequest, "INVALID_ID", "invalid project id", err.Error())
+	}
+	var policy governance.Policy
+	if err := c.Bind(&policy); err != nil {
+		return ErrorResponse(c, http.StatusBadRequest, "INVALID_BODY", "failed to bind request", err.Error())
+	}
+	updated, err := h.service.UpdatePolicy(c.Request().Context(), projectID, policy, GetUserID(c))
+	if err != nil {
+		if errors.Is(err, app.ErrInvalidGovernancePolicy) {
+			return ErrorResponse(c, http.StatusBadRequest, "INVALID_POLICY", "invalid governance policy", err.Error())
+		}
+		return ErrorResponse(c, http.StatusInternalServerError, "INTERNAL_ERROR", "failed to update governance policy", err.Error())
+	}
+	return SuccessResponse(c, http.StatusOK, updated)
+}
+
+// EvaluateGovernance runs a gate (?gate=BUILD|DEPLOY, default BUILD) against a roadmap item.
+func (h *GovernanceHandler) EvaluateGovernance(c echo.Context) error {
+	id, err := uuid.Parse(c.Param("roadmapItemId"))
+	if err != nil {
+		return ErrorResponse(c, http.StatusBadRequest, "INVALID_ID", "invalid roadmap item id", err.Error())
+	}
+	gate := governance.GateBuild
+	if v := c.QueryParam("gate"); v != "" {
+		gate = governance.Gate(strings.ToUpper(v))
+	}
+	if gate != governance.GateBuild && gate != governance.GateDeploy {
+		return ErrorResponse(c, http.StatusBadRequest, "INVALID_GATE", "gate must be BUILD or DEPLOY", string(gate))
+	}
+	eval, err := h.service.EvaluateFeature(c.Request().Context(), id, gate)
+	if err != nil {
+		return ErrorResponse(c, http.StatusInternalServerError, "INTERNAL_ERROR", "failed to evaluate governance", err.Error())
+	}
+	return SuccessResponse(c, http.StatusOK, eval)
+}
+
+type waiverRequest struct {
+	Gate          governance.Gate `json:"gate"`
+	Rule          string          `json:"rule"`
+	Justification string          `json:"justification"`
+	ExpiresAt     time.Time       `json:"expires_at"`
+}
+
+type waiverDecisionRequest struct {
+	Comment string `json:"comment"`
+}
+
+// RequestWaiver files an exception to one governance rule for a roadmap item.
+func (h *GovernanceHandler) RequestWaiver(c echo.Context) error {
+	id, err := uuid.Parse(c.Param("roadmapItemId"))
+	if err != nil {
+		return ErrorResponse(c, http.StatusBadRequest, "INVALID_ID", "invalid roadmap item id", err.Error())
+	}
+	var req waiverRequest
+	if err := c.Bind(&req); err != nil {
+		return ErrorResponse(c, http.StatusBadRequest, "INVALID_BODY", "failed to bind request", err.Error())
+	}
+	req.Gate = governance.Gate(strings.ToUpper(string(req.Gate)))
+	if req.Gate != governance.GateBuild && req.Gate != governance.GateDeploy {
+		return ErrorResponse(c, http.StatusBadRequest, "INVALID_GATE", "gate must be BUILD or DEPLOY", string(req.Gate))
+	}
+	waiver, err := h.service.RequestWaiver(c.Request().Context(), id, req.Gate, req.Rule, req.Justification, req.ExpiresAt, GetUserID(c))
+	if err != nil {
+		return waiverError(c, err)
+	}
+	return SuccessResponse(c, http.StatusCreated, waiver)
+}
+
+// GetWaiver returns one waiver with its status as of now.
+func (h *GovernanceHandler) GetWaiver(c echo.Context) error {
+	id, err := uuid.Parse(c.Param("waiverId"))
+	if err != nil {
+		return ErrorResponse(c, http.StatusBadRequest, "INVALID_ID", "invalid waiver id", err.Error())
+	}
+	waiver, err := h.service.GetWaiver(c.Request().Context(), id)
+	if err != nil {
+		return waiverError(c, err)
+	}
+	return SuccessResponse(c, http.StatusOK, waiver)
+}
+
+func (h *GovernanceHandler) ApproveWaiver(c echo.Context) error {
+	return h.decideWaiver(c, h.service.ApproveWaiver)
+}
+
+func (h *GovernanceHandler) RejectWaiver(c echo.Context) error {
+	return h.decideWaiver(c, h.service.RejectWaiver)
+}
+
+func (h *GovernanceHandler) RevokeWaiver(c echo.Context) error {
+	return h.decideWaiver(c, h.service.RevokeWaiver)
+}
+
+func (h *GovernanceHandler) decideWaiver(c echo.Context, decide func(ctx context.Context, id, userID uuid.UUID, comment string) (*domain.GovernanceWaiver, error)) error {
+	id, err := uuid.Parse(c.Param("waiverId"))
+	if err != nil {
+		return ErrorResponse(c, http.StatusBadRequest, "INVALID_ID", "invalid waiver id", err.Error())
+	}
+	var req waiverDecisionRequest
+	if err := c.Bind(&req); err != nil {
+		return ErrorResponse(c, http.StatusBadRequest, "INVALID_BODY", "failed to bind request", err.Error())
+	}
+	waiver, err := decide(c.Request().Context(), id, GetUserID(c), req.Comment)
+	if err != nil {
+		return waiverError(c, err)
+	}
+	return SuccessResponse(c, http.StatusOK, waiver)
+}
+
+// ListWaivers returns the project's exception register (?status= filters
+// by PENDING, APPROVED, REJECTED, REVOKED or EXPIRED).
+func (h *GovernanceHandler) ListWaivers(c echo.Context) error {
+	projectID, err := uuid.Parse(c.Param("projectId"))
+	if err != nil {
+		return ErrorResponse(c, http.StatusBadRequest, "INVALID_ID", "invalid project id", err.Error())
+	}
+	status := domain.WaiverStatus(strings.ToUpper(c.QueryParam("status")))
+	switch status {
+	case "", domain.WaiverPending, domain.WaiverApproved, domain.WaiverRejected, domain.WaiverRevoked, domain.WaiverExpired:
+	default:
+		return ErrorResponse(c, http.StatusBadRequest, "INVALID_STATUS", "unknown waiver status", string(status))
+	}
+	waivers, err := h.service.ListWaivers(c.Request().Context(), projectID, status)
+	if err != nil {
+		return ErrorResponse(c, http.StatusInternalServerError, "INTERNAL_ERROR", "failed to list waivers", err.Error())
+	}
+	return SuccessResponse(c, http.StatusOK, waivers)
+}
+
+func waiverError(c echo.Context, err error) error {
+	switch {
+	case errors.Is(err, sql.ErrNoRows):
+		return ErrorResponse(c, http.StatusNotFound, "NOT_FOUND", "waiver not found", err.Error())
+	case errors.Is(err, app.ErrInvalidWaiver):
+		return ErrorResponse(c, http.StatusBadRequest, "INVALID_WAIVER", "invalid waiver", err.Error())
+	case errors.Is(err, app.ErrWaiverSelfApproval):
+		return ErrorResponse(c, http.StatusForbidden, "SELF_APPROVAL", "the requester of a waiver cannot decide it", err.Error())
+	case errors.Is(err, app.ErrWaiverTransition):
+		return ErrorResponse(c, http.StatusConflict, "INVALID_TRANSITION", "waiver cannot change status", err.Error())
+	default:
+		return ErrorResponse(c, http.StatusInternalServerError, "INTERNAL_ERROR", "failed to process waiver", err.Error())
+	}
+}
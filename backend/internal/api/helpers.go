@@ -1,6 +1,7 @@
 package api
 
 import (
+	"github.com/SpecForgeVC/SpecForge/internal/domain"
 	"github.com/SpecForgeVC/SpecForge/internal/transport/middleware"
 	"github.com/google/uuid"
 	"github.com/labstack/echo/v4"
@@ -14,3 +15,12 @@ func GetUserID(c echo.Context) uuid.UUID {
 	}
 	return p.UserID
 }
+
+// GetRole extracts the caller's role from the Principal in the request context.
+func GetRole(c echo.Context) domain.Role {
+	p, ok := middleware.PrincipalFromContext(c.Request().Context())
+	if !ok {
+		return ""
+	}
+	return p.Role
+}
@@ -0,0 +1,89 @@
+package api
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/SpecForgeVC/SpecForge/internal/app"
+	"github.com/SpecForgeVC/SpecForge/internal/domain"
+	"github.com/SpecForgeVC/SpecForge/internal/openapi"
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+)
+
+type OpenAPIImportHandler struct {
+	service app.OpenAPIImportService
+}
+
+func NewOpenAPIImportHandler(s app.OpenAPIImportService) *OpenAPIImportHandler {
+	return &OpenAPIImportHandler{service: s}
+}
+
+// openAPIImportRequest accepts the document either as a YAML/JSON string or
+// as an inline JSON object.
+type openAPIImportRequest struct {
+	Document      json.RawMessage `json:"document"`
+	RoadmapItemID uuid.UUID       `json:"roadmap_item_id"`
+	GroupByTag    bool            `json:"group_by_tag"`
+}
+
+// ImportOpenAPI imports an OpenAPI 3.x document into the project's contracts.
+// It accepts either a JSON body or a multipart upload with a "document" file
+// and roadmap_item_id and group_by_tag form fields.
+func (h *OpenAPIImportHandler) ImportOpenAPI(c echo.Context) error {
+	projectID, err := uuid.Parse(c.Param("projectId"))
+	if err != nil {
+		return ErrorResponse(c, http.StatusBadRequest, "INVALID_ID", "invalid project id", err.Error())
+	}
+	input := domain.OpenAPIImportInput{ProjectID: projectID, UserID: GetUserID(c)}
+
+	if strings.HasPrefix(c.Request().Header.Get(echo.HeaderContentType), echo.MIMEMultipartForm) {
+		if input.Document, err = readFormFile(c, "document"); err != nil {
+			return ErrorResponse(c, http.StatusBadRequest, "INVALID_BODY", "failed to read document file", err.Error())
+		}
+		if v := c.FormValue("roadmap_item_id"); v != "" {
+			if input.RoadmapItemID, err = uuid.Parse(v); err != nil {
+				return ErrorResponse(c, http.StatusBadRequest, "INVALID_ID", "invalid roadmap item id", err.Error())
+			}
+		}
+		if v := c.FormValue("group_by_tag"); v != "" {
+			if input.GroupByTag, err = strconv.ParseBool(v); err != nil {
+				return ErrorResponse(c, http.StatusBadRequest, "INVALID_BODY", "group_by_tag must be a boolean", err.Error())
+			}
+		}
+	} else {
+		req := new(openAPIImportRequest)
+		if err := c.Bind(req); err != nil {
+			return ErrorResponse(c, http.StatusBadRequest, "INVALID_BODY", "failed to bind request", err.Error())
+		}
+		input.RoadmapItemID, input.GroupByTag = req.RoadmapItemID, req.GroupByTag
+		if len(req.Document) > 0 && string(req.Document) != "null" {
+			var text string
+			if err := json.Unmarshal(req.Document, &text); err == nil {
+				input.Document = []byte(text)
+			} else {
+				input.Document = req.Document
+			}
+		}
+	}
+
+	result, err := h.service.ImportOpenAPI(c.Request().Context(), input)
+	if err != nil {
+		var refErr *openapi.UnresolvedRefsError
+		switch {
+		case errors.As(err, &refErr):
+			return c.JSON(http.StatusUnprocessableEntity, Response{
+				Success: false,
+				Error:   &Error{Code: "UNRESOLVED_REFS", Message: "document contains unresolvable references", Details: err.Error()},
+				Meta:    refErr.Refs,
+			})
+		case errors.Is(err, app.ErrInvalidOpenAPIImport):
+			return ErrorResponse(c, http.StatusBadRequest, "INVALID_IMPORT", "invalid openapi import", err.Error())
+		}
+		return ErrorResponse(c, http.StatusInternalServerError, "INTERNAL_ERROR", "failed to import openapi document", err.Error())
+	}
+	return SuccessResponse(c, http.StatusOK, result)
+}
@@ -1,6 +1,8 @@
 package api
 
 import (
+	"context"
+	"errors"
 	"net/http"
 
 	"github.com/SpecForgeVC/SpecForge/internal/app"
@@ -55,43 +57,136 @@ func (h *AiProposalHandler) CreateProposal(c echo.Context) error {
 	if err := c.Bind(req); err != nil {
 		return err
 	}
-	proposal, err := h.service.CreateProposal(c.Request().Context(), req.RoadmapItemID, req.ProposalType, req.Diff, req.Reasoning, req.ConfidenceScore)
+	proposal, err := h.service.CreateProposal(c.Request().Context(), req.RoadmapItemID, req.ProposalType, req.Diff, req.Reasoning, req.ConfidenceScore, GetUserID(c))
 	if err != nil {
-		return c.JSON(http.StatusInternalServerError, map[string]string{"error": err.Error()})
+		return c.JSON(proposalErrorStatus(err), map[string]string{"error": err.Error()})
 	}
 	return c.JSON(http.StatusCreated, proposal)
 }
 
+type proposalReviewRequest struct {
+	Comment string `json:"comment"`
+}
+
+// GetReviewState returns the proposal's votes and quorum progress.
+func (h *AiProposalHandler) GetReviewState(c echo.Context) error {
+	id, err := uuid.Parse(c.Param("proposalId"))
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": "invalid proposal id"})
+	}
+	state, err := h.service.GetReviewState(c.Request().Context(), id)
+	if err != nil {
+		return c.JSON(http.StatusNotFound, map[string]string{"error": "proposal not found"})
+	}
+	return c.JSON(http.StatusOK, state)
+}
+
+// PreviewProposal shows a JSON_PATCH proposal's target before and after the
+// patch, with a change list for reviewers.
+func (h *AiProposalHandler) PreviewProposal(c echo.Context) error {
+	id, err := uuid.Parse(c.Param("proposalId"))
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": "invalid proposal id"})
+	}
+	preview, err := h.service.PreviewProposal(c.Request().Context(), id)
+	if err != nil {
+		return c.JSON(proposalErrorStatus(err), map[string]string{"error": err.Error()})
+	}
+	return c.JSON(http.StatusOK, preview)
+}
+
+// ApproveProposal records an approval vote. The proposal is applied once the
+// votes meet its quorum; the returned state says whether they have.
 func (h *AiProposalHandler) ApproveProposal(c echo.Context) error {
+	return h.review(c, h.service.ApproveProposal)
+}
+
+// RequestChanges records a changes-requested vote and sends the proposal back
+// to its author.
+func (h *AiProposalHandler) RequestChanges(c echo.Context) error {
+	return h.review(c, h.service.RequestChanges)
+}
+
+func (h *AiProposalHandler) RejectProposal(c echo.Context) error {
+	return h.review(c, h.service.RejectProposal)
+}
+
+type reviewFunc func(ctx context.Context, id uuid.UUID, reviewer domain.Principal, comment string) (*domain.ProposalReviewState, error)
+
+func (h *AiProposalHandler) review(c echo.Context, vote reviewFunc) error {
 	id, err := uuid.Parse(c.Param("proposalId"))
 	if err != nil {
 		return c.JSON(http.StatusBadRequest, map[string]string{"error": "invalid proposal id"})
 	}
+	req := new(proposalReviewRequest)
+	if err := c.Bind(req); err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": "invalid request body"})
+	}
 
 	principal, ok := mw.PrincipalFromContext(c.Request().Context())
 	if !ok {
 		return c.JSON(http.StatusUnauthorized, map[string]string{"error": "unauthorized"})
 	}
 
-	if err := h.service.ApproveProposal(c.Request().Context(), id, principal.UserID); err != nil {
-		return c.JSON(http.StatusInternalServerError, map[string]string{"error": err.Error()})
+	state, err := vote(c.Request().Context(), id, *principal, req.Comment)
+	if err != nil {
+		return c.JSON(proposalErrorStatus(err), map[string]string{"error": err.Error()})
 	}
-	return c.NoContent(http.StatusNoContent)
+	return c.JSON(http.StatusOK, state)
 }
 
-func (h *AiProposalHandler) RejectProposal(c echo.Context) error {
+type proposalResubmitRequest struct {
+	Diff      map[string]interface{} `json:"diff"`
+	Reasoning string                 `json:"reasoning"`
+}
+
+// ResubmitProposal returns a proposal with changes requested to review,
+// optionally with a revised diff.
+func (h *AiProposalHandler) ResubmitProposal(c echo.Context) error {
 	id, err := uuid.Parse(c.Param("proposalId"))
 	if err != nil {
 		return c.JSON(http.StatusBadRequest, map[string]string{"error": "invalid proposal id"})
 	}
+	req := new(proposalResubmitRequest)
+	if err := c.Bind(req); err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": "invalid request body"})
+	}
+	proposal, err := h.service.ResubmitProposal(c.Request().Context(), id, req.Diff, req.Reasoning, GetUserID(c))
+	if err != nil {
+		return c.JSON(proposalErrorStatus(err), map[string]string{"error": err.Error()})
+	}
+	return c.JSON(http.StatusOK, proposal)
+}
 
-	principal, ok := mw.PrincipalFromContext(c.Request().Context())
-	if !ok {
-		return c.JSON(http.StatusUnauthorized, map[string]string{"error": "unauthorized"})
+// RebaseProposal moves a proposal onto the current version of its target.
+// Conflicting changes are reported with 409 and leave the proposal as it was.
+// A proposal already on the current version is returned unchanged with 200.
+func (h *AiProposalHandler) RebaseProposal(c echo.Context) error {
+	id, err := uuid.Parse(c.Param("proposalId"))
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": "invalid proposal id"})
+	}
+	result, err := h.service.RebaseProposal(c.Request().Context(), id, GetUserID(c))
+	if err != nil {
+		return c.JSON(proposalErrorStatus(err), map[string]string{"error": err.Error()})
 	}
+	if len(result.Conflicts) > 0 {
+		return c.JSON(http.StatusConflict, result)
+	}
+	return c.JSON(http.StatusOK, result)
+}
 
-	if err := h.service.RejectProposal(c.Request().Context(), id, principal.UserID); err != nil {
-		return c.JSON(http.StatusInternalServerError, map[string]string{"error": err.Error()})
+func proposalErrorStatus(err error) int {
+	switch {
+	case errors.Is(err, app.ErrSelfApproval):
+		return http.StatusForbidden
+	case errors.Is(err, app.ErrProposalNotReviewable), errors.Is(err, app.ErrProposalStale), errors.Is(err, app.ErrContractLocked):
+		return http.StatusConflict
+	case errors.Is(err, app.ErrReviewCommentRequired), errors.Is(err, app.ErrNotPatchProposal):
+		return http.StatusBadRequest
+	case errors.Is(err, app.ErrInvalidProposal), errors.Is(err, app.ErrInvalidContractSchema), errors.Is(err, app.ErrInvalidContractVersion), errors.As(err, new(*app.VersionBumpError)):
+		return http.StatusUnprocessableEntity
+	default:
+		return http.StatusInternalServerError
 	}
-	return c.NoContent(http.StatusNoContent)
 }
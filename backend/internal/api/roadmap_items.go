@@ -1,6 +1,7 @@
 package api
 
 import (
+	"errors"
 	"fmt"
 	"net/http"
 
@@ -103,6 +104,7 @@ type roadmapItemUpdateRequest struct {
 	BusinessContext  string                   `json:"business_context"`
 	TechnicalContext string                   `json:"technical_context"`
 	Status           domain.RoadmapItemStatus `json:"status"`
+	StatusReason     string                   `json:"status_reason"`
 }
 
 func (h *RoadmapItemHandler) UpdateRoadmapItem(c echo.Context) error {
@@ -120,13 +122,86 @@ func (h *RoadmapItemHandler) UpdateRoadmapItem(c echo.Context) error {
 		return c.JSON(http.StatusUnauthorized, map[string]string{"error": "unauthorized"})
 	}
 
-	item, err := h.service.UpdateRoadmapItem(c.Request().Context(), id, req.Title, req.Description, req.BusinessContext, req.TechnicalContext, req.Status, principal.UserID)
+	item, err := h.service.UpdateRoadmapItem(c.Request().Context(), id, req.Title, req.Description, req.BusinessContext, req.TechnicalContext, req.Status, req.StatusReason, *principal)
 	if err != nil {
+		if transitionErr := statusTransitionError(c, err); transitionErr != nil {
+			return transitionErr
+		}
 		return c.JSON(http.StatusInternalServerError, map[string]string{"error": err.Error()})
 	}
 	return c.JSON(http.StatusOK, item)
 }
 
+type roadmapItemTransitionRequest struct {
+	Status domain.RoadmapItemStatus `json:"status"`
+	Reason string                   `json:"reason"`
+}
+
+// TransitionRoadmapItem moves the item to another workflow status.
+func (h *RoadmapItemHandler) TransitionRoadmapItem(c echo.Context) error {
+	id, err := uuid.Parse(c.Param("roadmapItemId"))
+	if err != nil {
+		return ErrorResponse(c, http.StatusBadRequest, "INVALID_ID", "invalid roadmap item id", err.Error())
+	}
+	req := new(roadmapItemTransitionRequest)
+	if err := c.Bind(req); err != nil {
+		return ErrorResponse(c, http.StatusBadRequest, "INVALID_BODY", "failed to bind request", err.Error())
+	}
+	if req.Status == "" {
+		return ErrorResponse(c, http.StatusBadRequest, "INVALID_BODY", "status is required", "")
+	}
+
+	principal, ok := mw.PrincipalFromContext(c.Request().Context())
+	if !ok {
+		return ErrorResponse(c, http.StatusUnauthorized, "UNAUTHORIZED", "unauthorized", "")
+	}
+
+	item, err := h.service.TransitionRoadmapItem(c.Request().Context(), id, req.Status, req.Reason, *principal)
+	if err != nil {
+		if transitionErr := statusTransitionError(c, err); transitionErr != nil {
+			return transitionErr
+		}
+		return ErrorResponse(c, http.StatusInternalServerError, "INTERNAL_ERROR", "failed to transition roadmap item", err.Error())
+	}
+	return SuccessResponse(c, http.StatusOK, item)
+}
+
+// ListStatusTransitions returns the item's status history, oldest first.
+func (h *RoadmapItemHandler) ListStatusTransitions(c echo.Context) error {
+	id, err := uuid.Parse(c.Param("roadmapItemId"))
+	if err != nil {
+		return ErrorResponse(c, http.StatusBadRequest, "INVALID_ID", "invalid roadmap item id", err.Error())
+	}
+	transitions, err := h.service.ListStatusTransitions(c.Request().Context(), id)
+	if err != nil {
+		return ErrorResponse(c, http.StatusInternalServerError, "INTERNAL_ERROR", "failed to list status transitions", err.Error())
+	}
+	return SuccessResponse(c, http.StatusOK, transitions)
+}
+
+// statusTransitionError renders a rejected transition with its reasons, or
+// returns nil if err is not a transition rejection.
+func statusTransitionError(c echo.Context, err error) error {
+	var transitionErr *app.StatusTransitionError
+	if !errors.As(err, &transitionErr) {
+		return nil
+	}
+	status := http.StatusConflict
+	switch transitionErr.Failure {
+	case app.TransitionForbidden:
+		status = http.StatusForbidden
+	case app.TransitionReasonRequired:
+		status = http.StatusBadRequest
+	case app.TransitionBlocked:
+		status = http.StatusUnprocessableEntity
+	}
+	return c.JSON(status, Response{
+		Success: false,
+		Error:   &Error{Code: "TRANSITION_" + string(transitionErr.Failure), Message: "status transition rejected", Details: err.Error()},
+		Meta:    transitionErr,
+	})
+}
+
 func (h *RoadmapItemHandler) DeleteRoadmapItem(c echo.Context) error {
 	id, err := uuid.Parse(c.Param("roadmapItemId"))
 	if err != nil {
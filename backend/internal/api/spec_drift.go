@@ -0,0 +1,184 @@
+package api
+
+import (
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"strings"
+
+	"github.com/SpecForgeVC/SpecForge/internal/cireport"
+	specdrift "github.com/SpecForgeVC/SpecForge/internal/domain/drift"
+	"github.com/SpecForgeVC/SpecForge/internal/drift"
+	"github.com/SpecForgeVC/SpecForge/internal/openapi"
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+)
+
+type SpecDriftHandler struct {
+	service drift.DriftService
+}
+
+func NewSpecDriftHandler(s drift.DriftService) *SpecDriftHandler {
+	return &SpecDriftHandler{service: s}
+}
+
+// specSourceRequest accepts the document either as a YAML/JSON string or as an inline JSON object.
+type specSourceRequest struct {
+	Document      json.RawMessage `json:"document"`
+	RoadmapItemID uuid.UUID       `json:"roadmap_item_id"`
+}
+
+type specDriftCheckRequest struct {
+	Baseline specSourceRequest      `json:"baseline"`
+	Proposed specSourceRequest      `json:"proposed"`
+	Policy   *specdrift.DriftPolicy `json:"policy"`
+}
+
+func (r specSourceRequest) toSource() drift.SpecSource {
+	src := drift.SpecSource{RoadmapItemID: r.RoadmapItemID}
+	if len(r.Document) == 0 || string(r.Document) == "null" {
+		return src
+	}
+	var text string
+	if err := json.Unmarshal(r.Document, &text); err == nil {
+		src.Document = []byte(text)
+	} else {
+		src.Document = r.Document
+	}
+	return src
+}
+
+// RunSpecDriftCheck compares a baseline and a proposed OpenAPI document.
+// It accepts either a JSON body or a multipart upload with "baseline" and "proposed" files.
+func (h *SpecDriftHandler) RunSpecDriftCheck(c echo.Context) error {
+	projectID, err := uuid.Parse(c.Param("projectId"))
+	if err != nil {
+		return ErrorResponse(c, http.StatusBadRequest, "INVALID_ID", "invalid project id", err.Error())
+	}
+
+	input := drift.SpecDriftInput{
+		ProjectID: projectID,
+		UserID:    GetUserID(c),
+		Role:      GetRole(c),
+	}
+
+	if strings.HasPrefix(c.Request().Header.Get(echo.HeaderContentType), echo.MIMEMultipartForm) {
+		if input.Baseline.Document, err = readFormFile(c, "baseline"); err != nil {
+			return ErrorResponse(c, http.StatusBadRequest, "INVALID_BODY", "failed to read baseline file", err.Error())
+		}
+		if input.Proposed.Document, err = readFormFile(c, "proposed"); err != nil {
+			return ErrorResponse(c, http.StatusBadRequest, "INVALID_BODY", "failed to read proposed file", err.Error())
+		}
+	} else {
+		req := new(specDriftCheckRequest)
+		if err := c.Bind(req); err != nil {
+			return ErrorResponse(c, http.StatusBadRequest, "INVALID_BODY", "failed to bind request", err.Error())
+		}
+		input.Baseline = req.Baseline.toSource()
+		input.Proposed = req.Proposed.toSource()
+		input.Policy = req.Policy
+	}
+
+	check, err := h.service.RunSpecDriftCheck(c.Request().Context(), input)
+	if err != nil {
+		var refErr *openapi.UnresolvedRefsError
+		if errors.As(err, &refErr) {
+			return c.JSON(http.StatusUnprocessableEntity, Response{
+				Success: false,
+				Error:   &Error{Code: "UNRESOLVED_REFS", Message: "document contains unresolvable references", Details: err.Error()},
+				Meta:    refErr.Refs,
+			})
+		}
+		switch {
+		case errors.Is(err, drift.ErrPolicyOverrideForbidden):
+			return ErrorResponse(c, http.StatusForbidden, "FORBIDDEN", "policy override not allowed", err.Error())
+		case errors.Is(err, drift.ErrForeignRoadmapItem):
+			return ErrorResponse(c, http.StatusNotFound, "NOT_FOUND", "roadmap item not found in project", err.Error())
+		}
+		return ErrorResponse(c, http.StatusBadRequest, "DRIFT_CHECK_FAILED", "failed to run drift check", err.Error())
+	}
+	return SuccessResponse(c, http.StatusCreated, check)
+}
+
+func (h *SpecDriftHandler) ListSpecDriftChecks(c echo.Context) error {
+	projectID, err := uuid.Parse(c.Param("projectId"))
+	if err != nil {
+		return ErrorResponse(c, http.StatusBadRequest, "INVALID_ID", "invalid project id", err.Error())
+	}
+	checks, err := h.service.ListSpecDriftChecks(c.Request().Context(), projectID)
+	if err != nil {
+		return ErrorResponse(c, http.StatusInternalServerError, "INTERNAL_ERROR", "failed to list drift checks", err.Error())
+	}
+	return SuccessResponse(c, http.StatusOK, checks)
+}
+
+// GetSpecDriftCheck returns a stored drift check, or its report as a SARIF or
+// JUnit XML download when ?format=sarif|junit is given.
+func (h *SpecDriftHandler) GetSpecDriftCheck(c echo.Context) error {
+	id, err := uuid.Parse(c.Param("checkId"))
+	if err != nil {
+		return ErrorResponse(c, http.StatusBadRequest, "INVALID_ID", "invalid drift check id", err.Error())
+	}
+	format, download, err := ciReportFormat(c)
+	if err != nil {
+		return ErrorResponse(c, http.StatusBadRequest, "INVALID_FORMAT", "invalid report format", err.Error())
+	}
+	check, err := h.service.GetSpecDriftCheck(c.Request().Context(), id)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return ErrorResponse(c, http.StatusNotFound, "NOT_FOUND", "drift check not found", err.Error())
+		}
+		return ErrorResponse(c, http.StatusInternalServerError, "INTERNAL_ERROR", "failed to get drift check", err.Error())
+	}
+	if download {
+		report := cireport.FromDriftReport("spec-drift-check "+check.ID.String(), ciReportArtifact(c, "openapi.yaml"), check.Report)
+		return sendCIReport(c, format, "drift-check-"+check.ID.String(), report)
+	}
+	return SuccessResponse(c, http.StatusOK, check)
+}
+
+func (h *SpecDriftHandler) GetDriftPolicy(c echo.Context) error {
+	projectID, err := uuid.Parse(c.Param("projectId"))
+	if err != nil {
+		return ErrorResponse(c, http.StatusBadRequest, "INVALID_ID", "invalid project id", err.Error())
+	}
+	policy, err := h.service.GetDriftPolicy(c.Request().Context(), projectID)
+	if err != nil {
+		return ErrorResponse(c, http.StatusInternalServerError, "INTERNAL_ERROR", "failed to get drift policy", err.Error())
+	}
+	return SuccessResponse(c, http.StatusOK, policy)
+}
+
+func (h *SpecDriftHandler) UpdateDriftPolicy(c echo.Context) error {
+	projectID, err := uuid.Parse(c.Param("projectId"))
+	if err != nil {
+		return ErrorResponse(c, http.StatusBadRequest, "INVALID_ID", "invalid project id", err.Error())
+	}
+	var policy specdrift.DriftPolicy
+	if err := c.Bind(&policy); err != nil {
+		return ErrorResponse(c, http.StatusBadRequest, "INVALID_BODY", "failed to bind request", err.Error())
+	}
+	if err := policy.Validate(); err != nil {
+		return ErrorResponse(c, http.StatusBadRequest, "INVALID_POLICY", "invalid drift policy", err.Error())
+	}
+	updated, err := h.service.UpdateDriftPolicy(c.Request().Context(), projectID, policy, GetUserID(c))
+	if err != nil {
+		return ErrorResponse(c, http.StatusInternalServerError, "INTERNAL_ERROR", "failed to update drift policy", err.Error())
+	}
+	return SuccessResponse(c, http.StatusOK, updated)
+}
+
+func readFormFile(c echo.Context, field string) ([]byte, error) {
+	fh, err := c.FormFile(field)
+	if err != nil {
+		return nil, err
+	}
+	f, err := fh.Open()
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	return io.ReadAll(f)
+}
@@ -0,0 +1,93 @@
+package api
+
+import (
+	"database/sql"
+	"errors"
+	"io"
+	"net/http"
+	"strings"
+
+	"github.com/SpecForgeVC/SpecForge/internal/drift"
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+)
+
+type TrafficDriftHandler struct {
+	service drift.DriftService
+}
+
+func NewTrafficDriftHandler(s drift.DriftService) *TrafficDriftHandler {
+	return &TrafficDriftHandler{service: s}
+}
+
+// RunTrafficDriftCheck validates an uploaded HAR or JSONL recording against the
+// project's REST contracts. It accepts a multipart upload with a "recording" file
+// (and optional "roadmap_item_id" field) or the recording as the raw request body
+// with an optional ?roadmap_item_id= query parameter.
+func (h *TrafficDriftHandler) RunTrafficDriftCheck(c echo.Context) error {
+	projectID, err := uuid.Parse(c.Param("projectId"))
+	if err != nil {
+		return ErrorResponse(c, http.StatusBadRequest, "INVALID_ID", "invalid project id", err.Error())
+	}
+
+	input := drift.TrafficDriftInput{
+		ProjectID: projectID,
+		UserID:    GetUserID(c),
+	}
+
+	roadmapItemID := c.QueryParam("roadmap_item_id")
+	if strings.HasPrefix(c.Request().Header.Get(echo.HeaderContentType), echo.MIMEMultipartForm) {
+		if input.Recording, err = readFormFile(c, "recording"); err != nil {
+			return ErrorResponse(c, http.StatusBadRequest, "INVALID_BODY", "failed to read recording file", err.Error())
+		}
+		if v := c.FormValue("roadmap_item_id"); v != "" {
+			roadmapItemID = v
+		}
+	} else if input.Recording, err = io.ReadAll(c.Request().Body); err != nil {
+		return ErrorResponse(c, http.StatusBadRequest, "INVALID_BODY", "failed to read recording", err.Error())
+	}
+	if roadmapItemID != "" {
+		if input.RoadmapItemID, err = uuid.Parse(roadmapItemID); err != nil {
+			return ErrorResponse(c, http.StatusBadRequest, "INVALID_ID", "invalid roadmap item id", err.Error())
+		}
+	}
+
+	check, err := h.service.RunTrafficDriftCheck(c.Request().Context(), input)
+	if err != nil {
+		switch {
+		case errors.Is(err, drift.ErrInvalidRecording):
+			return ErrorResponse(c, http.StatusBadRequest, "INVALID_RECORDING", "recording must be a HAR document or JSONL exchanges", err.Error())
+		case errors.Is(err, drift.ErrForeignRoadmapItem):
+			return ErrorResponse(c, http.StatusNotFound, "NOT_FOUND", "roadmap item not found in project", err.Error())
+		}
+		return ErrorResponse(c, http.StatusInternalServerError, "INTERNAL_ERROR", "failed to run traffic drift check", err.Error())
+	}
+	return SuccessResponse(c, http.StatusCreated, check)
+}
+
+func (h *TrafficDriftHandler) ListTrafficDriftChecks(c echo.Context) error {
+	projectID, err := uuid.Parse(c.Param("projectId"))
+	if err != nil {
+		return ErrorResponse(c, http.StatusBadRequest, "INVALID_ID", "invalid project id", err.Error())
+	}
+	checks, err := h.service.ListTrafficDriftChecks(c.Request().Context(), projectID)
+	if err != nil {
+		return ErrorResponse(c, http.StatusInternalServerError, "INTERNAL_ERROR", "failed to list traffic drift checks", err.Error())
+	}
+	return SuccessResponse(c, http.StatusOK, checks)
+}
+
+func (h *TrafficDriftHandler) GetTrafficDriftCheck(c echo.Context) error {
+	id, err := uuid.Parse(c.Param("checkId"))
+	if err != nil {
+		return ErrorResponse(c, http.StatusBadRequest, "INVALID_ID", "invalid traffic drift check id", err.Error())
+	}
+	check, err := h.service.GetTrafficDriftCheck(c.Request().Context(), id)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return ErrorResponse(c, http.StatusNotFound, "NOT_FOUND", "traffic drift check not found", err.Error())
+		}
+		return ErrorResponse(c, http.StatusInternalServerError, "INTERNAL_ERROR", "failed to get traffic drift check", err.Error())
+	}
+	return SuccessResponse(c, http.StatusOK, check)
+}
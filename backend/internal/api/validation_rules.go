@@ -1,6 +1,7 @@
 package api
 
 import (
+	"errors"
 	"net/http"
 
 	"github.com/SpecForgeVC/SpecForge/internal/app"
@@ -57,6 +58,9 @@ func (h *ValidationRuleHandler) CreateValidationRule(c echo.Context) error {
 	userID := GetUserID(c)
 	rule, err := h.service.CreateValidationRule(c.Request().Context(), projectID, input.Name, input.RuleType, input.RuleConfig, input.Description, userID)
 	if err != nil {
+		if errors.Is(err, app.ErrInvalidValidationRule) {
+			return ErrorResponse(c, http.StatusBadRequest, "INVALID_RULE", "invalid validation rule", err.Error())
+		}
 		return ErrorResponse(c, http.StatusInternalServerError, "INTERNAL_ERROR", "failed to create validation rule", err.Error())
 	}
 	return SuccessResponse(c, http.StatusCreated, rule)
@@ -79,6 +83,9 @@ func (h *ValidationRuleHandler) UpdateValidationRule(c echo.Context) error {
 	userID := GetUserID(c)
 	rule, err := h.service.UpdateValidationRule(c.Request().Context(), id, input.Name, input.RuleType, input.RuleConfig, input.Description, userID)
 	if err != nil {
+		if errors.Is(err, app.ErrInvalidValidationRule) {
+			return ErrorResponse(c, http.StatusBadRequest, "INVALID_RULE", "invalid validation rule", err.Error())
+		}
 		return ErrorResponse(c, http.StatusInternalServerError, "INTERNAL_ERROR", "failed to update validation rule", err.Error())
 	}
 	return SuccessResponse(c, http.StatusOK, rule)
@@ -95,3 +102,29 @@ func (h *ValidationRuleHandler) DeleteValidationRule(c echo.Context) error {
 	}
 	return c.NoContent(http.StatusNoContent)
 }
+
+// EvaluateValidationRules runs the project's rules against a sample value or
+// payload and reports pass or fail per rule and location.
+func (h *ValidationRuleHandler) EvaluateValidationRules(c echo.Context) error {
+	projectID, err := uuid.Parse(c.Param("projectId"))
+	if err != nil {
+		return ErrorResponse(c, http.StatusBadRequest, "INVALID_ID", "invalid project id", err.Error())
+	}
+	var input struct {
+		RuleIDs  []uuid.UUID `json:"rule_ids"`
+		Variable string      `json:"variable"`
+		Value    interface{} `json:"value"`
+		Payload  interface{} `json:"payload"`
+	}
+	if err := c.Bind(&input); err != nil {
+		return ErrorResponse(c, http.StatusBadRequest, "INVALID_BODY", "failed to bind request", err.Error())
+	}
+	report, err := h.service.EvaluateValidationRules(c.Request().Context(), projectID, input.RuleIDs, input.Variable, input.Value, input.Payload)
+	if err != nil {
+		if errors.Is(err, app.ErrInvalidValidationRule) {
+			return ErrorResponse(c, http.StatusBadRequest, "INVALID_RULE", "invalid rule selection", err.Error())
+		}
+		return ErrorResponse(c, http.StatusInternalServerError, "INTERNAL_ERROR", "failed to evaluate validation rules", err.Error())
+	}
+	return SuccessResponse(c, http.StatusOK, report)
+}
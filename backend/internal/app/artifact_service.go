@@ -9,6 +9,8 @@ import (
 	"strings"
 	"time"
 
+	"github.com/SpecForgeVC/SpecForge/internal/asyncapi"
+	"github.com/SpecForgeVC/SpecForge/internal/clispec"
 	"github.com/SpecForgeVC/SpecForge/internal/domain"
 	"github.com/google/uuid"
 )
@@ -89,9 +91,35 @@ func (s *buildArtifactService) GenerateArtifact(ctx context.Context, roadmapItem
 			Version:      c.Version,
 			InputSchema:  c.InputSchema,
 			OutputSchema: c.OutputSchema,
+			Channels:     asyncapi.Channels(c),
+			CLI:          clispec.Command(c),
 		})
 	}
 
+	// 3a. Generate payload samples as test fixtures and property-test seeds.
+	// Contracts whose schemas do not compile are left to governance to flag.
+	testRequirements := make([]domain.TestSpecification, 0)
+	for _, c := range contracts {
+		examples, err := contractExamples(newRevision(&c, 0, domain.VersionBumpNone, uuid.Nil))
+		if err != nil {
+			continue
+		}
+		for _, kind := range []domain.PayloadKind{domain.PayloadInput, domain.PayloadOutput, domain.PayloadError} {
+			samples, ok := examples.Samples[kind]
+			if !ok {
+				continue
+			}
+			testRequirements = append(testRequirements, domain.TestSpecification{
+				Type: "PAYLOAD_SAMPLES",
+				Instruction: fmt.Sprintf("Accept the %d valid %s payload samples of %s contract v%s and reject each of the %d invalid ones for the constraint it names; use the samples as seeds for property-based tests.",
+					len(samples.Valid), strings.ToLower(string(kind)), c.ContractType, c.Version, len(samples.Invalid)),
+				ContractID: c.ID.String(),
+				Kind:       kind,
+				Samples:    samples,
+			})
+		}
+	}
+
 	// 4. Fetch Requirements (Acceptance Criteria)
 	reqs, err := s.requirementRepo.List(ctx, roadmapItemID)
 	if err != nil {
@@ -187,6 +215,7 @@ func (s *buildArtifactService) GenerateArtifact(ctx context.Context, roadmapItem
 		ValidationRules:       validationBundles,
 		Variables:             variableBundles,
 		AcceptanceCriteria:    acceptanceCriteria,
+		TestRequirements:      testRequirements,
 		BuildPrompts:          buildPrompts,
 		RefinementLoopPrompts: refinementPrompts,
 		GovernanceConstraints: govBundle,
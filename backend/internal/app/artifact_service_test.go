@@ -2,6 +2,7 @@ package app
 
 import (
 	"context"
+	"errors"
 	"testing"
 
 	"github.com/SpecForgeVC/SpecForge/internal/domain"
@@ -35,6 +36,9 @@ type mockContractRepo struct{ mock.Mock }
 func (m *mockContractRepo) Get(ctx context.Context, id uuid.UUID) (*domain.ContractDefinition, error) {
 	return nil, nil
 }
+func (m *mockContractRepo) GetForUpdate(ctx context.Context, id uuid.UUID) (*domain.ContractDefinition, error) {
+	return nil, nil
+}
 func (m *mockContractRepo) List(ctx context.Context, roadmapItemID uuid.UUID) ([]domain.ContractDefinition, error) {
 	args := m.Called(ctx, roadmapItemID)
 	return args.Get(0).([]domain.ContractDefinition), args.Error(1)
@@ -125,7 +129,10 @@ func (m *mockFiService) CalculateFeatureScore(ctx context.Context, featureID uui
 	return nil, nil
 }
 
-type mockGovService struct{ mock.Mock }
+type mockGovService struct {
+	mock.Mock
+	GovernanceService // policy management is not exercised here
+}
 
 func (m *mockGovService) CanBuildFeature(ctx context.Context, featureID uuid.UUID) (bool, []string, error) {
 	args := m.Called(ctx, featureID)
@@ -196,3 +203,48 @@ func TestArtifactService_GenerateArtifact(t *testing.T) {
 	govSvc.AssertExpectations(t)
 	fiSvc.AssertExpectations(t)
 }
+
+func TestArtifactService_EmbedsPayloadSamples(t *testing.T) {
+	ctx := context.Background()
+	item := &domain.RoadmapItem{ID: uuid.New(), ProjectID: uuid.New(), Title: "Orders"}
+	contract := domain.ContractDefinition{
+		ID:           uuid.New(),
+		ContractType: domain.REST,
+		Version:      "1.0.0",
+		InputSchema: map[string]interface{}{
+			"path":       "/orders",
+			"type":       "object",
+			"required":   []interface{}{"qty"},
+			"properties": map[string]interface{}{"qty": map[string]interface{}{"type": "integer", "minimum": 1.0}},
+		},
+		OutputSchema: map[string]interface{}{"type": "object"},
+	}
+
+	rmRepo := new(mockRoadmapRepo)
+	rmRepo.On("Get", ctx, item.ID).Return(item, nil)
+	cRepo := new(mockContractRepo)
+	cRepo.On("List", ctx, item.ID).Return([]domain.ContractDefinition{contract}, nil)
+	vRepo := new(mockVariableRepo)
+	vRepo.On("List", ctx, contract.ID).Return([]domain.VariableDefinition{}, nil)
+	reqRepo := new(mockRequirementRepo)
+	reqRepo.On("List", ctx, item.ID).Return([]domain.Requirement{}, nil)
+	valRepo := new(mockValidationRepo)
+	valRepo.On("List", ctx, item.ProjectID).Return([]domain.ValidationRule{}, nil)
+	fiSvc := new(mockFiService)
+	fiSvc.On("GetFeatureScore", ctx, item.ID).Return(nil, errors.New("no score"))
+
+	service := NewBuildArtifactService(rmRepo, cRepo, vRepo, reqRepo, valRepo, new(mockGovService), fiSvc)
+	pkg, err := service.GenerateArtifact(ctx, item.ID, domain.ExportFormatJSON, ExportOptions{}, uuid.New())
+	assert.NoError(t, err)
+
+	// The contract has no error schema, so only input and output samples are embedded.
+	if assert.Len(t, pkg.TestRequirements, 2) {
+		input := pkg.TestRequirements[0]
+		assert.Equal(t, "PAYLOAD_SAMPLES", input.Type)
+		assert.Equal(t, contract.ID.String(), input.ContractID)
+		assert.Equal(t, domain.PayloadInput, input.Kind)
+		assert.Equal(t, map[string]interface{}{"qty": 1.0}, input.Samples.Valid[0].Payload)
+		assert.NotEmpty(t, input.Samples.Invalid)
+		assert.Equal(t, domain.PayloadOutput, pkg.TestRequirements[1].Kind)
+	}
+}
@@ -15,13 +15,15 @@ type bootstrapService struct {
 	repo        BootstrapRepository
 	projectRepo ProjectRepository
 	sessionRepo ImportSessionRepository
+	uow         UnitOfWork
 }
 
-func NewBootstrapService(repo BootstrapRepository, projectRepo ProjectRepository, sessionRepo ImportSessionRepository) BootstrapService {
+func NewBootstrapService(repo BootstrapRepository, projectRepo ProjectRepository, sessionRepo ImportSessionRepository, uow UnitOfWork) BootstrapService {
 	return &bootstrapService{
 		repo:        repo,
 		projectRepo: projectRepo,
 		sessionRepo: sessionRepo,
+		uow:         uow,
 	}
 }
 
@@ -147,128 +149,138 @@ func (s *bootstrapService) IngestBootstrap(ctx context.Context, projectID uuid.U
 		warnings = append(warnings, "no APIs detected in analysis")
 	}
 
-	// Get next version
-	maxVersion, err := s.repo.GetMaxVersion(ctx, projectID)
-	if err != nil {
-		return nil, nil, fmt.Errorf("get max version: %w", err)
-	}
-
-	// Build snapshot JSON from payload
-	payloadBytes, _ := json.Marshal(payload)
-	var snapshotJSON map[string]interface{}
-	json.Unmarshal(payloadBytes, &snapshotJSON)
-
-	// Compute scores
-	scores := s.computeScores(payload)
+	// The snapshot and its normalized sub-resources are written together, so a
+	// failed insert leaves no partial snapshot behind.
+	var snapshot *domain.ProjectIntelligenceSnapshot
+	err := s.uow.Do(ctx, func(ctx context.Context, repos Repositories) error {
+		repo := repos.Bootstrap
+		// Get next version
+		maxVersion, err := repo.GetMaxVersion(ctx, projectID)
+		if err != nil {
+			return fmt.Errorf("get max version: %w", err)
+		}
 
-	// Build confidence map
-	confidence := s.computeConfidence(payload)
+		// Build snapshot JSON from payload
+		payloadBytes, _ := json.Marshal(payload)
+		var snapshotJSON map[string]interface{}
+		json.Unmarshal(payloadBytes, &snapshotJSON)
 
-	// Create snapshot
-	snapshot := &domain.ProjectIntelligenceSnapshot{
-		ProjectID:         projectID,
-		Version:           maxVersion + 1,
-		SnapshotJSON:      snapshotJSON,
-		ArchitectureScore: scores.ArchitectureScore,
-		ContractDensity:   scores.ContractDensity,
-		RiskScore:         scores.RiskScore,
-		AlignmentScore:    scores.AlignmentScore,
-		ConfidenceJSON:    confidence,
-	}
+		// Compute scores
+		scores := s.computeScores(payload)
 
-	if err := s.repo.InsertSnapshot(ctx, snapshot); err != nil {
-		return nil, nil, fmt.Errorf("insert snapshot: %w", err)
-	}
+		// Build confidence map
+		confidence := s.computeConfidence(payload)
 
-	// Insert normalized sub-resources
-	// Modules
-	for _, m := range payload.Modules {
-		module := &domain.ProjectModule{
+		// Create snapshot
+		snapshot = &domain.ProjectIntelligenceSnapshot{
 			ProjectID:         projectID,
-			SnapshotID:        snapshot.ID,
-			Name:              getStr(m, "name"),
-			Description:       getStr(m, "description"),
-			RiskLevel:         strings.ToUpper(getStr(m, "risk_level")),
-			ChangeSensitivity: strings.ToUpper(getStr(m, "change_sensitivity")),
-		}
-		if err := s.repo.InsertModule(ctx, module); err != nil {
-			warnings = append(warnings, fmt.Sprintf("failed to insert module %s: %v", module.Name, err))
+			Version:           maxVersion + 1,
+			SnapshotJSON:      snapshotJSON,
+			ArchitectureScore: scores.ArchitectureScore,
+			ContractDensity:   scores.ContractDensity,
+			RiskScore:         scores.RiskScore,
+			AlignmentScore:    scores.AlignmentScore,
+			ConfidenceJSON:    confidence,
 		}
-	}
 
-	// Data models → Entities
-	for _, dm := range payload.DataModels {
-		entity := &domain.ProjectEntity{
-			ProjectID:  projectID,
-			SnapshotID: snapshot.ID,
-			Name:       getStr(dm, "name"),
-		}
-		if rels, ok := dm["relationships"]; ok {
-			relBytes, _ := json.Marshal(rels)
-			var relMap map[string]interface{}
-			json.Unmarshal(relBytes, &relMap)
-			entity.RelationshipsJSON = relMap
-		}
-		if cons, ok := dm["constraints"]; ok {
-			conBytes, _ := json.Marshal(cons)
-			var conMap map[string]interface{}
-			json.Unmarshal(conBytes, &conMap)
-			entity.ConstraintsJSON = conMap
+		if err := repo.InsertSnapshot(ctx, snapshot); err != nil {
+			return fmt.Errorf("insert snapshot: %w", err)
 		}
-		if err := s.repo.InsertEntity(ctx, entity); err != nil {
-			warnings = append(warnings, fmt.Sprintf("failed to insert entity %s: %v", entity.Name, err))
-		}
-	}
 
-	// APIs
-	for _, a := range payload.APIs {
-		entry := &domain.ProjectApiEntry{
-			ProjectID:  projectID,
-			SnapshotID: snapshot.ID,
-			Endpoint:   getStr(a, "endpoint"),
-			Method:     strings.ToUpper(getStr(a, "method")),
-			AuthType:   getStr(a, "auth_type"),
-		}
-		if rs, ok := a["request_schema"]; ok {
-			rsBytes, _ := json.Marshal(rs)
-			var rsMap map[string]interface{}
-			json.Unmarshal(rsBytes, &rsMap)
-			entry.RequestSchema = rsMap
-		}
-		if rs, ok := a["response_schema"]; ok {
-			rsBytes, _ := json.Marshal(rs)
-			var rsMap map[string]interface{}
-			json.Unmarshal(rsBytes, &rsMap)
-			entry.ResponseSchema = rsMap
-		}
-		if err := s.repo.InsertApiEntry(ctx, entry); err != nil {
-			warnings = append(warnings, fmt.Sprintf("failed to insert api %s: %v", entry.Endpoint, err))
+		// Insert normalized sub-resources
+		// Modules
+		for _, m := range payload.Modules {
+			module := &domain.ProjectModule{
+				ProjectID:         projectID,
+				SnapshotID:        snapshot.ID,
+				Name:              getStr(m, "name"),
+				Description:       getStr(m, "description"),
+				RiskLevel:         strings.ToUpper(getStr(m, "risk_level")),
+				ChangeSensitivity: strings.ToUpper(getStr(m, "change_sensitivity")),
+			}
+			if err := repo.InsertModule(ctx, module); err != nil {
+				return fmt.Errorf("insert module %s: %w", module.Name, err)
+			}
 		}
-	}
 
-	// Contracts
-	for _, c := range payload.Contracts {
-		contractEntry := &domain.ProjectContractEntry{
-			ProjectID:    projectID,
-			SnapshotID:   snapshot.ID,
-			Name:         getStr(c, "name"),
-			ContractType: getStr(c, "contract_type"),
-			SourceModule: getStr(c, "source_module"),
-		}
-		if schema, ok := c["schema"]; ok {
-			schemaBytes, _ := json.Marshal(schema)
-			var schemaMap map[string]interface{}
-			json.Unmarshal(schemaBytes, &schemaMap)
-			contractEntry.SchemaJSON = schemaMap
+		// Data models → Entities
+		for _, dm := range payload.DataModels {
+			entity := &domain.ProjectEntity{
+				ProjectID:  projectID,
+				SnapshotID: snapshot.ID,
+				Name:       getStr(dm, "name"),
+			}
+			if rels, ok := dm["relationships"]; ok {
+				relBytes, _ := json.Marshal(rels)
+				var relMap map[string]interface{}
+				json.Unmarshal(relBytes, &relMap)
+				entity.RelationshipsJSON = relMap
+			}
+			if cons, ok := dm["constraints"]; ok {
+				conBytes, _ := json.Marshal(cons)
+				var conMap map[string]interface{}
+				json.Unmarshal(conBytes, &conMap)
+				entity.ConstraintsJSON = conMap
+			}
+			if err := repo.InsertEntity(ctx, entity); err != nil {
+				return fmt.Errorf("insert entity %s: %w", entity.Name, err)
+			}
 		}
-		if score, ok := c["stability_score"]; ok {
-			if f, ok := score.(float64); ok {
-				contractEntry.StabilityScore = f
+
+		// APIs
+		for _, a := range payload.APIs {
+			entry := &domain.ProjectApiEntry{
+				ProjectID:  projectID,
+				SnapshotID: snapshot.ID,
+				Endpoint:   getStr(a, "endpoint"),
+				Method:     strings.ToUpper(getStr(a, "method")),
+				AuthType:   getStr(a, "auth_type"),
+			}
+			if rs, ok := a["request_schema"]; ok {
+				rsBytes, _ := json.Marshal(rs)
+				var rsMap map[string]interface{}
+				json.Unmarshal(rsBytes, &rsMap)
+				entry.RequestSchema = rsMap
+			}
+			if rs, ok := a["response_schema"]; ok {
+				rsBytes, _ := json.Marshal(rs)
+				var rsMap map[string]interface{}
+				json.Unmarshal(rsBytes, &rsMap)
+				entry.ResponseSchema = rsMap
+			}
+			if err := repo.InsertApiEntry(ctx, entry); err != nil {
+				return fmt.Errorf("insert api %s: %w", entry.Endpoint, err)
 			}
 		}
-		if err := s.repo.InsertContractEntry(ctx, contractEntry); err != nil {
-			warnings = append(warnings, fmt.Sprintf("failed to insert contract %s: %v", contractEntry.Name, err))
+
+		// Contracts
+		for _, c := range payload.Contracts {
+			contractEntry := &domain.ProjectContractEntry{
+				ProjectID:    projectID,
+				SnapshotID:   snapshot.ID,
+				Name:         getStr(c, "name"),
+				ContractType: getStr(c, "contract_type"),
+				SourceModule: getStr(c, "source_module"),
+			}
+			if schema, ok := c["schema"]; ok {
+				schemaBytes, _ := json.Marshal(schema)
+				var schemaMap map[string]interface{}
+				json.Unmarshal(schemaBytes, &schemaMap)
+				contractEntry.SchemaJSON = schemaMap
+			}
+			if score, ok := c["stability_score"]; ok {
+				if f, ok := score.(float64); ok {
+					contractEntry.StabilityScore = f
+				}
+			}
+			if err := repo.InsertContractEntry(ctx, contractEntry); err != nil {
+				return fmt.Errorf("insert contract %s: %w", contractEntry.Name, err)
+			}
 		}
+		return nil
+	})
+	if err != nil {
+		return nil, nil, err
 	}
 
 	return snapshot, warnings, nil
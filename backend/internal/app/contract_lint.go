@@ -0,0 +1,161 @@
+package app
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/SpecForgeVC/SpecForge/internal/domain"
+	"github.com/SpecForgeVC/SpecForge/internal/schemalint"
+	"github.com/google/uuid"
+)
+
+// ErrInvalidLintRuleset wraps ruleset validation failures.
+var ErrInvalidLintRuleset = errors.New("invalid schema lint ruleset")
+
+// SchemaLintError rejects a save whose schemas have error-level lint
+// findings. It wraps ErrInvalidContractSchema.
+type SchemaLintError struct {
+	Report schemalint.Report
+}
+
+func (e *SchemaLintError) Error() string {
+	for _, f := range e.Report.Findings {
+		if f.Severity == schemalint.Error {
+			return fmt.Sprintf("schema lint found %d error(s): %s%s: %s", e.Report.Errors, f.Schema, f.Pointer, f.Message)
+		}
+	}
+	return fmt.Sprintf("schema lint found %d error(s)", e.Report.Errors)
+}
+
+func (e *SchemaLintError) Unwrap() error {
+	return ErrInvalidContractSchema
+}
+
+// LintContract lints the stored contract with its project's ruleset.
+func (s *contractService) LintContract(ctx context.Context, id uuid.UUID) (*domain.ContractLint, error) {
+	c, err := s.repo.Get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	lint, err := projectLint(ctx, s.repositories(), c)
+	if err != nil {
+		return nil, err
+	}
+	lint.ContractID = &c.ID
+	lint.Version = c.Version
+	return lint, nil
+}
+
+// LintContractDraft lints schemas for a contract on the roadmap item without
+// saving them, reporting what a create would reject or warn about.
+func (s *contractService) LintContractDraft(ctx context.Context, roadmapItemID uuid.UUID, cType domain.ContractType, input, output, errSchema map[string]interface{}) (*domain.ContractLint, error) {
+	return projectLint(ctx, s.repositories(), &domain.ContractDefinition{
+		RoadmapItemID: roadmapItemID,
+		ContractType:  cType,
+		InputSchema:   input,
+		OutputSchema:  output,
+		ErrorSchema:   errSchema,
+	})
+}
+
+// GetLintRuleset returns the project's lint ruleset, or the default ruleset
+// if none is stored.
+func (s *contractService) GetLintRuleset(ctx context.Context, projectID uuid.UUID) (*domain.ProjectSchemaLintRuleset, error) {
+	return projectLintRuleset(ctx, s.lintRulesets, projectID)
+}
+
+func projectLintRuleset(ctx context.Context, rulesets SchemaLintRulesetRepository, projectID uuid.UUID) (*domain.ProjectSchemaLintRuleset, error) {
+	if rulesets == nil {
+		return &domain.ProjectSchemaLintRuleset{ProjectID: projectID, Ruleset: schemalint.DefaultRuleset()}, nil
+	}
+	r, err := rulesets.Get(ctx, projectID)
+	if err != nil {
+		return nil, err
+	}
+	if r == nil {
+		return &domain.ProjectSchemaLintRuleset{ProjectID: projectID, Ruleset: schemalint.DefaultRuleset()}, nil
+	}
+	return r, nil
+}
+
+func (s *contractService) UpdateLintRuleset(ctx context.Context, projectID uuid.UUID, ruleset schemalint.Ruleset, userID uuid.UUID) (*domain.ProjectSchemaLintRuleset, error) {
+	if err := ruleset.Validate(); err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidLintRuleset, err)
+	}
+	r := &domain.ProjectSchemaLintRuleset{
+		ProjectID: projectID,
+		Ruleset:   ruleset,
+		UpdatedBy: userID,
+		UpdatedAt: time.Now(),
+	}
+	if err := s.lintRulesets.Upsert(ctx, r); err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+// checkLint rejects a contract whose schemas have error-level findings under
+// its project's ruleset.
+func checkLint(ctx context.Context, repos Repositories, c *domain.ContractDefinition) error {
+	lint, err := projectLint(ctx, repos, c)
+	if err != nil {
+		return err
+	}
+	if !lint.Report.Passed {
+		return &SchemaLintError{Report: lint.Report}
+	}
+	return nil
+}
+
+// projectLint lints c with its project's ruleset, read through repos.
+// Without a ruleset repository the default ruleset applies.
+func projectLint(ctx context.Context, repos Repositories, c *domain.ContractDefinition) (*domain.ContractLint, error) {
+	lint := &domain.ContractLint{}
+	rules := schemalint.DefaultRuleset()
+	if repos.SchemaLintRulesets != nil {
+		item, err := repos.RoadmapItems.Get(ctx, c.RoadmapItemID)
+		if err != nil {
+			return nil, err
+		}
+		project, err := projectLintRuleset(ctx, repos.SchemaLintRulesets, item.ProjectID)
+		if err != nil {
+			return nil, err
+		}
+		lint.ProjectID, rules = item.ProjectID, project.Ruleset
+	}
+	lint.Report = lintContract(c, rules)
+	return lint, nil
+}
+
+// lintContract lints the JSON schemas of a contract. Schemas holding a
+// GraphQL, AsyncAPI, CLI or OpenAPI document are checked by their own
+// validators and skipped here.
+func lintContract(c *domain.ContractDefinition, rules schemalint.Ruleset) schemalint.Report {
+	_, embedsOpenAPI := c.InputSchema["openapi"]
+	contract := schemalint.Contract{
+		REST:           c.ContractType == domain.REST,
+		HasErrorSchema: len(c.ErrorSchema) > 0 || embedsOpenAPI,
+	}
+	for _, s := range []struct {
+		name   string
+		schema map[string]interface{}
+	}{
+		{"input_schema", c.InputSchema},
+		{"output_schema", c.OutputSchema},
+		{"error_schema", c.ErrorSchema},
+	} {
+		schema := s.schema
+		if len(schema) == 0 || isSchemaDocument(schema) {
+			continue
+		}
+		if s.name == "input_schema" && c.ContractType == domain.REST {
+			if schema = stripRouteHints(schema); len(schema) == 0 {
+				continue
+			}
+		}
+		contract.Schemas = append(contract.Schemas, schemalint.Schema{Name: s.name, Document: schema})
+	}
+	return schemalint.Lint(contract, rules)
+}
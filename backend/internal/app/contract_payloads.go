@@ -0,0 +1,198 @@
+package app
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/SpecForgeVC/SpecForge/internal/asyncapi"
+	"github.com/SpecForgeVC/SpecForge/internal/clispec"
+	"github.com/SpecForgeVC/SpecForge/internal/domain"
+	"github.com/SpecForgeVC/SpecForge/internal/graphql"
+	"github.com/SpecForgeVC/SpecForge/internal/openapi"
+	"github.com/SpecForgeVC/SpecForge/internal/payload"
+	"github.com/google/uuid"
+)
+
+// MaxPayloadBatch bounds the number of payloads checked in one call.
+const MaxPayloadBatch = 1000
+
+var (
+	ErrInvalidPayloadRequest = errors.New("invalid payload validation request")
+	ErrNoPayloadSchema       = errors.New("contract has no JSON schema for this payload kind")
+)
+
+// ValidatePayloads checks each payload against the input, output or error
+// schema of the contract at a version. An empty version selects the current
+// contract; otherwise the newest revision saved with that version is used.
+// A payload failing validation is reported in the result, not as an error.
+func (s *contractService) ValidatePayloads(ctx context.Context, contractID uuid.UUID, version string, kind domain.PayloadKind, payloads []interface{}) (*domain.PayloadValidation, error) {
+	if len(payloads) == 0 {
+		return nil, fmt.Errorf("%w: at least one payload is required", ErrInvalidPayloadRequest)
+	}
+	if len(payloads) > MaxPayloadBatch {
+		return nil, fmt.Errorf("%w: at most %d payloads can be checked at once, got %d", ErrInvalidPayloadRequest, MaxPayloadBatch, len(payloads))
+	}
+	rev, err := s.revisionAt(ctx, contractID, version)
+	if err != nil {
+		return nil, err
+	}
+	schema, err := payloadSchema(rev, kind)
+	if err != nil {
+		return nil, err
+	}
+	validator, err := payload.Compile(schema)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s schema of version %s: %v", ErrInvalidContractSchema, kind, rev.Version, err)
+	}
+
+	report := &domain.PayloadValidation{
+		ContractID: contractID,
+		Revision:   rev.Revision,
+		Version:    rev.Version,
+		Kind:       kind,
+		Valid:      true,
+		Results:    validator.ValidateAll(payloads),
+	}
+	for _, r := range report.Results {
+		if !r.Valid {
+			report.Valid = false
+			report.Invalid++
+		}
+	}
+	return report, nil
+}
+
+// GenerateExamples builds deterministic valid and labelled invalid sample
+// payloads from the contract's schemas at a version, selected as in
+// ValidatePayloads.
+func (s *contractService) GenerateExamples(ctx context.Context, contractID uuid.UUID, version string) (*domain.ContractExamples, error) {
+	rev, err := s.revisionAt(ctx, contractID, version)
+	if err != nil {
+		return nil, err
+	}
+	return contractExamples(rev)
+}
+
+// contractExamples generates samples for every kind of payload the revision
+// has a JSON schema for.
+func contractExamples(rev *domain.ContractRevision) (*domain.ContractExamples, error) {
+	ex := &domain.ContractExamples{
+		ContractID: rev.ContractID,
+		Revision:   rev.Revision,
+		Version:    rev.Version,
+		Samples:    make(map[domain.PayloadKind]*payload.Examples),
+	}
+	for _, kind := range []domain.PayloadKind{domain.PayloadInput, domain.PayloadOutput, domain.PayloadError} {
+		schema, err := payloadSchema(rev, kind)
+		if errors.Is(err, ErrNoPayloadSchema) {
+			if ex.Unavailable == nil {
+				ex.Unavailable = make(map[domain.PayloadKind]string)
+			}
+			ex.Unavailable[kind] = err.Error()
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+		samples, err := payload.Generate(schema)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %s schema of version %s: %v", ErrInvalidContractSchema, kind, rev.Version, err)
+		}
+		ex.Samples[kind] = samples
+	}
+	return ex, nil
+}
+
+// revisionAt returns the contract as saved at version. An empty version
+// selects the current contract, numbered as its latest revision. Contracts
+// created before revision tracking have only their current state, reported
+// as revision 1.
+func (s *contractService) revisionAt(ctx context.Context, contractID uuid.UUID, version string) (*domain.ContractRevision, error) {
+	if version == "" {
+		c, err := s.repo.Get(ctx, contractID)
+		if err != nil {
+			return nil, err
+		}
+		latest, err := s.revisions.GetLatest(ctx, contractID)
+		if err != nil {
+			return nil, err
+		}
+		number := 1
+		if latest != nil {
+			number = latest.Revision
+		}
+		return newRevision(c, number, domain.VersionBumpNone, uuid.Nil), nil
+	}
+
+	revisions, err := s.revisions.List(ctx, contractID)
+	if err != nil {
+		return nil, err
+	}
+	want, semver := domain.ParseSemVer(version)
+	var found *domain.ContractRevision
+	for i := range revisions {
+		r := &revisions[i]
+		matches := r.Version == version
+		if v, err := domain.ParseSemVer(r.Version); !matches && semver == nil && err == nil {
+			matches = v.Compare(want) == 0
+		}
+		if matches && (found == nil || r.Revision > found.Revision) {
+			found = r
+		}
+	}
+	if found == nil {
+		if len(revisions) == 0 {
+			if c, err := s.repo.Get(ctx, contractID); err == nil && c.Version == version {
+				return newRevision(c, 1, domain.VersionBumpNone, uuid.Nil), nil
+			}
+		}
+		return nil, fmt.Errorf("contract %s has no version %s: %w", contractID, version, sql.ErrNoRows)
+	}
+	return found, nil
+}
+
+// payloadSchema returns the JSON schema payloads of kind must satisfy, with
+// local $refs inlined. Schemas holding a GraphQL, AsyncAPI, CLI or embedded
+// OpenAPI document do not describe a single payload and are refused.
+func payloadSchema(rev *domain.ContractRevision, kind domain.PayloadKind) (map[string]interface{}, error) {
+	schema := rev.Schema(kind)
+	if len(schema) == 0 {
+		return nil, fmt.Errorf("%w: version %s has no %s schema", ErrNoPayloadSchema, rev.Version, kind)
+	}
+	if _, ok := schema["openapi"]; ok {
+		return nil, fmt.Errorf("%w: the %s schema of version %s embeds an OpenAPI document", ErrNoPayloadSchema, kind, rev.Version)
+	}
+	if isSchemaDocument(schema) {
+		return nil, fmt.Errorf("%w: the %s schema of version %s is a %s document", ErrNoPayloadSchema, kind, rev.Version, rev.ContractType)
+	}
+	if kind == domain.PayloadInput && rev.ContractType == domain.REST {
+		schema = stripRouteHints(schema)
+	}
+	resolved, err := openapi.ResolveRefs(schema)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s schema of version %s: %v", ErrInvalidContractSchema, kind, rev.Version, err)
+	}
+	return resolved, nil
+}
+
+// isSchemaDocument reports whether a contract schema holds a GraphQL,
+// AsyncAPI, CLI or OpenAPI document rather than a JSON schema.
+func isSchemaDocument(schema map[string]interface{}) bool {
+	_, sdl := schema[graphql.SDLKey]
+	_, oas := schema["openapi"]
+	return sdl || oas || asyncapi.IsAsyncAPI(schema) || clispec.IsCLI(schema)
+}
+
+// stripRouteHints drops the route hints plain REST contracts keep next to
+// their request schema.
+func stripRouteHints(schema map[string]interface{}) map[string]interface{} {
+	stripped := make(map[string]interface{}, len(schema))
+	for k, v := range schema {
+		if k != "path" && k != "endpoint" && k != "method" {
+			stripped[k] = v
+		}
+	}
+	return stripped
+}
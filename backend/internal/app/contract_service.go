@@ -2,27 +2,64 @@ package app
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"time"
 
+	"github.com/SpecForgeVC/SpecForge/internal/asyncapi"
+	"github.com/SpecForgeVC/SpecForge/internal/clispec"
 	"github.com/SpecForgeVC/SpecForge/internal/domain"
+	"github.com/SpecForgeVC/SpecForge/internal/domain/drift"
+	"github.com/SpecForgeVC/SpecForge/internal/graphql"
+	"github.com/SpecForgeVC/SpecForge/internal/openapi"
 	"github.com/google/uuid"
 )
 
+var (
+	ErrInvalidContractVersion = errors.New("contract version must be a semantic version (MAJOR.MINOR.PATCH)")
+	ErrInvalidContractSchema  = errors.New("invalid contract schema")
+	// ErrContractLocked rejects manual changes while governance holds the contract.
+	ErrContractLocked = errors.New("governance check failed")
+)
+
+// VersionBumpError rejects an update whose version change is smaller than the
+// drift between the current and proposed schemas requires.
+type VersionBumpError struct {
+	Current  string             `json:"current_version"`
+	Proposed string             `json:"proposed_version"`
+	Required domain.VersionBump `json:"required_bump"`
+	Actual   domain.VersionBump `json:"actual_bump"`
+	Report   drift.DriftReport  `json:"report"`
+}
+
+func (e *VersionBumpError) Error() string {
+	if e.Actual == "" {
+		return fmt.Sprintf("version %s is lower than current version %s", e.Proposed, e.Current)
+	}
+	return fmt.Sprintf("changes require a %s version bump from %s, got %s (%s)", e.Required, e.Current, e.Proposed, e.Actual)
+}
+
 type contractService struct {
 	repo                ContractRepository
+	revisions           ContractRevisionRepository
 	roadmapRepo         RoadmapItemRepository
 	featureIntelligence FeatureIntelligenceService
 	governance          GovernanceService
 	alignment           AlignmentService
+	lintRulesets        SchemaLintRulesetRepository
+	uow                 UnitOfWork
 }
 
-func NewContractService(repo ContractRepository, roadmapRepo RoadmapItemRepository, fi FeatureIntelligenceService, gov GovernanceService, alignment AlignmentService) ContractService {
+func NewContractService(repo ContractRepository, revisions ContractRevisionRepository, roadmapRepo RoadmapItemRepository, fi FeatureIntelligenceService, gov GovernanceService, alignment AlignmentService, lintRulesets SchemaLintRulesetRepository, uow UnitOfWork) ContractService {
 	return &contractService{
 		repo:                repo,
+		revisions:           revisions,
 		roadmapRepo:         roadmapRepo,
 		featureIntelligence: fi,
 		governance:          gov,
 		alignment:           alignment,
+		lintRulesets:        lintRulesets,
+		uow:                 uow,
 	}
 }
 
@@ -38,7 +75,17 @@ func (s *contractService) ListContractsByProject(ctx context.Context, projectID
 	return s.repo.ListByProject(ctx, projectID)
 }
 
-func (s *contractService) CreateContract(ctx context.Context, roadmapItemID uuid.UUID, cType domain.ContractType, version string, input, output, errSchema map[string]interface{}) (*domain.ContractDefinition, error) {
+func (s *contractService) CreateContract(ctx context.Context, roadmapItemID uuid.UUID, cType domain.ContractType, version string, input, output, errSchema map[string]interface{}, userID uuid.UUID) (*domain.ContractDefinition, error) {
+	if version == "" {
+		version = "1.0.0"
+	}
+	if _, err := domain.ParseSemVer(version); err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidContractVersion, err)
+	}
+	if err := validateContractSchema(cType, input); err != nil {
+		return nil, err
+	}
+
 	c := &domain.ContractDefinition{
 		ID:                 uuid.New(),
 		RoadmapItemID:      roadmapItemID,
@@ -49,7 +96,19 @@ func (s *contractService) CreateContract(ctx context.Context, roadmapItemID uuid
 		ErrorSchema:        errSchema,
 		BackwardCompatible: true,
 	}
-	if err := s.repo.Create(ctx, c); err != nil {
+	if err := checkLint(ctx, s.repositories(), c); err != nil {
+		return nil, err
+	}
+	err := s.uow.Do(ctx, func(ctx context.Context, repos Repositories) error {
+		if err := repos.Contracts.Create(ctx, c); err != nil {
+			return err
+		}
+		if err := repos.ContractRevisions.Create(ctx, newRevision(c, 1, domain.VersionBumpNone, userID)); err != nil {
+			return fmt.Errorf("failed to record contract revision: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
 		return nil, err
 	}
 
@@ -84,33 +143,41 @@ func (s *contractService) DeleteContract(ctx context.Context, id uuid.UUID) erro
 	return nil
 }
 
-func (s *contractService) UpdateContract(ctx context.Context, id uuid.UUID, cType domain.ContractType, version string, input, output, errSchema map[string]interface{}) (*domain.ContractDefinition, error) {
+func (s *contractService) UpdateContract(ctx context.Context, id uuid.UUID, cType domain.ContractType, version string, input, output, errSchema map[string]interface{}, deprecatedFields []string, userID uuid.UUID) (*domain.ContractDefinition, error) {
 	// Governance Check
 	allowed, reasons, err := s.governance.CanUpdateContract(ctx, id)
 	if err != nil {
 		return nil, err
 	}
 	if !allowed {
-		return nil, fmt.Errorf("governance check failed: %v", reasons)
+		return nil, fmt.Errorf("%w: %v", ErrContractLocked, reasons)
 	}
 
-	old, err := s.repo.Get(ctx, id)
+	var old, c *domain.ContractDefinition
+	err = s.uow.Do(ctx, func(ctx context.Context, repos Repositories) error {
+		var err error
+		if old, err = repos.Contracts.GetForUpdate(ctx, id); err != nil {
+			return err
+		}
+		if deprecatedFields == nil {
+			deprecatedFields = old.DeprecatedFields
+		}
+		c = &domain.ContractDefinition{
+			ID:               id,
+			RoadmapItemID:    old.RoadmapItemID,
+			ContractType:     cType,
+			Version:          version,
+			InputSchema:      input,
+			OutputSchema:     output,
+			ErrorSchema:      errSchema,
+			DeprecatedFields: deprecatedFields,
+			CreatedAt:        old.CreatedAt,
+		}
+		return saveContractUpdate(ctx, repos, old, c, userID)
+	})
 	if err != nil {
 		return nil, err
 	}
-	c := &domain.ContractDefinition{
-		ID:                 id,
-		RoadmapItemID:      old.RoadmapItemID,
-		ContractType:       cType,
-		Version:            version,
-		InputSchema:        input,
-		OutputSchema:       output,
-		ErrorSchema:        errSchema,
-		BackwardCompatible: old.BackwardCompatible,
-	}
-	if err := s.repo.Update(ctx, c); err != nil {
-		return nil, err
-	}
 
 	// Trigger intelligence recalculation
 	_, _ = s.featureIntelligence.CalculateFeatureScore(ctx, old.RoadmapItemID)
@@ -122,3 +189,224 @@ func (s *contractService) UpdateContract(ctx context.Context, id uuid.UUID, cTyp
 
 	return c, nil
 }
+
+// repositories are the repositories the service reads through outside a unit
+// of work.
+func (s *contractService) repositories() Repositories {
+	return Repositories{
+		RoadmapItems:       s.roadmapRepo,
+		Contracts:          s.repo,
+		ContractRevisions:  s.revisions,
+		SchemaLintRulesets: s.lintRulesets,
+	}
+}
+
+// saveContractUpdate writes c over the stored contract old after the checks
+// every contract change goes through: the project's lint ruleset and the
+// version bump the drift between old and c requires. The change is recorded
+// as a new revision. Governance is checked by the caller, which runs this in a
+// unit of work after reading old with Contracts.GetForUpdate so that
+// concurrent updates cannot claim the same revision number.
+func saveContractUpdate(ctx context.Context, repos Repositories, old, c *domain.ContractDefinition, userID uuid.UUID) error {
+	if err := validateContractSchema(c.ContractType, c.InputSchema); err != nil {
+		return err
+	}
+	if err := checkLint(ctx, repos, c); err != nil {
+		return err
+	}
+
+	// Contracts created before revision tracking get their current state recorded first.
+	latest, err := repos.ContractRevisions.GetLatest(ctx, old.ID)
+	if err != nil {
+		return err
+	}
+	if latest == nil {
+		latest = newRevision(old, 1, domain.VersionBumpNone, uuid.Nil)
+		if err := repos.ContractRevisions.Create(ctx, latest); err != nil {
+			return fmt.Errorf("failed to record contract revision: %w", err)
+		}
+	}
+
+	report, bump, err := checkVersionBump(old, c)
+	if err != nil {
+		return err
+	}
+	c.BackwardCompatible = report.BreakingChanges == 0 && report.CriticalChanges == 0
+
+	if err := repos.Contracts.Update(ctx, c); err != nil {
+		return err
+	}
+	if err := repos.ContractRevisions.Create(ctx, newRevision(c, latest.Revision+1, bump, userID)); err != nil {
+		return fmt.Errorf("failed to record contract revision: %w", err)
+	}
+	return nil
+}
+
+func (s *contractService) ListRevisions(ctx context.Context, contractID uuid.UUID) ([]domain.ContractRevision, error) {
+	return s.revisions.List(ctx, contractID)
+}
+
+func (s *contractService) GetRevision(ctx context.Context, contractID uuid.UUID, revision int) (*domain.ContractRevision, error) {
+	return s.revisions.Get(ctx, contractID, revision)
+}
+
+func (s *contractService) DiffRevisions(ctx context.Context, contractID uuid.UUID, from, to int) (*domain.ContractRevisionDiff, error) {
+	fromRev, err := s.revisions.Get(ctx, contractID, from)
+	if err != nil {
+		return nil, fmt.Errorf("revision %d: %w", from, err)
+	}
+	toRev, err := s.revisions.Get(ctx, contractID, to)
+	if err != nil {
+		return nil, fmt.Errorf("revision %d: %w", to, err)
+	}
+
+	report, err := diffContracts(revisionContract(fromRev), revisionContract(toRev))
+	if err != nil {
+		return nil, err
+	}
+
+	diff := &domain.ContractRevisionDiff{
+		ContractID:   contractID,
+		From:         *fromRev,
+		To:           *toRev,
+		RequiredBump: domain.RequiredBump(report),
+		ActualBump:   domain.VersionBumpNone,
+		Report:       report,
+	}
+	fromVer, fromErr := domain.ParseSemVer(fromRev.Version)
+	toVer, toErr := domain.ParseSemVer(toRev.Version)
+	if fromErr == nil && toErr == nil {
+		diff.ActualBump = fromVer.BumpTo(toVer)
+	}
+	return diff, nil
+}
+
+// checkVersionBump runs the drift engine between the stored and proposed contract
+// and enforces semver: breaking drift needs a major bump, additive drift a minor one.
+// Contracts still carrying a free-form legacy version are only checked for a valid new version.
+func checkVersionBump(old, proposed *domain.ContractDefinition) (drift.DriftReport, domain.VersionBump, error) {
+	next, err := domain.ParseSemVer(proposed.Version)
+	if err != nil {
+		return drift.DriftReport{}, "", fmt.Errorf("%w: %v", ErrInvalidContractVersion, err)
+	}
+
+	report, err := diffContracts(old, proposed)
+	if err != nil {
+		return drift.DriftReport{}, "", err
+	}
+	required := domain.RequiredBump(report)
+
+	current, err := domain.ParseSemVer(old.Version)
+	if err != nil {
+		return report, required, nil
+	}
+	if next.Compare(current) < 0 {
+		return report, "", &VersionBumpError{Current: old.Version, Proposed: proposed.Version, Required: required, Report: report}
+	}
+	actual := current.BumpTo(next)
+	if !actual.Satisfies(required) {
+		return report, "", &VersionBumpError{Current: old.Version, Proposed: proposed.Version, Required: required, Actual: actual, Report: report}
+	}
+	return report, actual, nil
+}
+
+// validateContractSchema applies type-specific checks before a contract is saved.
+func validateContractSchema(cType domain.ContractType, input map[string]interface{}) error {
+	switch cType {
+	case domain.GraphQL:
+		if err := graphql.ValidateContract(input); err != nil {
+			return fmt.Errorf("%w: %v", ErrInvalidContractSchema, err)
+		}
+	case domain.Event:
+		if err := asyncapi.ValidateContract(input); err != nil {
+			return fmt.Errorf("%w: %v", ErrInvalidContractSchema, err)
+		}
+	case domain.CLI:
+		if err := clispec.ValidateContract(input); err != nil {
+			return fmt.Errorf("%w: %v", ErrInvalidContractSchema, err)
+		}
+	}
+	return nil
+}
+
+func diffContracts(baseline, proposed *domain.ContractDefinition) (drift.DriftReport, error) {
+	if baseline.ContractType == domain.GraphQL && proposed.ContractType == domain.GraphQL {
+		baseSDL, baseOK := graphql.SDL(*baseline)
+		propSDL, propOK := graphql.SDL(*proposed)
+		switch {
+		case baseOK && propOK:
+			return graphql.Compare(baseSDL, propSDL)
+		case baseOK:
+			return drift.DriftReport{}, fmt.Errorf("proposed contract: %w", graphql.ErrMissingSDL)
+		case propOK:
+			// A legacy contract gaining its first SDL: every type is new.
+			return graphql.Compare("", propSDL)
+		}
+		// Legacy contracts without SDL on either side are compared by their
+		// JSON Schemas, which FromContracts skips for GraphQL contracts.
+		legacyBase, legacyProp := *baseline, *proposed
+		legacyBase.ContractType, legacyProp.ContractType = domain.REST, domain.REST
+		baseline, proposed = &legacyBase, &legacyProp
+	}
+	if asyncapi.IsAsyncAPI(baseline.InputSchema) && asyncapi.IsAsyncAPI(proposed.InputSchema) {
+		base, err := asyncapi.FromMap(baseline.InputSchema)
+		if err != nil {
+			return drift.DriftReport{}, fmt.Errorf("baseline contract: %w", err)
+		}
+		next, err := asyncapi.FromMap(proposed.InputSchema)
+		if err != nil {
+			return drift.DriftReport{}, fmt.Errorf("proposed contract: %w", err)
+		}
+		return asyncapi.Compare(base, next), nil
+	}
+	if clispec.IsCLI(baseline.InputSchema) && clispec.IsCLI(proposed.InputSchema) {
+		base, err := clispec.Parse(baseline.InputSchema)
+		if err != nil {
+			return drift.DriftReport{}, fmt.Errorf("baseline contract: %w", err)
+		}
+		next, err := clispec.Parse(proposed.InputSchema)
+		if err != nil {
+			return drift.DriftReport{}, fmt.Errorf("proposed contract: %w", err)
+		}
+		return clispec.Compare(base, next), nil
+	}
+
+	base, err := openapi.FromContracts([]domain.ContractDefinition{*baseline})
+	if err != nil {
+		return drift.DriftReport{}, fmt.Errorf("baseline contract: %w", err)
+	}
+	next, err := openapi.FromContracts([]domain.ContractDefinition{*proposed})
+	if err != nil {
+		return drift.DriftReport{}, fmt.Errorf("proposed contract: %w", err)
+	}
+	return drift.DetectDrift(drift.DriftInput{Baseline: *base, Proposed: *next}), nil
+}
+
+func newRevision(c *domain.ContractDefinition, number int, bump domain.VersionBump, userID uuid.UUID) *domain.ContractRevision {
+	return &domain.ContractRevision{
+		ID:               uuid.New(),
+		ContractID:       c.ID,
+		Revision:         number,
+		ContractType:     c.ContractType,
+		Version:          c.Version,
+		InputSchema:      c.InputSchema,
+		OutputSchema:     c.OutputSchema,
+		ErrorSchema:      c.ErrorSchema,
+		DeprecatedFields: c.DeprecatedFields,
+		Bump:             bump,
+		CreatedBy:        userID,
+		CreatedAt:        time.Now(),
+	}
+}
+
+func revisionContract(r *domain.ContractRevision) *domain.ContractDefinition {
+	return &domain.ContractDefinition{
+		ID:               r.ContractID,
+		ContractType:     r.ContractType,
+		Version:          r.Version,
+		InputSchema:      r.InputSchema,
+		OutputSchema:     r.OutputSchema,
+		ErrorSchema:      r.ErrorSchema,
+		DeprecatedFields: r.DeprecatedFields,
+	}
+}
@@ -0,0 +1,362 @@
+package app
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"testing"
+
+	"github.com/SpecForgeVC/SpecForge/internal/domain"
+	"github.com/SpecForgeVC/SpecForge/internal/schemalint"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+type storedContractRepo struct {
+	mockContractRepo
+	contract domain.ContractDefinition
+}
+
+func (m *storedContractRepo) Get(ctx context.Context, id uuid.UUID) (*domain.ContractDefinition, error) {
+	c := m.contract
+	return &c, nil
+}
+func (m *storedContractRepo) GetForUpdate(ctx context.Context, id uuid.UUID) (*domain.ContractDefinition, error) {
+	return m.Get(ctx, id)
+}
+func (m *storedContractRepo) Update(ctx context.Context, c *domain.ContractDefinition) error {
+	m.contract = *c
+	return nil
+}
+
+type memRevisionRepo struct {
+	revisions []domain.ContractRevision
+}
+
+func (m *memRevisionRepo) Create(ctx context.Context, r *domain.ContractRevision) error {
+	m.revisions = append(m.revisions, *r)
+	return nil
+}
+func (m *memRevisionRepo) Get(ctx context.Context, contractID uuid.UUID, revision int) (*domain.ContractRevision, error) {
+	for _, r := range m.revisions {
+		if r.ContractID == contractID && r.Revision == revision {
+			return &r, nil
+		}
+	}
+	return nil, errors.New("not found")
+}
+func (m *memRevisionRepo) GetLatest(ctx context.Context, contractID uuid.UUID) (*domain.ContractRevision, error) {
+	for i := len(m.revisions) - 1; i >= 0; i-- {
+		if r := m.revisions[i]; r.ContractID == contractID {
+			return &r, nil
+		}
+	}
+	return nil, nil
+}
+func (m *memRevisionRepo) List(ctx context.Context, contractID uuid.UUID) ([]domain.ContractRevision, error) {
+	return m.revisions, nil
+}
+
+func userSchema(props ...string) map[string]interface{} {
+	properties := map[string]interface{}{}
+	required := []interface{}{}
+	for _, p := range props {
+		properties[p] = map[string]interface{}{"type": "string"}
+	}
+	if len(props) > 0 {
+		required = append(required, props[0])
+	}
+	return map[string]interface{}{"type": "object", "properties": properties, "required": required}
+}
+
+// newTestContractService builds a contract service whose units of work run
+// against the given repositories.
+func newTestContractService(repo ContractRepository, revisions ContractRevisionRepository, roadmapRepo RoadmapItemRepository, rulesets SchemaLintRulesetRepository) ContractService {
+	uow := &memUnitOfWork{repos: Repositories{
+		RoadmapItems:       roadmapRepo,
+		Contracts:          repo,
+		ContractRevisions:  revisions,
+		SchemaLintRulesets: rulesets,
+	}}
+	return NewContractService(repo, revisions, roadmapRepo, new(mockFiService), new(mockGovService), nil, rulesets, uow)
+}
+
+func newVersionedContractService(t *testing.T) (ContractService, *storedContractRepo, *memRevisionRepo) {
+	t.Helper()
+	contractID := uuid.New()
+	repo := &storedContractRepo{contract: domain.ContractDefinition{
+		ID:            contractID,
+		RoadmapItemID: uuid.New(),
+		ContractType:  domain.REST,
+		Version:       "1.2.0",
+		InputSchema:   userSchema("id"),
+		OutputSchema:  userSchema("id", "name"),
+	}}
+	roadmapRepo := new(mockRoadmapRepo)
+	roadmapRepo.On("Get", mock.Anything, mock.Anything).Return((*domain.RoadmapItem)(nil), errors.New("not found"))
+	revisions := &memRevisionRepo{}
+	svc := newTestContractService(repo, revisions, roadmapRepo, nil)
+	return svc, repo, revisions
+}
+
+func TestUpdateContract_BreakingChangeRequiresMajorBump(t *testing.T) {
+	ctx := context.Background()
+	svc, repo, revisions := newVersionedContractService(t)
+	id := repo.contract.ID
+
+	// Dropping the required "id" output field is breaking.
+	_, err := svc.UpdateContract(ctx, id, domain.REST, "1.3.0", userSchema("id"), userSchema("name"), nil, nil, uuid.Nil)
+	var bumpErr *VersionBumpError
+	if assert.ErrorAs(t, err, &bumpErr) {
+		assert.Equal(t, domain.VersionBumpMajor, bumpErr.Required)
+		assert.Equal(t, domain.VersionBumpMinor, bumpErr.Actual)
+	}
+	assert.Equal(t, "1.2.0", repo.contract.Version)
+
+	userID := uuid.New()
+	c, err := svc.UpdateContract(ctx, id, domain.REST, "2.0.0", userSchema("id"), userSchema("name"), nil, nil, userID)
+	assert.NoError(t, err)
+	assert.False(t, c.BackwardCompatible)
+
+	// The pre-existing state is captured as revision 1 before the first tracked update.
+	if assert.Len(t, revisions.revisions, 2) {
+		assert.Equal(t, "1.2.0", revisions.revisions[0].Version)
+		assert.Equal(t, 2, revisions.revisions[1].Revision)
+		assert.Equal(t, domain.VersionBumpMajor, revisions.revisions[1].Bump)
+		assert.Equal(t, userID, revisions.revisions[1].CreatedBy)
+	}
+}
+
+func TestUpdateContract_AdditiveChangeRequiresMinorBump(t *testing.T) {
+	ctx := context.Background()
+	svc, repo, _ := newVersionedContractService(t)
+	id := repo.contract.ID
+
+	_, err := svc.UpdateContract(ctx, id, domain.REST, "1.2.1", userSchema("id"), userSchema("id", "name", "email"), nil, nil, uuid.Nil)
+	var bumpErr *VersionBumpError
+	if assert.ErrorAs(t, err, &bumpErr) {
+		assert.Equal(t, domain.VersionBumpMinor, bumpErr.Required)
+	}
+
+	c, err := svc.UpdateContract(ctx, id, domain.REST, "1.3.0", userSchema("id"), userSchema("id", "name", "email"), nil, nil, uuid.Nil)
+	assert.NoError(t, err)
+	assert.True(t, c.BackwardCompatible)
+}
+
+func TestUpdateContract_RejectsVersionRegressionAndInvalidVersions(t *testing.T) {
+	ctx := context.Background()
+	svc, repo, _ := newVersionedContractService(t)
+	id := repo.contract.ID
+
+	_, err := svc.UpdateContract(ctx, id, domain.REST, "1.1.0", repo.contract.InputSchema, repo.contract.OutputSchema, nil, nil, uuid.Nil)
+	var bumpErr *VersionBumpError
+	assert.ErrorAs(t, err, &bumpErr)
+
+	_, err = svc.UpdateContract(ctx, id, domain.REST, "latest", repo.contract.InputSchema, repo.contract.OutputSchema, nil, nil, uuid.Nil)
+	assert.ErrorIs(t, err, ErrInvalidContractVersion)
+}
+
+// failingRevisionRepo fails to record the given revision number.
+type failingRevisionRepo struct {
+	memRevisionRepo
+	failAt int
+}
+
+func (m *failingRevisionRepo) Create(ctx context.Context, r *domain.ContractRevision) error {
+	if r.Revision == m.failAt {
+		return errors.New("insert failed")
+	}
+	return m.memRevisionRepo.Create(ctx, r)
+}
+
+func TestContractWrites_RollBackWithoutRevision(t *testing.T) {
+	ctx := context.Background()
+	revisions := &failingRevisionRepo{failAt: 1}
+	contracts := &memContractRepo{}
+	svc := newTestContractService(contracts, revisions, new(mockRoadmapRepo), nil)
+
+	_, err := svc.CreateContract(ctx, uuid.New(), domain.REST, "1.0.0", userSchema("id"), nil, nil, uuid.Nil)
+	assert.Error(t, err)
+	assert.Empty(t, contracts.contracts, "a contract must not be stored without its first revision")
+
+	_, repo, _ := newVersionedContractService(t)
+	revisions = &failingRevisionRepo{failAt: 2}
+	roadmapRepo := new(mockRoadmapRepo)
+	svc = newTestContractService(repo, revisions, roadmapRepo, nil)
+
+	_, err = svc.UpdateContract(ctx, repo.contract.ID, domain.REST, "1.3.0", userSchema("id"), userSchema("id", "name", "email"), nil, nil, uuid.Nil)
+	assert.Error(t, err)
+	assert.Equal(t, "1.2.0", repo.contract.Version)
+	assert.Empty(t, revisions.revisions)
+}
+
+func TestDiffRevisions(t *testing.T) {
+	ctx := context.Background()
+	svc, repo, _ := newVersionedContractService(t)
+	id := repo.contract.ID
+
+	_, err := svc.UpdateContract(ctx, id, domain.REST, "2.0.0", userSchema("id"), userSchema("name"), nil, nil, uuid.Nil)
+	assert.NoError(t, err)
+
+	diff, err := svc.DiffRevisions(ctx, id, 1, 2)
+	assert.NoError(t, err)
+	assert.Equal(t, domain.VersionBumpMajor, diff.RequiredBump)
+	assert.Equal(t, domain.VersionBumpMajor, diff.ActualBump)
+	assert.NotEmpty(t, diff.Report.Items)
+}
+
+func TestDiffContracts_LegacyGraphQL(t *testing.T) {
+	sdl := map[string]interface{}{"sdl": "type Query { user: String }"}
+	legacy := &domain.ContractDefinition{ContractType: domain.GraphQL, InputSchema: userSchema("id"), OutputSchema: userSchema("id")}
+	withSDL := &domain.ContractDefinition{ContractType: domain.GraphQL, InputSchema: sdl}
+
+	// Adopting SDL on a legacy contract reports its types as added.
+	report, err := diffContracts(legacy, withSDL)
+	assert.NoError(t, err)
+	assert.Equal(t, domain.VersionBumpMinor, domain.RequiredBump(report))
+
+	_, err = diffContracts(withSDL, legacy)
+	assert.Error(t, err)
+
+	// Two legacy revisions are compared by their JSON Schemas.
+	report, err = diffContracts(legacy, &domain.ContractDefinition{ContractType: domain.GraphQL, InputSchema: userSchema("id"), OutputSchema: userSchema("name")})
+	assert.NoError(t, err)
+	assert.NotEmpty(t, report.Items)
+}
+
+func TestValidatePayloads(t *testing.T) {
+	ctx := context.Background()
+	svc, repo, _ := newVersionedContractService(t)
+	id := repo.contract.ID
+
+	// Before any update the current contract stands in as revision 1.
+	report, err := svc.ValidatePayloads(ctx, id, "", domain.PayloadOutput, []interface{}{
+		map[string]interface{}{"id": "u1", "name": "Ada"},
+		map[string]interface{}{"name": 7},
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, 1, report.Revision)
+	assert.False(t, report.Valid)
+	assert.Equal(t, 1, report.Invalid)
+	assert.True(t, report.Results[0].Valid)
+	if assert.Len(t, report.Results[1].Errors, 2) {
+		assert.Equal(t, "", report.Results[1].Errors[0].Pointer)
+		assert.Equal(t, "/name", report.Results[1].Errors[1].Pointer)
+	}
+
+	_, err = svc.UpdateContract(ctx, id, domain.REST, "2.0.0", userSchema("id"), userSchema("name"), nil, nil, uuid.Nil)
+	assert.NoError(t, err)
+
+	// "id" is only required by the output of version 1.2.0.
+	withoutID := []interface{}{map[string]interface{}{"name": "Ada"}}
+	report, err = svc.ValidatePayloads(ctx, id, "2.0.0", domain.PayloadOutput, withoutID)
+	assert.NoError(t, err)
+	assert.True(t, report.Valid)
+	assert.Equal(t, 2, report.Revision)
+	report, err = svc.ValidatePayloads(ctx, id, "1.2.0", domain.PayloadOutput, withoutID)
+	assert.NoError(t, err)
+	assert.False(t, report.Valid)
+
+	// Without a version the current contract is used, even when it was
+	// changed without a revision being recorded.
+	repo.contract.OutputSchema = userSchema("email")
+	report, err = svc.ValidatePayloads(ctx, id, "", domain.PayloadOutput, withoutID)
+	assert.NoError(t, err)
+	assert.False(t, report.Valid)
+	assert.Equal(t, 2, report.Revision)
+
+	_, err = svc.ValidatePayloads(ctx, id, "9.9.9", domain.PayloadOutput, withoutID)
+	assert.ErrorIs(t, err, sql.ErrNoRows)
+	_, err = svc.ValidatePayloads(ctx, id, "", domain.PayloadError, withoutID)
+	assert.ErrorIs(t, err, ErrNoPayloadSchema)
+	_, err = svc.ValidatePayloads(ctx, id, "", domain.PayloadInput, nil)
+	assert.ErrorIs(t, err, ErrInvalidPayloadRequest)
+}
+
+func TestGenerateExamples(t *testing.T) {
+	ctx := context.Background()
+	svc, repo, _ := newVersionedContractService(t)
+
+	ex, err := svc.GenerateExamples(ctx, repo.contract.ID, "1.2.0")
+	assert.NoError(t, err)
+	assert.Equal(t, "1.2.0", ex.Version)
+	assert.Contains(t, ex.Unavailable, domain.PayloadError)
+	if output := ex.Samples[domain.PayloadOutput]; assert.NotNil(t, output) {
+		assert.Equal(t, map[string]interface{}{"id": "example"}, output.Valid[0].Payload)
+		assert.Equal(t, map[string]interface{}{"id": "example", "name": "example"}, output.Valid[1].Payload)
+		assert.Equal(t, `required "id" at (root)`, output.Invalid[1].Name)
+	}
+}
+
+type memLintRulesetRepo struct {
+	ruleset *domain.ProjectSchemaLintRuleset
+}
+
+func (m *memLintRulesetRepo) Get(ctx context.Context, projectID uuid.UUID) (*domain.ProjectSchemaLintRuleset, error) {
+	return m.ruleset, nil
+}
+func (m *memLintRulesetRepo) Upsert(ctx context.Context, r *domain.ProjectSchemaLintRuleset) error {
+	m.ruleset = r
+	return nil
+}
+
+func TestUpdateContract_LintErrorsBlockSave(t *testing.T) {
+	ctx := context.Background()
+	svc, repo, revisions := newVersionedContractService(t)
+	id := repo.contract.ID
+
+	input := userSchema("id")
+	input["properties"].(map[string]interface{})["id"] = map[string]interface{}{"type": "strng"}
+	_, err := svc.UpdateContract(ctx, id, domain.REST, "1.3.0", input, userSchema("id", "name"), nil, nil, uuid.Nil)
+	var lintErr *SchemaLintError
+	if assert.ErrorAs(t, err, &lintErr) {
+		assert.ErrorIs(t, err, ErrInvalidContractSchema)
+		assert.False(t, lintErr.Report.Passed)
+		assert.Equal(t, 1, lintErr.Report.Errors)
+		assert.Contains(t, lintErr.Error(), "input_schema/properties/id/type")
+	}
+	assert.Equal(t, "1.2.0", repo.contract.Version)
+	assert.Empty(t, revisions.revisions)
+}
+
+func TestLintContract_AppliesProjectRuleset(t *testing.T) {
+	ctx := context.Background()
+	projectID := uuid.New()
+	repo := &storedContractRepo{contract: domain.ContractDefinition{
+		ID:            uuid.New(),
+		RoadmapItemID: uuid.New(),
+		ContractType:  domain.REST,
+		Version:       "1.0.0",
+		InputSchema:   map[string]interface{}{"path": "/users", "method": "POST", "type": "object", "properties": map[string]interface{}{"user_name": map[string]interface{}{"type": "string"}}},
+	}}
+	roadmapRepo := new(mockRoadmapRepo)
+	roadmapRepo.On("Get", mock.Anything, repo.contract.RoadmapItemID).Return(&domain.RoadmapItem{ID: repo.contract.RoadmapItemID, ProjectID: projectID}, nil)
+	rulesets := &memLintRulesetRepo{}
+	svc := newTestContractService(repo, &memRevisionRepo{}, roadmapRepo, rulesets)
+
+	lint, err := svc.LintContract(ctx, repo.contract.ID)
+	assert.NoError(t, err)
+	assert.True(t, lint.Report.Passed)
+	assert.Equal(t, projectID, lint.ProjectID)
+
+	_, err = svc.UpdateLintRuleset(ctx, projectID, schemalint.Ruleset{Rules: map[string]schemalint.RuleConfig{
+		schemalint.RulePropertyNaming: {Severity: schemalint.Error, Style: "Title Case"},
+	}}, uuid.Nil)
+	assert.ErrorIs(t, err, ErrInvalidLintRuleset)
+
+	_, err = svc.UpdateLintRuleset(ctx, projectID, schemalint.Ruleset{Rules: map[string]schemalint.RuleConfig{
+		schemalint.RulePropertyNaming:      {Severity: schemalint.Error, Style: "camelCase"},
+		schemalint.RuleErrorSchemaRequired: {Severity: schemalint.Off},
+	}}, uuid.Nil)
+	assert.NoError(t, err)
+
+	lint, err = svc.LintContract(ctx, repo.contract.ID)
+	assert.NoError(t, err)
+	assert.False(t, lint.Report.Passed)
+	assert.Equal(t, 1, lint.Report.Errors)
+	for _, f := range lint.Report.Findings {
+		assert.NotEqual(t, schemalint.RuleErrorSchemaRequired, f.Rule)
+		assert.NotContains(t, f.Pointer, "/path")
+	}
+}
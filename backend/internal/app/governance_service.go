@@ -2,117 +2,289 @@ package app
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"strings"
+	"time"
 
 	"github.com/SpecForgeVC/SpecForge/internal/domain"
+	"github.com/SpecForgeVC/SpecForge/internal/domain/governance"
+	"github.com/SpecForgeVC/SpecForge/internal/drift"
 	"github.com/google/uuid"
 )
 
+// ErrInvalidGovernancePolicy wraps policy validation failures.
+var ErrInvalidGovernancePolicy = errors.New("invalid governance policy")
+
 type governanceService struct {
 	fiService    FeatureIntelligenceService
 	propRepo     AiProposalRepository
 	varRepo      VariableRepository
 	contractRepo ContractRepository
+	roadmapRepo  RoadmapItemRepository
+	reqRepo      RequirementRepository
+	policyRepo   GovernancePolicyRepository
+	waiverRepo   GovernanceWaiverRepository
+	alignment    AlignmentService
+	driftService drift.DriftService
+	auditLog     AuditLogService
 }
 
-func NewGovernanceService(fi FeatureIntelligenceService, propRepo AiProposalRepository, varRepo VariableRepository, contractRepo ContractRepository) GovernanceService {
+func NewGovernanceService(fi FeatureIntelligenceService, propRepo AiProposalRepository, varRepo VariableRepository, contractRepo ContractRepository, roadmapRepo RoadmapItemRepository, reqRepo RequirementRepository, policyRepo GovernancePolicyRepository, waiverRepo GovernanceWaiverRepository, alignment AlignmentService, driftService drift.DriftService, auditLog AuditLogService) GovernanceService {
 	return &governanceService{
 		fiService:    fi,
 		propRepo:     propRepo,
 		varRepo:      varRepo,
 		contractRepo: contractRepo,
+		roadmapRepo:  roadmapRepo,
+		reqRepo:      reqRepo,
+		policyRepo:   policyRepo,
+		waiverRepo:   waiverRepo,
+		alignment:    alignment,
+		driftService: driftService,
+		auditLog:     auditLog,
 	}
 }
 
+// CanBuildFeature evaluates the BUILD rules of the feature's project policy.
 func (s *governanceService) CanBuildFeature(ctx context.Context, featureID uuid.UUID) (bool, []string, error) {
-	var reasons []string
-	allowed := true
+	return s.checkGate(ctx, featureID, governance.GateBuild)
+}
+
+// CanDeployFeature evaluates the DEPLOY rules of the feature's project policy.
+func (s *governanceService) CanDeployFeature(ctx context.Context, featureID uuid.UUID) (bool, []string, error) {
+	return s.checkGate(ctx, featureID, governance.GateDeploy)
+}
 
-	// 1. Check Intelligence Score
-	score, err := s.fiService.GetFeatureScore(ctx, featureID)
+func (s *governanceService) checkGate(ctx context.Context, featureID uuid.UUID, gate governance.Gate) (bool, []string, error) {
+	eval, err := s.EvaluateFeature(ctx, featureID, gate)
 	if err != nil {
 		return false, nil, err
 	}
-	if score != nil && score.OverallScore < 50 {
-		allowed = false
-		reasons = append(reasons, fmt.Sprintf("Overall Intelligence Score is too low (%d < 50)", score.OverallScore))
-	}
+	return eval.Result.Allowed, eval.Result.Reasons(), nil
+}
 
-	// 2. Check for pending AI proposals on this feature
-	proposals, err := s.propRepo.ListByRoadmapItem(ctx, featureID)
+// EvaluateFeature runs one gate of the project's policy against the feature
+// and returns the findings along with the facts they were decided on. Findings
+// of rules with an active waiver are reported as waived and do not block.
+func (s *governanceService) EvaluateFeature(ctx context.Context, featureID uuid.UUID, gate governance.Gate) (*domain.GovernanceEvaluation, error) {
+	feature, err := s.roadmapRepo.Get(ctx, featureID)
 	if err != nil {
-		return false, nil, err
+		return nil, fmt.Errorf("failed to resolve feature: %w", err)
+	}
+	policy, err := s.GetPolicy(ctx, feature.ProjectID)
+	if err != nil {
+		return nil, err
+	}
+	facts, err := s.gatherFacts(ctx, feature, policy.Policy.Facts(gate))
+	if err != nil {
+		return nil, err
+	}
+	waivers, err := s.activeWaivers(ctx, featureID, gate)
+	if err != nil {
+		return nil, err
+	}
+	waived := make(map[string]string, len(waivers))
+	for _, w := range waivers {
+		waived[w.Rule] = w.ID.String()
+	}
+	return &domain.GovernanceEvaluation{
+		FeatureID:     featureID,
+		PolicyVersion: policy.Version,
+		Result:        policy.Policy.Evaluate(gate, facts).Waive(waived),
+		Facts:         facts,
+		Waivers:       waivers,
+	}, nil
+}
+
+// gatherFacts loads only the fact groups the given fact names belong to.
+func (s *governanceService) gatherFacts(ctx context.Context, feature *domain.RoadmapItem, names []string) (governance.Facts, error) {
+	groups := make(map[string]bool)
+	for _, name := range names {
+		group, _, _ := strings.Cut(name, ".")
+		groups[group] = true
+	}
+	facts := governance.Facts{}
+
+	if groups["feature"] {
+		facts["feature.type"] = feature.Type
+		facts["feature.status"] = feature.Status
+		facts["feature.priority"] = feature.Priority
+		facts["feature.risk_level"] = feature.RiskLevel
+		facts["feature.breaking_change"] = feature.BreakingChange
+	}
+
+	if groups["score"] {
+		score, err := s.fiService.GetFeatureScore(ctx, feature.ID)
+		if err != nil {
+			return nil, err
+		}
+		facts["score.available"] = score != nil
+		if score == nil {
+			score = &domain.FeatureIntelligence{}
+		}
+		facts["score.overall"] = score.OverallScore
+		facts["score.completeness"] = score.CompletenessScore
+		facts["score.contract_integrity"] = score.ContractIntegrityScore
+		facts["score.variable_coverage"] = score.VariableCoverageScore
+		facts["score.dependency_stability"] = score.DependencyStabilityScore
+		facts["score.drift_risk"] = score.DriftRiskScore
+		facts["score.test_coverage"] = score.TestCoverageScore
+		facts["score.llm_confidence"] = score.LLMConfidenceScore
+	}
+
+	if groups["proposals"] {
+		proposals, err := s.propRepo.ListByRoadmapItem(ctx, feature.ID)
+		if err != nil {
+			return nil, err
+		}
+		pending := 0
+		for _, p := range proposals {
+			if p.Status == domain.Pending {
+				pending++
+			}
+		}
+		facts["proposals.pending"] = pending
+	}
+
+	if groups["drift"] {
+		breaking, nonBreaking, err := s.driftService.CountFeatureDrift(ctx, feature.ID)
+		if err != nil {
+			return nil, err
+		}
+		facts["drift.breaking"] = breaking
+		facts["drift.non_breaking"] = nonBreaking
 	}
-	pendingCount := 0
-	for _, p := range proposals {
-		if p.Status == domain.Pending {
-			pendingCount++
+
+	if groups["alignment"] {
+		report, err := s.alignment.GetAlignmentReport(ctx, feature.ProjectID)
+		if err != nil {
+			return nil, err
+		}
+		conflicts, critical := 0, 0
+		if report != nil {
+			for _, c := range report.Conflicts {
+				if c.SourceID != feature.ID && c.TargetID != feature.ID {
+					continue
+				}
+				conflicts++
+				if c.Severity == domain.SeverityCritical || c.Severity == domain.SeverityError {
+					critical++
+				}
+			}
+		}
+		facts["alignment.available"] = report != nil
+		facts["alignment.score"] = 0
+		if report != nil {
+			facts["alignment.score"] = report.AlignmentScore
 		}
+		facts["alignment.conflicts"] = conflicts
+		facts["alignment.critical"] = critical
 	}
-	if pendingCount > 0 {
-		allowed = false
-		reasons = append(reasons, fmt.Sprintf("Feature has %d pending AI proposal(s) awaiting review before it can be built", pendingCount))
+
+	if groups["requirements"] {
+		reqs, err := s.reqRepo.List(ctx, feature.ID)
+		if err != nil {
+			return nil, err
+		}
+		testable := 0
+		for _, r := range reqs {
+			if r.Testable {
+				testable++
+			}
+		}
+		facts["requirements.total"] = len(reqs)
+		facts["requirements.testable"] = testable
+		facts["requirements.untestable"] = len(reqs) - testable
 	}
 
-	return allowed, reasons, nil
+	if groups["contracts"] {
+		contracts, err := s.contractRepo.List(ctx, feature.ID)
+		if err != nil {
+			return nil, err
+		}
+		facts["contracts.count"] = len(contracts)
+	}
+
+	return facts, nil
 }
 
-func (s *governanceService) CanDeployFeature(ctx context.Context, featureID uuid.UUID) (bool, []string, error) {
-	var reasons []string
-	allowed := true
+// GetPolicy returns the project's newest policy version, or the default
+// policy as version 0 if none has been stored.
+func (s *governanceService) GetPolicy(ctx context.Context, projectID uuid.UUID) (*domain.ProjectGovernancePolicy, error) {
+	return projectPolicy(ctx, s.policyRepo, projectID)
+}
 
-	score, err := s.fiService.GetFeatureScore(ctx, featureID)
+func projectPolicy(ctx context.Context, repo GovernancePolicyRepository, projectID uuid.UUID) (*domain.ProjectGovernancePolicy, error) {
+	p, err := repo.GetLatest(ctx, projectID)
 	if err != nil {
-		return false, nil, err
-	}
-	if score != nil && score.OverallScore < 80 {
-		allowed = false
-		reasons = append(reasons, fmt.Sprintf("Overall Intelligence Score is too low for deployment (%d < 80)", score.OverallScore))
+		return nil, err
 	}
-	if score != nil && score.CompletenessScore < 100 {
-		allowed = false
-		reasons = append(reasons, "Feature Spec must be 100% complete before deployment")
+	if p == nil {
+		return &domain.ProjectGovernancePolicy{ProjectID: projectID, Policy: governance.DefaultPolicy}, nil
 	}
+	return p, nil
+}
 
-	// Also block deployment if pending proposals exist
-	proposals, err := s.propRepo.ListByRoadmapItem(ctx, featureID)
+func (s *governanceService) ListPolicyVersions(ctx context.Context, projectID uuid.UUID) ([]domain.ProjectGovernancePolicy, error) {
+	return s.policyRepo.List(ctx, projectID)
+}
+
+// UpdatePolicy validates the policy and stores it as the project's next version.
+func (s *governanceService) UpdatePolicy(ctx context.Context, projectID uuid.UUID, policy governance.Policy, userID uuid.UUID) (*domain.ProjectGovernancePolicy, error) {
+	if err := policy.Validate(); err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidGovernancePolicy, err)
+	}
+	previous, err := s.GetPolicy(ctx, projectID)
 	if err != nil {
-		return false, nil, err
+		return nil, err
 	}
-	for _, p := range proposals {
-		if p.Status == domain.Pending {
-			allowed = false
-			reasons = append(reasons, "All pending AI proposals must be reviewed before deployment")
-			break
-		}
+	p := &domain.ProjectGovernancePolicy{
+		ProjectID: projectID,
+		Policy:    policy,
+		CreatedBy: userID,
+		CreatedAt: time.Now(),
 	}
-
-	return allowed, reasons, nil
+	if err := s.policyRepo.Create(ctx, p); err != nil {
+		return nil, err
+	}
+	s.auditLog.Log(ctx, "PROJECT", projectID, "GOVERNANCE_POLICY_UPDATED", userID,
+		map[string]interface{}{"version": previous.Version, "rules": len(previous.Policy.Rules)},
+		map[string]interface{}{"version": p.Version, "rules": len(p.Policy.Rules)},
+	)
+	return p, nil
 }
 
 func (s *governanceService) CanUpdateContract(ctx context.Context, contractID uuid.UUID) (bool, []string, error) {
-	var reasons []string
-	allowed := true
-
 	// Resolve which roadmap item this contract belongs to
 	contract, err := s.contractRepo.Get(ctx, contractID)
 	if err != nil {
 		return false, nil, fmt.Errorf("failed to resolve contract: %w", err)
 	}
-
-	// Check for pending proposals on the parent roadmap item
-	proposals, err := s.propRepo.ListByRoadmapItem(ctx, contract.RoadmapItemID)
+	reasons, err := contractHolds(ctx, s.propRepo, contract, nil)
 	if err != nil {
 		return false, nil, err
 	}
-	for _, p := range proposals {
-		if p.Status == domain.Pending {
-			allowed = false
-			reasons = append(reasons,
-				fmt.Sprintf("Contract has a pending AI proposal (ID: %s) awaiting review — resolve it before making manual changes", p.ID))
-			break
+	return len(reasons) == 0, reasons, nil
+}
+
+// contractHolds lists why the contract may not change now: a pending
+// proposal on its roadmap item must be resolved first. When an approved
+// proposal is being applied, it and the other proposals on the same contract,
+// which its application makes stale, do not hold the contract.
+func contractHolds(ctx context.Context, proposals AiProposalRepository, contract *domain.ContractDefinition, applying *domain.AiProposal) ([]string, error) {
+	list, err := proposals.ListByRoadmapItem(ctx, contract.RoadmapItemID)
+	if err != nil {
+		return nil, err
+	}
+	for _, p := range list {
+		if p.Status != domain.Pending {
+			continue
+		}
+		if applying != nil && (p.ID == applying.ID || (p.TargetType == domain.PatchTargetContract && p.TargetID == contract.ID)) {
+			continue
 		}
+		return []string{fmt.Sprintf("Contract has a pending AI proposal (ID: %s) awaiting review — resolve it before making manual changes", p.ID)}, nil
 	}
-
-	return allowed, reasons, nil
+	return nil, nil
 }
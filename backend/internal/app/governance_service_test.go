@@ -0,0 +1,225 @@
+package app
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/SpecForgeVC/SpecForge/internal/domain"
+	"github.com/SpecForgeVC/SpecForge/internal/domain/governance"
+	"github.com/SpecForgeVC/SpecForge/internal/drift"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+type memGovPolicyRepo struct {
+	versions []domain.ProjectGovernancePolicy
+}
+
+func (m *memGovPolicyRepo) Create(ctx context.Context, p *domain.ProjectGovernancePolicy) error {
+	p.Version = len(m.versions) + 1
+	m.versions = append(m.versions, *p)
+	return nil
+}
+func (m *memGovPolicyRepo) GetLatest(ctx context.Context, projectID uuid.UUID) (*domain.ProjectGovernancePolicy, error) {
+	if len(m.versions) == 0 {
+		return nil, nil
+	}
+	p := m.versions[len(m.versions)-1]
+	return &p, nil
+}
+func (m *memGovPolicyRepo) List(ctx context.Context, projectID uuid.UUID) ([]domain.ProjectGovernancePolicy, error) {
+	return m.versions, nil
+}
+
+type memWaiverRepo struct {
+	waivers []domain.GovernanceWaiver
+}
+
+func (m *memWaiverRepo) Create(ctx context.Context, w *domain.GovernanceWaiver) error {
+	m.waivers = append(m.waivers, *w)
+	return nil
+}
+func (m *memWaiverRepo) Get(ctx context.Context, id uuid.UUID) (*domain.GovernanceWaiver, error) {
+	for _, w := range m.waivers {
+		if w.ID == id {
+			return &w, nil
+		}
+	}
+	return nil, sql.ErrNoRows
+}
+func (m *memWaiverRepo) Decide(ctx context.Context, w *domain.GovernanceWaiver, from domain.WaiverStatus) (bool, error) {
+	for i := range m.waivers {
+		if m.waivers[i].ID == w.ID && m.waivers[i].Status == from {
+			m.waivers[i] = *w
+			return true, nil
+		}
+	}
+	return false, nil
+}
+func (m *memWaiverRepo) ListByProject(ctx context.Context, projectID uuid.UUID) ([]domain.GovernanceWaiver, error) {
+	return m.waivers, nil
+}
+func (m *memWaiverRepo) ListByRoadmapItem(ctx context.Context, roadmapItemID uuid.UUID) ([]domain.GovernanceWaiver, error) {
+	return m.waivers, nil
+}
+
+type stubProposalRepo struct {
+	AiProposalRepository
+	proposals []domain.AiProposal
+}
+
+func (s *stubProposalRepo) ListByRoadmapItem(ctx context.Context, roadmapItemID uuid.UUID) ([]domain.AiProposal, error) {
+	return s.proposals, nil
+}
+
+type stubDriftCounter struct {
+	drift.DriftService
+	breaking int
+}
+
+func (s *stubDriftCounter) CountFeatureDrift(ctx context.Context, featureID uuid.UUID) (int, int, error) {
+	return s.breaking, 0, nil
+}
+
+func newTestGovernanceService(feature *domain.RoadmapItem) (GovernanceService, *mockFiService, *memGovPolicyRepo) {
+	svc, fi, policies, _ := newTestGovernanceServiceWithWaivers(feature)
+	return svc, fi, policies
+}
+
+func newTestGovernanceServiceWithWaivers(feature *domain.RoadmapItem) (GovernanceService, *mockFiService, *memGovPolicyRepo, *memWaiverRepo) {
+	roadmapRepo := new(mockRoadmapRepo)
+	roadmapRepo.On("Get", mock.Anything, feature.ID).Return(feature, nil)
+	fi := new(mockFiService)
+	reqRepo := new(mockRequirementRepo)
+	reqRepo.On("List", mock.Anything, feature.ID).Return([]domain.Requirement{{Testable: true}, {Testable: false}}, nil)
+	audit := new(mockAuditLog)
+	audit.On("Log", mock.Anything, "PROJECT", feature.ProjectID, "GOVERNANCE_POLICY_UPDATED", mock.Anything, mock.Anything, mock.Anything).Return(nil)
+	audit.On("Log", mock.Anything, "governance_waiver", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil)
+	policies := &memGovPolicyRepo{}
+	waivers := &memWaiverRepo{}
+	proposals := &stubProposalRepo{proposals: []domain.AiProposal{{Status: domain.Pending}}}
+	svc := NewGovernanceService(fi, proposals, nil, nil, roadmapRepo, reqRepo, policies, waivers, nil, &stubDriftCounter{breaking: 2}, audit)
+	return svc, fi, policies, waivers
+}
+
+func TestGovernance_DefaultPolicy(t *testing.T) {
+	ctx := context.Background()
+	feature := &domain.RoadmapItem{ID: uuid.New(), ProjectID: uuid.New()}
+	svc, fi, _ := newTestGovernanceService(feature)
+	fi.On("GetFeatureScore", mock.Anything, feature.ID).Return(&domain.FeatureIntelligence{OverallScore: 40, CompletenessScore: 100}, nil)
+
+	allowed, reasons, err := svc.CanBuildFeature(ctx, feature.ID)
+	assert.NoError(t, err)
+	assert.False(t, allowed)
+	assert.Equal(t, []string{
+		"Overall Intelligence Score is too low (40 < 50)",
+		"Feature has 1 pending AI proposal(s) awaiting review before it can be built",
+	}, reasons)
+}
+
+func TestGovernance_ProjectPolicy(t *testing.T) {
+	ctx := context.Background()
+	feature := &domain.RoadmapItem{ID: uuid.New(), ProjectID: uuid.New(), RiskLevel: domain.RiskHigh}
+	svc, _, policies := newTestGovernanceService(feature)
+
+	_, err := svc.UpdatePolicy(ctx, feature.ProjectID, governance.Policy{Rules: []governance.Rule{
+		{Name: "bad", Gate: governance.GateDeploy, Condition: "drift.breaking >", Severity: governance.SeverityBlock},
+	}}, uuid.Nil)
+	assert.ErrorIs(t, err, ErrInvalidGovernancePolicy)
+	assert.Empty(t, policies.versions)
+
+	stored, err := svc.UpdatePolicy(ctx, feature.ProjectID, governance.Policy{Rules: []governance.Rule{
+		{Name: "no-drift-on-high-risk", Gate: governance.GateDeploy, Severity: governance.SeverityBlock,
+			Condition: "feature.risk_level != 'HIGH' || drift.breaking == 0",
+			Message:   "High-risk features cannot ship with {drift.breaking} breaking contract change(s)"},
+		{Name: "testable-requirements", Gate: governance.GateDeploy, Severity: governance.SeverityWarn,
+			Condition: "requirements.untestable == 0",
+			Message:   "{requirements.untestable} of {requirements.total} requirement(s) are not testable"},
+	}}, uuid.New())
+	assert.NoError(t, err)
+	assert.Equal(t, 1, stored.Version)
+
+	// The score is never read: the policy does not reference it.
+	allowed, reasons, err := svc.CanDeployFeature(ctx, feature.ID)
+	assert.NoError(t, err)
+	assert.False(t, allowed)
+	assert.Equal(t, []string{
+		"High-risk features cannot ship with 2 breaking contract change(s)",
+		"Warning: 1 of 2 requirement(s) are not testable",
+	}, reasons)
+
+	// The new policy has no BUILD rules, so building is no longer gated.
+	allowed, reasons, err = svc.CanBuildFeature(ctx, feature.ID)
+	assert.NoError(t, err)
+	assert.True(t, allowed)
+	assert.Empty(t, reasons)
+}
+
+func TestGovernance_Waivers(t *testing.T) {
+	ctx := context.Background()
+	feature := &domain.RoadmapItem{ID: uuid.New(), ProjectID: uuid.New()}
+	svc, fi, _, waivers := newTestGovernanceServiceWithWaivers(feature)
+	fi.On("GetFeatureScore", mock.Anything, feature.ID).Return(&domain.FeatureIntelligence{OverallScore: 40, CompletenessScore: 100}, nil)
+	requester, approver := uuid.New(), uuid.New()
+	expiry := time.Now().Add(time.Hour)
+
+	_, err := svc.RequestWaiver(ctx, feature.ID, governance.GateBuild, "no-such-rule", "Demo", expiry, requester)
+	assert.ErrorIs(t, err, ErrInvalidWaiver)
+	_, err = svc.RequestWaiver(ctx, feature.ID, governance.GateBuild, "min-overall-score", "Demo", time.Now().Add(-time.Minute), requester)
+	assert.ErrorIs(t, err, ErrInvalidWaiver)
+
+	scoreWaiver, err := svc.RequestWaiver(ctx, feature.ID, governance.GateBuild, "min-overall-score", "Demo build for the board review", expiry, requester)
+	assert.NoError(t, err)
+	proposalWaiver, err := svc.RequestWaiver(ctx, feature.ID, governance.GateBuild, "no-pending-proposals", "Proposal only touches docs", expiry, requester)
+	assert.NoError(t, err)
+
+	// Pending waivers do not count.
+	allowed, _, err := svc.CanBuildFeature(ctx, feature.ID)
+	assert.NoError(t, err)
+	assert.False(t, allowed)
+
+	_, err = svc.ApproveWaiver(ctx, scoreWaiver.ID, requester, "")
+	assert.ErrorIs(t, err, ErrWaiverSelfApproval)
+	for _, id := range []uuid.UUID{scoreWaiver.ID, proposalWaiver.ID} {
+		_, err = svc.ApproveWaiver(ctx, id, approver, "ok until the demo")
+		assert.NoError(t, err)
+	}
+
+	allowed, reasons, err := svc.CanBuildFeature(ctx, feature.ID)
+	assert.NoError(t, err)
+	assert.True(t, allowed)
+	assert.Equal(t, []string{
+		"Waived (" + scoreWaiver.ID.String() + "): Overall Intelligence Score is too low (40 < 50)",
+		"Waived (" + proposalWaiver.ID.String() + "): Feature has 1 pending AI proposal(s) awaiting review before it can be built",
+	}, reasons)
+
+	// BUILD waivers do not carry over to DEPLOY.
+	allowed, _, err = svc.CanDeployFeature(ctx, feature.ID)
+	assert.NoError(t, err)
+	assert.False(t, allowed)
+
+	// An expired waiver blocks again and shows as EXPIRED in the register.
+	waivers.waivers[0].ExpiresAt = time.Now().Add(-time.Second)
+	eval, err := svc.EvaluateFeature(ctx, feature.ID, governance.GateBuild)
+	assert.NoError(t, err)
+	assert.False(t, eval.Result.Allowed)
+	assert.Len(t, eval.Waivers, 1)
+
+	expired, err := svc.ListWaivers(ctx, feature.ProjectID, domain.WaiverExpired)
+	assert.NoError(t, err)
+	if assert.Len(t, expired, 1) {
+		assert.Equal(t, scoreWaiver.ID, expired[0].ID)
+	}
+	_, err = svc.RevokeWaiver(ctx, scoreWaiver.ID, approver, "")
+	assert.ErrorIs(t, err, ErrWaiverTransition)
+
+	revoked, err := svc.RevokeWaiver(ctx, proposalWaiver.ID, approver, "proposal grew")
+	assert.NoError(t, err)
+	assert.Equal(t, domain.WaiverRevoked, revoked.Status)
+	all, err := svc.ListWaivers(ctx, feature.ProjectID, "")
+	assert.NoError(t, err)
+	assert.Len(t, all, 2)
+}
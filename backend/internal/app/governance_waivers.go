@@ -0,0 +1,153 @@
+package app
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/SpecForgeVC/SpecForge/internal/domain"
+	"github.com/SpecForgeVC/SpecForge/internal/domain/governance"
+	"github.com/google/uuid"
+)
+
+var (
+	ErrInvalidWaiver      = errors.New("invalid governance waiver")
+	ErrWaiverTransition   = errors.New("waiver cannot change status")
+	ErrWaiverSelfApproval = errors.New("the requester of a waiver cannot decide it")
+)
+
+// RequestWaiver files a pending exception to one rule of the feature's
+// current policy. It has no effect until approved and lapses at expiresAt.
+func (s *governanceService) RequestWaiver(ctx context.Context, featureID uuid.UUID, gate governance.Gate, rule, justification string, expiresAt time.Time, requestedBy uuid.UUID) (*domain.GovernanceWaiver, error) {
+	justification = strings.TrimSpace(justification)
+	if justification == "" {
+		return nil, fmt.Errorf("%w: justification is required", ErrInvalidWaiver)
+	}
+	now := time.Now()
+	if !expiresAt.After(now) {
+		return nil, fmt.Errorf("%w: expires_at must be in the future", ErrInvalidWaiver)
+	}
+	feature, err := s.roadmapRepo.Get(ctx, featureID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to resolve feature: %w", err)
+	}
+	policy, err := s.GetPolicy(ctx, feature.ProjectID)
+	if err != nil {
+		return nil, err
+	}
+	if !policy.Policy.HasRule(gate, rule) {
+		return nil, fmt.Errorf("%w: policy version %d has no %s rule %q", ErrInvalidWaiver, policy.Version, gate, rule)
+	}
+
+	w := &domain.GovernanceWaiver{
+		ID:            uuid.New(),
+		ProjectID:     feature.ProjectID,
+		RoadmapItemID: featureID,
+		Gate:          gate,
+		Rule:          rule,
+		Justification: justification,
+		Status:        domain.WaiverPending,
+		RequestedBy:   requestedBy,
+		ExpiresAt:     expiresAt,
+		CreatedAt:     now,
+	}
+	if err := s.waiverRepo.Create(ctx, w); err != nil {
+		return nil, err
+	}
+	s.auditLog.Log(ctx, "governance_waiver", w.ID, "WAIVER_REQUESTED", requestedBy, nil,
+		map[string]interface{}{"roadmap_item_id": featureID, "gate": gate, "rule": rule, "expires_at": expiresAt},
+	)
+	return w, nil
+}
+
+func (s *governanceService) GetWaiver(ctx context.Context, id uuid.UUID) (*domain.GovernanceWaiver, error) {
+	w, err := s.waiverRepo.Get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	w.Status = w.StatusAt(time.Now())
+	return w, nil
+}
+
+// ApproveWaiver puts a pending waiver into force until its expiry. The
+// requester cannot approve their own waiver.
+func (s *governanceService) ApproveWaiver(ctx context.Context, id uuid.UUID, approver uuid.UUID, comment string) (*domain.GovernanceWaiver, error) {
+	return s.decideWaiver(ctx, id, approver, comment, domain.WaiverPending, domain.WaiverApproved, "WAIVER_APPROVED")
+}
+
+func (s *governanceService) RejectWaiver(ctx context.Context, id uuid.UUID, approver uuid.UUID, comment string) (*domain.GovernanceWaiver, error) {
+	return s.decideWaiver(ctx, id, approver, comment, domain.WaiverPending, domain.WaiverRejected, "WAIVER_REJECTED")
+}
+
+// RevokeWaiver withdraws an approved waiver before its expiry, so the rule
+// blocks again.
+func (s *governanceService) RevokeWaiver(ctx context.Context, id uuid.UUID, userID uuid.UUID, comment string) (*domain.GovernanceWaiver, error) {
+	return s.decideWaiver(ctx, id, userID, comment, domain.WaiverApproved, domain.WaiverRevoked, "WAIVER_REVOKED")
+}
+
+// decideWaiver moves a waiver whose current status is from to to. Expired
+// waivers cannot be decided.
+func (s *governanceService) decideWaiver(ctx context.Context, id uuid.UUID, userID uuid.UUID, comment string, from, to domain.WaiverStatus, action string) (*domain.GovernanceWaiver, error) {
+	w, err := s.waiverRepo.Get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	now := time.Now()
+	if status := w.StatusAt(now); status != from {
+		return nil, fmt.Errorf("%w: waiver is %s", ErrWaiverTransition, status)
+	}
+	if from == domain.WaiverPending && w.RequestedBy == userID {
+		return nil, ErrWaiverSelfApproval
+	}
+
+	w.Status = to
+	w.DecidedBy = userID
+	w.DecisionComment = strings.TrimSpace(comment)
+	w.DecidedAt = &now
+	ok, err := s.waiverRepo.Decide(ctx, w, from)
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return nil, fmt.Errorf("%w: waiver was decided concurrently", ErrWaiverTransition)
+	}
+	s.auditLog.Log(ctx, "governance_waiver", w.ID, action, userID,
+		map[string]interface{}{"status": from},
+		map[string]interface{}{"status": to, "comment": w.DecisionComment},
+	)
+	return w, nil
+}
+
+func (s *governanceService) ListWaivers(ctx context.Context, projectID uuid.UUID, status domain.WaiverStatus) ([]domain.GovernanceWaiver, error) {
+	waivers, err := s.waiverRepo.ListByProject(ctx, projectID)
+	if err != nil {
+		return nil, err
+	}
+	now := time.Now()
+	register := []domain.GovernanceWaiver{}
+	for _, w := range waivers {
+		w.Status = w.StatusAt(now)
+		if status == "" || w.Status == status {
+			register = append(register, w)
+		}
+	}
+	return register, nil
+}
+
+// activeWaivers returns the feature's waivers in force for the gate.
+func (s *governanceService) activeWaivers(ctx context.Context, featureID uuid.UUID, gate governance.Gate) ([]domain.GovernanceWaiver, error) {
+	waivers, err := s.waiverRepo.ListByRoadmapItem(ctx, featureID)
+	if err != nil {
+		return nil, err
+	}
+	now := time.Now()
+	var active []domain.GovernanceWaiver
+	for _, w := range waivers {
+		if w.Gate == gate && w.ActiveAt(now) {
+			active = append(active, w)
+		}
+	}
+	return active, nil
+}
@@ -2,8 +2,12 @@ package app
 
 import (
 	"context"
+	"time"
 
 	"github.com/SpecForgeVC/SpecForge/internal/domain"
+	"github.com/SpecForgeVC/SpecForge/internal/domain/governance"
+	"github.com/SpecForgeVC/SpecForge/internal/rules"
+	"github.com/SpecForgeVC/SpecForge/internal/schemalint"
 	"github.com/google/uuid"
 )
 
@@ -40,6 +44,8 @@ type RoadmapItemRepository interface {
 
 type ContractRepository interface {
 	Get(ctx context.Context, id uuid.UUID) (*domain.ContractDefinition, error)
+	// GetForUpdate reads the contract and locks its row until the unit of work ends.
+	GetForUpdate(ctx context.Context, id uuid.UUID) (*domain.ContractDefinition, error)
 	List(ctx context.Context, roadmapItemID uuid.UUID) ([]domain.ContractDefinition, error)
 	ListByProject(ctx context.Context, projectID uuid.UUID) ([]domain.ContractDefinition, error)
 	Create(ctx context.Context, c *domain.ContractDefinition) error
@@ -47,6 +53,21 @@ type ContractRepository interface {
 	Delete(ctx context.Context, id uuid.UUID) error
 }
 
+// ContractRevisionRepository is append-only; revisions are never updated.
+type ContractRevisionRepository interface {
+	Create(ctx context.Context, r *domain.ContractRevision) error
+	Get(ctx context.Context, contractID uuid.UUID, revision int) (*domain.ContractRevision, error)
+	GetLatest(ctx context.Context, contractID uuid.UUID) (*domain.ContractRevision, error)
+	List(ctx context.Context, contractID uuid.UUID) ([]domain.ContractRevision, error)
+}
+
+// SchemaLintRulesetRepository stores per-project lint rulesets. Get returns
+// nil when the project has none.
+type SchemaLintRulesetRepository interface {
+	Get(ctx context.Context, projectID uuid.UUID) (*domain.ProjectSchemaLintRuleset, error)
+	Upsert(ctx context.Context, r *domain.ProjectSchemaLintRuleset) error
+}
+
 type SnapshotRepository interface {
 	Get(ctx context.Context, id uuid.UUID) (*domain.VersionSnapshot, error)
 	List(ctx context.Context, roadmapItemID uuid.UUID) ([]domain.VersionSnapshot, error)
@@ -77,17 +98,28 @@ type RoadmapItemService interface {
 	GetRoadmapItem(ctx context.Context, id uuid.UUID) (*domain.RoadmapItem, error)
 	ListRoadmapItems(ctx context.Context, projectID uuid.UUID) ([]domain.RoadmapItem, error)
 	CreateRoadmapItem(ctx context.Context, item *domain.RoadmapItem, userID uuid.UUID) (*domain.RoadmapItem, error)
-	UpdateRoadmapItem(ctx context.Context, id uuid.UUID, title, description, businessContext, technicalContext string, status domain.RoadmapItemStatus, userID uuid.UUID) (*domain.RoadmapItem, error)
+	UpdateRoadmapItem(ctx context.Context, id uuid.UUID, title, description, businessContext, technicalContext string, status domain.RoadmapItemStatus, reason string, actor domain.Principal) (*domain.RoadmapItem, error)
 	DeleteRoadmapItem(ctx context.Context, id uuid.UUID, userID uuid.UUID) error
+	TransitionRoadmapItem(ctx context.Context, id uuid.UUID, to domain.RoadmapItemStatus, reason string, actor domain.Principal) (*domain.RoadmapItem, error)
+	ListStatusTransitions(ctx context.Context, id uuid.UUID) ([]domain.RoadmapStatusTransition, error)
 }
 
 type ContractService interface {
 	GetContract(ctx context.Context, id uuid.UUID) (*domain.ContractDefinition, error)
 	ListContracts(ctx context.Context, roadmapItemID uuid.UUID) ([]domain.ContractDefinition, error)
 	ListContractsByProject(ctx context.Context, projectID uuid.UUID) ([]domain.ContractDefinition, error)
-	CreateContract(ctx context.Context, roadmapItemID uuid.UUID, cType domain.ContractType, version string, input, output, errSchema map[string]interface{}) (*domain.ContractDefinition, error)
-	UpdateContract(ctx context.Context, id uuid.UUID, cType domain.ContractType, version string, input, output, errSchema map[string]interface{}) (*domain.ContractDefinition, error)
+	CreateContract(ctx context.Context, roadmapItemID uuid.UUID, cType domain.ContractType, version string, input, output, errSchema map[string]interface{}, userID uuid.UUID) (*domain.ContractDefinition, error)
+	UpdateContract(ctx context.Context, id uuid.UUID, cType domain.ContractType, version string, input, output, errSchema map[string]interface{}, deprecatedFields []string, userID uuid.UUID) (*domain.ContractDefinition, error)
 	DeleteContract(ctx context.Context, id uuid.UUID) error
+	ListRevisions(ctx context.Context, contractID uuid.UUID) ([]domain.ContractRevision, error)
+	GetRevision(ctx context.Context, contractID uuid.UUID, revision int) (*domain.ContractRevision, error)
+	DiffRevisions(ctx context.Context, contractID uuid.UUID, from, to int) (*domain.ContractRevisionDiff, error)
+	ValidatePayloads(ctx context.Context, contractID uuid.UUID, version string, kind domain.PayloadKind, payloads []interface{}) (*domain.PayloadValidation, error)
+	GenerateExamples(ctx context.Context, contractID uuid.UUID, version string) (*domain.ContractExamples, error)
+	LintContract(ctx context.Context, id uuid.UUID) (*domain.ContractLint, error)
+	LintContractDraft(ctx context.Context, roadmapItemID uuid.UUID, cType domain.ContractType, input, output, errSchema map[string]interface{}) (*domain.ContractLint, error)
+	GetLintRuleset(ctx context.Context, projectID uuid.UUID) (*domain.ProjectSchemaLintRuleset, error)
+	UpdateLintRuleset(ctx context.Context, projectID uuid.UUID, ruleset schemalint.Ruleset, userID uuid.UUID) (*domain.ProjectSchemaLintRuleset, error)
 }
 
 type SnapshotService interface {
@@ -99,18 +131,36 @@ type SnapshotService interface {
 
 type AiProposalRepository interface {
 	Get(ctx context.Context, id uuid.UUID) (*domain.AiProposal, error)
+	// GetForUpdate reads the proposal and locks its row until the unit of work ends.
+	GetForUpdate(ctx context.Context, id uuid.UUID) (*domain.AiProposal, error)
 	ListByProject(ctx context.Context, projectID uuid.UUID) ([]domain.AiProposal, error)
 	ListByRoadmapItem(ctx context.Context, roadmapItemID uuid.UUID) ([]domain.AiProposal, error)
 	Create(ctx context.Context, p *domain.AiProposal) error
 	UpdateStatus(ctx context.Context, id uuid.UUID, status domain.ProposalStatus, reviewedBy uuid.UUID) error
+	Resubmit(ctx context.Context, id uuid.UUID, diff map[string]interface{}, reasoning string) error
+	Rebase(ctx context.Context, id uuid.UUID, diff map[string]interface{}, baseHash string, baseDocument map[string]interface{}) error
+	// MarkStale moves open proposals on the target whose base hash differs
+	// from currentHash to STALE.
+	MarkStale(ctx context.Context, targetType domain.PatchTargetType, targetID uuid.UUID, currentHash string) (int64, error)
+}
+
+type ProposalReviewRepository interface {
+	Create(ctx context.Context, review *domain.ProposalReview) error
+	List(ctx context.Context, proposalID uuid.UUID) ([]domain.ProposalReview, error)
+	Supersede(ctx context.Context, proposalID uuid.UUID) error
 }
 
 type AiProposalService interface {
 	GetProposal(ctx context.Context, id uuid.UUID) (*domain.AiProposal, error)
 	ListProposals(ctx context.Context, projectID uuid.UUID) ([]domain.AiProposal, error)
-	CreateProposal(ctx context.Context, roadmapItemID uuid.UUID, pType domain.ProposalType, diff map[string]interface{}, reasoning string, confidence float64) (*domain.AiProposal, error)
-	ApproveProposal(ctx context.Context, id uuid.UUID, userID uuid.UUID) error
-	RejectProposal(ctx context.Context, id uuid.UUID, userID uuid.UUID) error
+	CreateProposal(ctx context.Context, roadmapItemID uuid.UUID, pType domain.ProposalType, diff map[string]interface{}, reasoning string, confidence float64, createdBy uuid.UUID) (*domain.AiProposal, error)
+	GetReviewState(ctx context.Context, id uuid.UUID) (*domain.ProposalReviewState, error)
+	PreviewProposal(ctx context.Context, id uuid.UUID) (*domain.PatchPreview, error)
+	ApproveProposal(ctx context.Context, id uuid.UUID, reviewer domain.Principal, comment string) (*domain.ProposalReviewState, error)
+	RequestChanges(ctx context.Context, id uuid.UUID, reviewer domain.Principal, comment string) (*domain.ProposalReviewState, error)
+	RejectProposal(ctx context.Context, id uuid.UUID, reviewer domain.Principal, comment string) (*domain.ProposalReviewState, error)
+	ResubmitProposal(ctx context.Context, id uuid.UUID, diff map[string]interface{}, reasoning string, userID uuid.UUID) (*domain.AiProposal, error)
+	RebaseProposal(ctx context.Context, id uuid.UUID, userID uuid.UUID) (*domain.RebaseResult, error)
 }
 
 type AuditLogRepository interface {
@@ -194,6 +244,7 @@ type ValidationRuleService interface {
 	CreateValidationRule(ctx context.Context, projectID uuid.UUID, name, rType string, config map[string]interface{}, description string, userID uuid.UUID) (*domain.ValidationRule, error)
 	UpdateValidationRule(ctx context.Context, id uuid.UUID, name, rType string, config map[string]interface{}, description string, userID uuid.UUID) (*domain.ValidationRule, error)
 	DeleteValidationRule(ctx context.Context, id uuid.UUID, userID uuid.UUID) error
+	EvaluateValidationRules(ctx context.Context, projectID uuid.UUID, ruleIDs []uuid.UUID, variable string, value, payload interface{}) (*rules.Report, error)
 }
 
 // Feature Intelligence
@@ -227,6 +278,76 @@ type GovernanceService interface {
 	CanBuildFeature(ctx context.Context, featureID uuid.UUID) (bool, []string, error)
 	CanDeployFeature(ctx context.Context, featureID uuid.UUID) (bool, []string, error)
 	CanUpdateContract(ctx context.Context, contractID uuid.UUID) (bool, []string, error)
+	EvaluateFeature(ctx context.Context, featureID uuid.UUID, gate governance.Gate) (*domain.GovernanceEvaluation, error)
+	GetPolicy(ctx context.Context, projectID uuid.UUID) (*domain.ProjectGovernancePolicy, error)
+	ListPolicyVersions(ctx context.Context, projectID uuid.UUID) ([]domain.ProjectGovernancePolicy, error)
+	UpdatePolicy(ctx context.Context, projectID uuid.UUID, policy governance.Policy, userID uuid.UUID) (*domain.ProjectGovernancePolicy, error)
+	RequestWaiver(ctx context.Context, featureID uuid.UUID, gate governance.Gate, rule, justification string, expiresAt time.Time, requestedBy uuid.UUID) (*domain.GovernanceWaiver, error)
+	GetWaiver(ctx context.Context, id uuid.UUID) (*domain.GovernanceWaiver, error)
+	ApproveWaiver(ctx context.Context, id uuid.UUID, approver uuid.UUID, comment string) (*domain.GovernanceWaiver, error)
+	RejectWaiver(ctx context.Context, id uuid.UUID, approver uuid.UUID, comment string) (*domain.GovernanceWaiver, error)
+	RevokeWaiver(ctx context.Context, id uuid.UUID, userID uuid.UUID, comment string) (*domain.GovernanceWaiver, error)
+	// ListWaivers is the project's exception register, newest first. An
+	// empty status lists every waiver.
+	ListWaivers(ctx context.Context, projectID uuid.UUID, status domain.WaiverStatus) ([]domain.GovernanceWaiver, error)
+}
+
+// Repositories are the repositories a unit of work writes through.
+type Repositories struct {
+	RoadmapItems             RoadmapItemRepository
+	RoadmapStatusTransitions RoadmapStatusTransitionRepository
+	Contracts                ContractRepository
+	ContractRevisions        ContractRevisionRepository
+	SchemaLintRulesets       SchemaLintRulesetRepository
+	Requirements             RequirementRepository
+	Variables                VariableRepository
+	ValidationRules          ValidationRuleRepository
+	Snapshots                SnapshotRepository
+	Proposals                AiProposalRepository
+	ProposalReviews          ProposalReviewRepository
+	AuditLogs                AuditLogRepository
+	Bootstrap                BootstrapRepository
+	UIRoadmapItems           DocumentRepository
+}
+
+// DocumentRepository exposes entities of a package this one cannot import,
+// such as ui_roadmap, as JSON documents so JSON_PATCH proposals can change
+// them. Documents leave out the fields a patch may not touch.
+type DocumentRepository interface {
+	// GetDocument returns the entity's document and the project it belongs to.
+	GetDocument(ctx context.Context, id uuid.UUID) (map[string]interface{}, uuid.UUID, error)
+	ValidateDocument(ctx context.Context, id uuid.UUID, doc map[string]interface{}) error
+	SaveDocument(ctx context.Context, id uuid.UUID, doc map[string]interface{}) error
+}
+
+// UnitOfWork runs multi-entity writes atomically. Every write fn makes
+// through repos commits if fn returns nil and rolls back otherwise.
+type UnitOfWork interface {
+	Do(ctx context.Context, fn func(ctx context.Context, repos Repositories) error) error
+	// Read runs fn against the repositories outside a transaction; fn must not write.
+	Read(ctx context.Context, fn func(ctx context.Context, repos Repositories) error) error
+}
+
+type RoadmapStatusTransitionRepository interface {
+	Create(ctx context.Context, t *domain.RoadmapStatusTransition) error
+	List(ctx context.Context, roadmapItemID uuid.UUID) ([]domain.RoadmapStatusTransition, error)
+}
+
+// GovernancePolicyRepository is append-only; every update stores a new version.
+type GovernancePolicyRepository interface {
+	Create(ctx context.Context, p *domain.ProjectGovernancePolicy) error
+	GetLatest(ctx context.Context, projectID uuid.UUID) (*domain.ProjectGovernancePolicy, error)
+	List(ctx context.Context, projectID uuid.UUID) ([]domain.ProjectGovernancePolicy, error)
+}
+
+type GovernanceWaiverRepository interface {
+	Create(ctx context.Context, w *domain.GovernanceWaiver) error
+	Get(ctx context.Context, id uuid.UUID) (*domain.GovernanceWaiver, error)
+	// Decide records the decision on a waiver that is still in status from.
+	// It returns false if the waiver was decided concurrently.
+	Decide(ctx context.Context, w *domain.GovernanceWaiver, from domain.WaiverStatus) (bool, error)
+	ListByProject(ctx context.Context, projectID uuid.UUID) ([]domain.GovernanceWaiver, error)
+	ListByRoadmapItem(ctx context.Context, roadmapItemID uuid.UUID) ([]domain.GovernanceWaiver, error)
 }
 
 type DiffService interface {
@@ -343,6 +464,10 @@ type ImportService interface {
 	FinalizeImport(ctx context.Context, input domain.ToolInputFinalizeProjectImport) (*domain.ToolOutputFinalizeProjectImport, error)
 }
 
+type OpenAPIImportService interface {
+	ImportOpenAPI(ctx context.Context, input domain.OpenAPIImportInput) (*domain.OpenAPIImportResult, error)
+}
+
 type ImportSessionRepository interface {
 	CreateSession(ctx context.Context, session *domain.ImportSession) error
 	GetSession(ctx context.Context, id uuid.UUID) (*domain.ImportSession, error)
@@ -0,0 +1,356 @@
+package app
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/SpecForgeVC/SpecForge/internal/domain"
+	"github.com/SpecForgeVC/SpecForge/internal/openapi"
+	"github.com/SpecForgeVC/SpecForge/internal/traffic"
+	"github.com/google/uuid"
+)
+
+// ErrInvalidOpenAPIImport wraps problems with an import request or its document.
+var ErrInvalidOpenAPIImport = errors.New("invalid openapi import")
+
+type openAPIImportService struct {
+	roadmap   RoadmapItemService
+	contracts ContractService
+	variables VariableService
+}
+
+// NewOpenAPIImportService imports through the roadmap, contract and variable
+// services, so imported contracts get the same versioning, lint and
+// governance checks as manual edits.
+func NewOpenAPIImportService(roadmap RoadmapItemService, contracts ContractService, variables VariableService) OpenAPIImportService {
+	return &openAPIImportService{roadmap: roadmap, contracts: contracts, variables: variables}
+}
+
+// openAPIImport is the state of one ImportOpenAPI call.
+type openAPIImport struct {
+	input    domain.OpenAPIImportInput
+	spec     *openapi.Spec
+	version  string
+	items    []domain.RoadmapItem
+	used     map[uuid.UUID]bool
+	existing map[uuid.UUID]domain.ContractDefinition
+	routes   []traffic.Route
+	claimed  map[uuid.UUID]string
+	result   *domain.OpenAPIImportResult
+}
+
+// ImportOpenAPI creates or updates one REST contract per operation of the
+// document, with a variable per parameter and response header. An operation
+// whose method and path match an existing plain REST contract updates it;
+// unchanged contracts are skipped. Versioned updates rejected for their
+// version, and routes already covered by embedded OpenAPI documents or by
+// several contracts, are reported as conflicts rather than failing the import.
+func (s *openAPIImportService) ImportOpenAPI(ctx context.Context, input domain.OpenAPIImportInput) (*domain.OpenAPIImportResult, error) {
+	if len(input.Document) == 0 {
+		return nil, fmt.Errorf("%w: document is required", ErrInvalidOpenAPIImport)
+	}
+	if !input.GroupByTag && input.RoadmapItemID == uuid.Nil {
+		return nil, fmt.Errorf("%w: roadmap_item_id is required unless group_by_tag is set", ErrInvalidOpenAPIImport)
+	}
+	spec, err := openapi.LoadSpec(input.Document)
+	if err != nil {
+		var refErr *openapi.UnresolvedRefsError
+		if errors.As(err, &refErr) {
+			return nil, err
+		}
+		return nil, fmt.Errorf("%w: %v", ErrInvalidOpenAPIImport, err)
+	}
+
+	imp := &openAPIImport{
+		input:    input,
+		spec:     spec,
+		version:  "1.0.0",
+		used:     make(map[uuid.UUID]bool),
+		existing: make(map[uuid.UUID]domain.ContractDefinition),
+		claimed:  make(map[uuid.UUID]string),
+		result: &domain.OpenAPIImportResult{
+			ProjectID:    input.ProjectID,
+			Title:        spec.Title,
+			Version:      spec.Version,
+			RoadmapItems: []domain.OpenAPIImportRoadmapItem{},
+			Operations:   []domain.OpenAPIImportOperation{},
+		},
+	}
+	if _, err := domain.ParseSemVer(spec.Version); err == nil {
+		imp.version = spec.Version
+	}
+
+	if imp.items, err = s.roadmap.ListRoadmapItems(ctx, input.ProjectID); err != nil {
+		return nil, err
+	}
+	if input.RoadmapItemID != uuid.Nil && imp.item(input.RoadmapItemID) == nil {
+		return nil, fmt.Errorf("%w: roadmap item %s is not in project %s", ErrInvalidOpenAPIImport, input.RoadmapItemID, input.ProjectID)
+	}
+	contracts, err := s.contracts.ListContractsByProject(ctx, input.ProjectID)
+	if err != nil {
+		return nil, err
+	}
+	for _, c := range contracts {
+		imp.existing[c.ID] = c
+	}
+	imp.routes = traffic.RoutesFromContracts(contracts)
+
+	for _, op := range spec.Operations {
+		entry, err := s.importOperation(ctx, imp, op)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", op.Key(), err)
+		}
+		switch entry.Status {
+		case domain.ImportCreated:
+			imp.result.Created++
+		case domain.ImportUpdated:
+			imp.result.Updated++
+		case domain.ImportSkipped:
+			imp.result.Skipped++
+		case domain.ImportConflict:
+			imp.result.Conflicts++
+		}
+		imp.result.Operations = append(imp.result.Operations, entry)
+	}
+	return imp.result, nil
+}
+
+func (s *openAPIImportService) importOperation(ctx context.Context, imp *openAPIImport, op openapi.Operation) (domain.OpenAPIImportOperation, error) {
+	entry := domain.OpenAPIImportOperation{Method: op.Method, Path: op.Path, OperationID: op.OperationID}
+	conflict := func(format string, args ...interface{}) (domain.OpenAPIImportOperation, error) {
+		entry.Status, entry.Reason = domain.ImportConflict, fmt.Sprintf(format, args...)
+		return entry, nil
+	}
+
+	input := map[string]interface{}{"path": op.Path, "method": op.Method}
+	for k, v := range op.Request {
+		input[k] = v
+	}
+
+	var matches []uuid.UUID
+	for _, r := range imp.routes {
+		if r.Path != op.Path || (r.Method != "" && r.Method != op.Method) || containsID(matches, r.ContractID) {
+			continue
+		}
+		if _, embedded := imp.existing[r.ContractID].InputSchema["openapi"]; embedded {
+			return conflict("route is documented by the OpenAPI document embedded in contract %s", r.ContractID)
+		}
+		matches = append(matches, r.ContractID)
+	}
+	if len(matches) > 1 {
+		return conflict("route matches %d existing contracts", len(matches))
+	}
+
+	var contract *domain.ContractDefinition
+	if len(matches) == 0 {
+		itemID, err := s.target(ctx, imp, op)
+		if err != nil {
+			return entry, err
+		}
+		c, err := s.contracts.CreateContract(ctx, itemID, domain.REST, imp.version, input, op.Response, op.Error, imp.input.UserID)
+		if errors.Is(err, ErrInvalidContractSchema) {
+			entry.Status, entry.Reason = domain.ImportSkipped, err.Error()
+			return entry, nil
+		}
+		if err != nil {
+			return entry, err
+		}
+		contract, entry.Status = c, domain.ImportCreated
+	} else {
+		old := imp.existing[matches[0]]
+		if other, ok := imp.claimed[old.ID]; ok {
+			return conflict("contract %s was already imported for %s", old.ID, other)
+		}
+		imp.claimed[old.ID] = op.Key()
+		if sameSchema(old.InputSchema, input) && sameSchema(old.OutputSchema, op.Response) && sameSchema(old.ErrorSchema, op.Error) {
+			contract, entry.Status, entry.Reason = &old, domain.ImportSkipped, "contract is unchanged"
+		} else {
+			version := imp.version
+			if _, err := domain.ParseSemVer(imp.spec.Version); err != nil {
+				version = old.Version
+			}
+			c, err := s.contracts.UpdateContract(ctx, old.ID, domain.REST, version, input, op.Response, op.Error, nil, imp.input.UserID)
+			var bumpErr *VersionBumpError
+			switch {
+			case errors.As(err, &bumpErr), errors.Is(err, ErrInvalidContractVersion), errors.Is(err, ErrContractLocked):
+				entry.ContractID, entry.RoadmapItemID = &old.ID, &old.RoadmapItemID
+				return conflict("contract %s: %v", old.ID, err)
+			case errors.Is(err, ErrInvalidContractSchema):
+				entry.ContractID, entry.RoadmapItemID = &old.ID, &old.RoadmapItemID
+				entry.Status, entry.Reason = domain.ImportSkipped, err.Error()
+				return entry, nil
+			case err != nil:
+				return entry, err
+			}
+			contract, entry.Status = c, domain.ImportUpdated
+		}
+		if item := imp.item(old.RoadmapItemID); item != nil {
+			imp.use(*item, "", false)
+		}
+	}
+	entry.ContractID, entry.RoadmapItemID = &contract.ID, &contract.RoadmapItemID
+
+	created, updated, err := s.syncVariables(ctx, contract.ID, op.Parameters, imp.input.UserID)
+	if err != nil {
+		return entry, err
+	}
+	entry.VariablesCreated, entry.VariablesUpdated = created, updated
+	return entry, nil
+}
+
+// target returns the roadmap item a new contract for op goes to, creating
+// the item for its tag when grouping by tag.
+func (s *openAPIImportService) target(ctx context.Context, imp *openAPIImport, op openapi.Operation) (uuid.UUID, error) {
+	tag := ""
+	if len(op.Tags) > 0 {
+		tag = strings.TrimSpace(op.Tags[0])
+	}
+	if !imp.input.GroupByTag || (tag == "" && imp.input.RoadmapItemID != uuid.Nil) {
+		item := imp.item(imp.input.RoadmapItemID)
+		imp.use(*item, "", false)
+		return item.ID, nil
+	}
+
+	title := tag
+	if title == "" {
+		title = strings.TrimSpace(imp.spec.Title)
+	}
+	if title == "" {
+		title = "Imported API"
+	}
+	for _, item := range imp.items {
+		if item.Title == title {
+			imp.use(item, tag, false)
+			return item.ID, nil
+		}
+	}
+	item, err := s.roadmap.CreateRoadmapItem(ctx, &domain.RoadmapItem{
+		ProjectID:   imp.input.ProjectID,
+		Type:        domain.Feature,
+		Title:       title,
+		Description: fmt.Sprintf("Operations imported from the OpenAPI document %q %s.", imp.spec.Title, imp.spec.Version),
+		Priority:    domain.PriorityMedium,
+		RiskLevel:   domain.RiskLow,
+	}, imp.input.UserID)
+	if err != nil {
+		return uuid.Nil, err
+	}
+	imp.items = append(imp.items, *item)
+	imp.use(*item, tag, true)
+	return item.ID, nil
+}
+
+func (imp *openAPIImport) item(id uuid.UUID) *domain.RoadmapItem {
+	for i := range imp.items {
+		if imp.items[i].ID == id {
+			return &imp.items[i]
+		}
+	}
+	return nil
+}
+
+// use lists the roadmap item in the result the first time it is used.
+func (imp *openAPIImport) use(item domain.RoadmapItem, tag string, created bool) {
+	if imp.used[item.ID] {
+		return
+	}
+	imp.used[item.ID] = true
+	imp.result.RoadmapItems = append(imp.result.RoadmapItems, domain.OpenAPIImportRoadmapItem{ID: item.ID, Title: item.Title, Tag: tag, Created: created})
+}
+
+// syncVariables creates a variable per parameter and updates those whose
+// definition changed. Variables match on name and location; variables created
+// by hand match on name alone. Variables without a parameter are kept.
+func (s *openAPIImportService) syncVariables(ctx context.Context, contractID uuid.UUID, params []openapi.Parameter, userID uuid.UUID) (created, updated int, err error) {
+	existing, err := s.variables.ListVariables(ctx, contractID)
+	if err != nil {
+		return 0, 0, err
+	}
+	for _, p := range params {
+		want := parameterVariable(p)
+		var match *domain.VariableDefinition
+		for i := range existing {
+			in, _ := existing[i].ValidationRules["in"].(string)
+			if existing[i].Name == p.Name && (in == p.In || (in == "" && match == nil)) {
+				match = &existing[i]
+			}
+		}
+		if match == nil {
+			v, err := s.variables.CreateVariable(ctx, contractID, want.Name, want.Type, want.Required, want.DefaultValue, want.Description, want.ValidationRules, userID)
+			if err != nil {
+				return created, updated, err
+			}
+			existing = append(existing, *v)
+			created++
+			continue
+		}
+		if match.Type == want.Type && match.Required == want.Required && match.DefaultValue == want.DefaultValue &&
+			match.Description == want.Description && sameSchema(match.ValidationRules, want.ValidationRules) {
+			continue
+		}
+		if _, err := s.variables.UpdateVariable(ctx, match.ID, want.Name, want.Type, want.Required, want.DefaultValue, want.Description, want.ValidationRules, userID); err != nil {
+			return created, updated, err
+		}
+		*match = want
+		updated++
+	}
+	return created, updated, nil
+}
+
+// parameterVariable describes a parameter as a variable. Its location and
+// schema are kept in the validation rules.
+func parameterVariable(p openapi.Parameter) domain.VariableDefinition {
+	v := domain.VariableDefinition{
+		Name:            p.Name,
+		Type:            "string",
+		Required:        p.Required || p.In == "path",
+		Description:     p.Description,
+		ValidationRules: map[string]interface{}{"in": p.In},
+	}
+	if p.Schema == nil {
+		return v
+	}
+	v.ValidationRules["schema"] = p.Schema
+	switch t := p.Schema["type"].(type) {
+	case string:
+		v.Type = t
+	case []interface{}:
+		for _, name := range t {
+			if s, ok := name.(string); ok && s != "null" {
+				v.Type = s
+				break
+			}
+		}
+	}
+	switch d := p.Schema["default"].(type) {
+	case nil:
+	case string:
+		v.DefaultValue = d
+	default:
+		data, _ := json.Marshal(d)
+		v.DefaultValue = string(data)
+	}
+	return v
+}
+
+// sameSchema compares two JSON documents, treating nil and empty as equal.
+func sameSchema(a, b map[string]interface{}) bool {
+	if len(a) == 0 || len(b) == 0 {
+		return len(a) == len(b)
+	}
+	da, errA := json.Marshal(a)
+	db, errB := json.Marshal(b)
+	return errA == nil && errB == nil && string(da) == string(db)
+}
+
+func containsID(ids []uuid.UUID, id uuid.UUID) bool {
+	for _, i := range ids {
+		if i == id {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,243 @@
+package app
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/SpecForgeVC/SpecForge/internal/domain"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+type memContractRepo struct {
+	contracts []domain.ContractDefinition
+}
+
+func (m *memContractRepo) Get(ctx context.Context, id uuid.UUID) (*domain.ContractDefinition, error) {
+	for _, c := range m.contracts {
+		if c.ID == id {
+			return &c, nil
+		}
+	}
+	return nil, nil
+}
+func (m *memContractRepo) GetForUpdate(ctx context.Context, id uuid.UUID) (*domain.ContractDefinition, error) {
+	return m.Get(ctx, id)
+}
+func (m *memContractRepo) List(ctx context.Context, roadmapItemID uuid.UUID) ([]domain.ContractDefinition, error) {
+	return nil, nil
+}
+func (m *memContractRepo) ListByProject(ctx context.Context, projectID uuid.UUID) ([]domain.ContractDefinition, error) {
+	return append([]domain.ContractDefinition(nil), m.contracts...), nil
+}
+func (m *memContractRepo) Create(ctx context.Context, c *domain.ContractDefinition) error {
+	m.contracts = append(m.contracts, *c)
+	return nil
+}
+func (m *memContractRepo) Update(ctx context.Context, c *domain.ContractDefinition) error {
+	for i := range m.contracts {
+		if m.contracts[i].ID == c.ID {
+			m.contracts[i] = *c
+		}
+	}
+	return nil
+}
+func (m *memContractRepo) Delete(ctx context.Context, id uuid.UUID) error { return nil }
+
+type memRoadmapService struct {
+	RoadmapItemService // only listing and creating are exercised here
+	items              []domain.RoadmapItem
+}
+
+func (m *memRoadmapService) ListRoadmapItems(ctx context.Context, projectID uuid.UUID) ([]domain.RoadmapItem, error) {
+	return m.items, nil
+}
+func (m *memRoadmapService) CreateRoadmapItem(ctx context.Context, item *domain.RoadmapItem, userID uuid.UUID) (*domain.RoadmapItem, error) {
+	item.ID = uuid.New()
+	m.items = append(m.items, *item)
+	return item, nil
+}
+
+type memVariableService struct {
+	VariableService // only listing, creating and updating are exercised here
+	variables       []domain.VariableDefinition
+}
+
+func (m *memVariableService) ListVariables(ctx context.Context, contractID uuid.UUID) ([]domain.VariableDefinition, error) {
+	var out []domain.VariableDefinition
+	for _, v := range m.variables {
+		if v.ContractID == contractID {
+			out = append(out, v)
+		}
+	}
+	return out, nil
+}
+func (m *memVariableService) CreateVariable(ctx context.Context, contractID uuid.UUID, name, vType string, required bool, defaultValue, description string, validationRules map[string]interface{}, userID uuid.UUID) (*domain.VariableDefinition, error) {
+	v := domain.VariableDefinition{ID: uuid.New(), ContractID: contractID, Name: name, Type: vType, Required: required, DefaultValue: defaultValue, Description: description, ValidationRules: validationRules}
+	m.variables = append(m.variables, v)
+	return &v, nil
+}
+func (m *memVariableService) UpdateVariable(ctx context.Context, id uuid.UUID, name, vType string, required bool, defaultValue, description string, validationRules map[string]interface{}, userID uuid.UUID) (*domain.VariableDefinition, error) {
+	for i := range m.variables {
+		if m.variables[i].ID == id {
+			v := &m.variables[i]
+			v.Name, v.Type, v.Required, v.DefaultValue, v.Description, v.ValidationRules = name, vType, required, defaultValue, description, validationRules
+			return v, nil
+		}
+	}
+	return nil, nil
+}
+
+const ordersAPI = `
+openapi: 3.0.3
+info: {title: Orders, version: VERSION}
+paths:
+  /orders:
+    post:
+      tags: [orders]
+      requestBody:
+        content:
+          application/json:
+            schema:
+              type: object
+              required: [sku]
+              properties:
+                sku: {type: string, description: Product SKU}
+      responses:
+        "201":
+          content:
+            application/json:
+              schema:
+                type: object
+                properties:
+                  id: {type: string, description: Order id}
+                  OUTPUT
+        "400":
+          content:
+            application/json:
+              schema:
+                type: object
+                properties:
+                  message: {type: string, description: What went wrong}
+  /health:
+    get:
+      parameters:
+        - name: verbose
+          in: query
+          description: DESCRIPTION
+          schema: {type: boolean, default: false}
+      responses:
+        "200":
+          content:
+            application/json:
+              schema:
+                type: object
+                properties:
+                  status: {type: string, description: Service status}
+`
+
+func ordersDocument(version, output, description string) []byte {
+	return []byte(strings.NewReplacer("VERSION", version, "OUTPUT", output, "DESCRIPTION", description).Replace(ordersAPI))
+}
+
+func TestImportOpenAPI(t *testing.T) {
+	ctx := context.Background()
+	projectID := uuid.New()
+	platform := domain.RoadmapItem{ID: uuid.New(), ProjectID: projectID, Title: "Platform"}
+	roadmap := &memRoadmapService{items: []domain.RoadmapItem{platform}}
+	contracts := &memContractRepo{}
+	roadmapRepo := new(mockRoadmapRepo)
+	roadmapRepo.On("Get", mock.Anything, mock.Anything).Return((*domain.RoadmapItem)(nil), errors.New("not found"))
+	contractSvc := newTestContractService(contracts, &memRevisionRepo{}, roadmapRepo, nil)
+	variables := &memVariableService{}
+	svc := NewOpenAPIImportService(roadmap, contractSvc, variables)
+
+	input := domain.OpenAPIImportInput{ProjectID: projectID, RoadmapItemID: platform.ID, GroupByTag: true, Document: ordersDocument("1.0.0", "", "Verbose output")}
+	result, err := svc.ImportOpenAPI(ctx, input)
+	assert.NoError(t, err)
+	assert.Equal(t, 2, result.Created)
+	if assert.Len(t, result.RoadmapItems, 2) {
+		// Untagged operations go to the target item; tagged ones to an item per tag.
+		assert.Equal(t, platform.ID, result.RoadmapItems[0].ID)
+		assert.Equal(t, "orders", result.RoadmapItems[1].Title)
+		assert.True(t, result.RoadmapItems[1].Created)
+	}
+	assert.Len(t, contracts.contracts, 2)
+	health := result.Operations[0]
+	assert.Equal(t, "GET /health", health.Method+" "+health.Path)
+	assert.Equal(t, platform.ID, *health.RoadmapItemID)
+	assert.Equal(t, 1, health.VariablesCreated)
+	if assert.Len(t, variables.variables, 1) {
+		v := variables.variables[0]
+		assert.Equal(t, "verbose", v.Name)
+		assert.Equal(t, "boolean", v.Type)
+		assert.Equal(t, "false", v.DefaultValue)
+		assert.Equal(t, "query", v.ValidationRules["in"])
+	}
+	var order domain.ContractDefinition
+	for _, c := range contracts.contracts {
+		if c.ID == *result.Operations[1].ContractID {
+			order = c
+		}
+	}
+	assert.Equal(t, "/orders", order.InputSchema["path"])
+	assert.Equal(t, "POST", order.InputSchema["method"])
+	assert.Equal(t, []interface{}{"sku"}, order.InputSchema["required"])
+	assert.NotEmpty(t, order.ErrorSchema)
+
+	// Re-importing the same document changes nothing.
+	result, err = svc.ImportOpenAPI(ctx, input)
+	assert.NoError(t, err)
+	assert.Equal(t, 2, result.Skipped)
+	assert.Len(t, contracts.contracts, 2)
+	assert.Len(t, roadmap.items, 2)
+	assert.Len(t, variables.variables, 1)
+
+	// A changed schema needs a version bump; a changed parameter updates its variable.
+	input.Document = ordersDocument("1.0.0", "total: {type: number, description: Order total}", "Include details")
+	result, err = svc.ImportOpenAPI(ctx, input)
+	assert.NoError(t, err)
+	assert.Equal(t, 1, result.Conflicts)
+	assert.Equal(t, domain.ImportConflict, result.Operations[1].Status)
+	assert.Contains(t, result.Operations[1].Reason, "MINOR")
+	assert.Equal(t, 1, result.Operations[0].VariablesUpdated)
+	assert.Equal(t, "Include details", variables.variables[0].Description)
+
+	input.Document = ordersDocument("1.1.0", "total: {type: number, description: Order total}", "Include details")
+	result, err = svc.ImportOpenAPI(ctx, input)
+	assert.NoError(t, err)
+	assert.Equal(t, 1, result.Updated)
+	assert.Equal(t, domain.ImportUpdated, result.Operations[1].Status)
+	assert.Len(t, contracts.contracts, 2)
+}
+
+func TestImportOpenAPI_Conflicts(t *testing.T) {
+	ctx := context.Background()
+	projectID := uuid.New()
+	item := domain.RoadmapItem{ID: uuid.New(), ProjectID: projectID, Title: "Platform"}
+	embedded := domain.ContractDefinition{ID: uuid.New(), RoadmapItemID: item.ID, ContractType: domain.REST, Version: "1.0.0", InputSchema: map[string]interface{}{
+		"openapi": "3.0.3",
+		"info":    map[string]interface{}{"title": "Health", "version": "1.0.0"},
+		"paths":   map[string]interface{}{"/health": map[string]interface{}{"get": map[string]interface{}{"responses": map[string]interface{}{}}}},
+	}}
+	contracts := &memContractRepo{contracts: []domain.ContractDefinition{embedded}}
+	contractSvc := newTestContractService(contracts, &memRevisionRepo{}, new(mockRoadmapRepo), nil)
+	svc := NewOpenAPIImportService(&memRoadmapService{items: []domain.RoadmapItem{item}}, contractSvc, &memVariableService{})
+
+	doc := []byte(strings.NewReplacer("VERSION", "1.0.0", "OUTPUT", "", "DESCRIPTION", "").Replace(ordersAPI))
+	_, err := svc.ImportOpenAPI(ctx, domain.OpenAPIImportInput{ProjectID: projectID, Document: doc})
+	assert.ErrorIs(t, err, ErrInvalidOpenAPIImport)
+	_, err = svc.ImportOpenAPI(ctx, domain.OpenAPIImportInput{ProjectID: projectID, RoadmapItemID: uuid.New(), Document: doc})
+	assert.ErrorIs(t, err, ErrInvalidOpenAPIImport)
+
+	// Only the conflicting route is imported; nothing else is created.
+	doc = []byte(strings.SplitN(string(doc), "  /orders:", 2)[0] + "  /health:" + strings.SplitN(string(doc), "  /health:", 2)[1])
+	result, err := svc.ImportOpenAPI(ctx, domain.OpenAPIImportInput{ProjectID: projectID, RoadmapItemID: item.ID, Document: doc})
+	assert.NoError(t, err)
+	assert.Equal(t, 1, result.Conflicts)
+	assert.Contains(t, result.Operations[0].Reason, embedded.ID.String())
+	assert.Len(t, contracts.contracts, 1)
+}
@@ -0,0 +1,402 @@
+package app
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/SpecForgeVC/SpecForge/internal/domain"
+	"github.com/SpecForgeVC/SpecForge/internal/drift"
+	"github.com/SpecForgeVC/SpecForge/internal/jsonpatch"
+	"github.com/SpecForgeVC/SpecForge/internal/schemalint"
+	"github.com/google/uuid"
+)
+
+var (
+	ErrInvalidProposal  = errors.New("invalid proposal")
+	ErrNotPatchProposal = errors.New("proposal is not a JSON_PATCH proposal")
+)
+
+// patchTarget loads one kind of entity as a JSON document, checks a patched
+// document against the entity's rules and writes it back, all through the
+// unit of work's repositories.
+type patchTarget interface {
+	// load returns the entity's document and the project it belongs to.
+	load(ctx context.Context, repos Repositories, id uuid.UUID) (map[string]interface{}, uuid.UUID, error)
+	validate(ctx context.Context, repos Repositories, id uuid.UUID, doc map[string]interface{}) error
+	// save writes the document of the approved proposal p; userID is the
+	// reviewer whose approval completed its quorum.
+	save(ctx context.Context, repos Repositories, p *domain.AiProposal, userID uuid.UUID, id uuid.UUID, doc map[string]interface{}) error
+}
+
+var patchTargets = map[domain.PatchTargetType]patchTarget{
+	domain.PatchTargetRoadmapItem: entityTarget[domain.RoadmapItem]{
+		// Status only moves through the roadmap workflow.
+		fixed: []string{"id", "project_id", "status", "created_at", "updated_at"},
+		get: func(ctx context.Context, repos Repositories, id uuid.UUID) (*domain.RoadmapItem, error) {
+			return repos.RoadmapItems.Get(ctx, id)
+		},
+		project: func(ctx context.Context, repos Repositories, r *domain.RoadmapItem) (uuid.UUID, error) {
+			return r.ProjectID, nil
+		},
+		check: checkRoadmapItem,
+		update: func(ctx context.Context, repos Repositories, p *domain.AiProposal, userID uuid.UUID, r *domain.RoadmapItem) error {
+			return repos.RoadmapItems.Update(ctx, r)
+		},
+	},
+	domain.PatchTargetContract: entityTarget[domain.ContractDefinition]{
+		fixed: []string{"id", "roadmap_item_id", "created_at"},
+		get: func(ctx context.Context, repos Repositories, id uuid.UUID) (*domain.ContractDefinition, error) {
+			return repos.Contracts.Get(ctx, id)
+		},
+		project: func(ctx context.Context, repos Repositories, c *domain.ContractDefinition) (uuid.UUID, error) {
+			return roadmapItemProject(ctx, repos, c.RoadmapItemID)
+		},
+		check: checkContract,
+		// The version must grow by the bump the patch's drift requires, as in a manual edit.
+		change: func(current, c *domain.ContractDefinition) error {
+			_, _, err := checkVersionBump(current, c)
+			return err
+		},
+		update: func(ctx context.Context, repos Repositories, p *domain.AiProposal, userID uuid.UUID, c *domain.ContractDefinition) error {
+			old, err := repos.Contracts.GetForUpdate(ctx, c.ID)
+			if err != nil {
+				return err
+			}
+			return applyContractUpdate(ctx, repos, p, old, c, userID)
+		},
+	},
+	domain.PatchTargetRequirement: entityTarget[domain.Requirement]{
+		fixed: []string{"id", "roadmap_item_id"},
+		get: func(ctx context.Context, repos Repositories, id uuid.UUID) (*domain.Requirement, error) {
+			return repos.Requirements.Get(ctx, id)
+		},
+		project: func(ctx context.Context, repos Repositories, r *domain.Requirement) (uuid.UUID, error) {
+			return roadmapItemProject(ctx, repos, r.RoadmapItemID)
+		},
+		check: func(r *domain.Requirement) error {
+			return requireField("title", r.Title)
+		},
+		update: func(ctx context.Context, repos Repositories, p *domain.AiProposal, userID uuid.UUID, r *domain.Requirement) error {
+			return repos.Requirements.Update(ctx, r)
+		},
+	},
+	domain.PatchTargetVariable: entityTarget[domain.VariableDefinition]{
+		fixed: []string{"id", "contract_id"},
+		get: func(ctx context.Context, repos Repositories, id uuid.UUID) (*domain.VariableDefinition, error) {
+			return repos.Variables.Get(ctx, id)
+		},
+		project: func(ctx context.Context, repos Repositories, v *domain.VariableDefinition) (uuid.UUID, error) {
+			c, err := repos.Contracts.Get(ctx, v.ContractID)
+			if err != nil {
+				return uuid.Nil, err
+			}
+			return roadmapItemProject(ctx, repos, c.RoadmapItemID)
+		},
+		check: func(v *domain.VariableDefinition) error {
+			if err := requireField("name", v.Name); err != nil {
+				return err
+			}
+			return requireField("type", v.Type)
+		},
+		update: func(ctx context.Context, repos Repositories, p *domain.AiProposal, userID uuid.UUID, v *domain.VariableDefinition) error {
+			return repos.Variables.Update(ctx, v)
+		},
+	},
+	domain.PatchTargetValidationRule: entityTarget[domain.ValidationRule]{
+		fixed: []string{"id", "project_id", "created_at"},
+		get: func(ctx context.Context, repos Repositories, id uuid.UUID) (*domain.ValidationRule, error) {
+			return repos.ValidationRules.Get(ctx, id)
+		},
+		project: func(ctx context.Context, repos Repositories, r *domain.ValidationRule) (uuid.UUID, error) {
+			return r.ProjectID, nil
+		},
+		check: checkValidationRule,
+		update: func(ctx context.Context, repos Repositories, p *domain.AiProposal, userID uuid.UUID, r *domain.ValidationRule) error {
+			return repos.ValidationRules.Update(ctx, r)
+		},
+	},
+	domain.PatchTargetUIRoadmapItem: documentTarget{},
+}
+
+// entityTarget is a patchTarget for an entity whose JSON form, minus the
+// fixed identity and ownership fields, is the patchable document. change,
+// if set, checks the patched entity against the stored one.
+type entityTarget[T any] struct {
+	fixed   []string
+	get     func(ctx context.Context, repos Repositories, id uuid.UUID) (*T, error)
+	project func(ctx context.Context, repos Repositories, entity *T) (uuid.UUID, error)
+	check   func(entity *T) error
+	change  func(current, entity *T) error
+	update  func(ctx context.Context, repos Repositories, p *domain.AiProposal, userID uuid.UUID, entity *T) error
+}
+
+func (t entityTarget[T]) load(ctx context.Context, repos Repositories, id uuid.UUID) (map[string]interface{}, uuid.UUID, error) {
+	entity, err := t.get(ctx, repos, id)
+	if err != nil {
+		return nil, uuid.Nil, err
+	}
+	if entity == nil {
+		return nil, uuid.Nil, fmt.Errorf("target %s not found", id)
+	}
+	projectID, err := t.project(ctx, repos, entity)
+	if err != nil {
+		return nil, uuid.Nil, err
+	}
+	doc, err := toDocument(entity)
+	if err != nil {
+		return nil, uuid.Nil, err
+	}
+	for _, f := range t.fixed {
+		delete(doc, f)
+	}
+	return doc, projectID, nil
+}
+
+func (t entityTarget[T]) validate(ctx context.Context, repos Repositories, id uuid.UUID, doc map[string]interface{}) error {
+	_, err := t.decode(ctx, repos, id, doc)
+	return err
+}
+
+func (t entityTarget[T]) save(ctx context.Context, repos Repositories, p *domain.AiProposal, userID uuid.UUID, id uuid.UUID, doc map[string]interface{}) error {
+	entity, err := t.decode(ctx, repos, id, doc)
+	if err != nil {
+		return err
+	}
+	return t.update(ctx, repos, p, userID, entity)
+}
+
+// decode rebuilds the entity from doc and the stored entity's fixed fields.
+// Unknown fields and values of the wrong type are rejected.
+func (t entityTarget[T]) decode(ctx context.Context, repos Repositories, id uuid.UUID, doc map[string]interface{}) (*T, error) {
+	for _, f := range t.fixed {
+		if _, ok := doc[f]; ok {
+			return nil, fmt.Errorf("%s cannot be patched", f)
+		}
+	}
+	current, err := t.get(ctx, repos, id)
+	if err != nil {
+		return nil, err
+	}
+	full, err := toDocument(current)
+	if err != nil {
+		return nil, err
+	}
+	merged := make(map[string]interface{}, len(doc)+len(t.fixed))
+	for k, v := range doc {
+		merged[k] = v
+	}
+	for _, f := range t.fixed {
+		merged[f] = full[f]
+	}
+
+	data, err := json.Marshal(merged)
+	if err != nil {
+		return nil, err
+	}
+	entity := new(T)
+	dec := json.NewDecoder(bytes.NewReader(data))
+	dec.DisallowUnknownFields()
+	if err := dec.Decode(entity); err != nil {
+		return nil, err
+	}
+	if err := t.check(entity); err != nil {
+		return nil, err
+	}
+	if t.change != nil {
+		if err := t.change(current, entity); err != nil {
+			return nil, err
+		}
+	}
+	return entity, nil
+}
+
+// documentTarget reaches UI roadmap items through their DocumentRepository.
+type documentTarget struct{}
+
+func (documentTarget) load(ctx context.Context, repos Repositories, id uuid.UUID) (map[string]interface{}, uuid.UUID, error) {
+	return repos.UIRoadmapItems.GetDocument(ctx, id)
+}
+
+func (documentTarget) validate(ctx context.Context, repos Repositories, id uuid.UUID, doc map[string]interface{}) error {
+	return repos.UIRoadmapItems.ValidateDocument(ctx, id, doc)
+}
+
+func (documentTarget) save(ctx context.Context, repos Repositories, p *domain.AiProposal, userID uuid.UUID, id uuid.UUID, doc map[string]interface{}) error {
+	return repos.UIRoadmapItems.SaveDocument(ctx, id, doc)
+}
+
+func toDocument(v interface{}) (map[string]interface{}, error) {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+	var doc map[string]interface{}
+	if err := json.Unmarshal(data, &doc); err != nil {
+		return nil, err
+	}
+	return doc, nil
+}
+
+func roadmapItemProject(ctx context.Context, repos Repositories, roadmapItemID uuid.UUID) (uuid.UUID, error) {
+	rm, err := repos.RoadmapItems.Get(ctx, roadmapItemID)
+	if err != nil {
+		return uuid.Nil, err
+	}
+	return rm.ProjectID, nil
+}
+
+func requireField(name, value string) error {
+	if strings.TrimSpace(value) == "" {
+		return fmt.Errorf("%s is required", name)
+	}
+	return nil
+}
+
+func checkRoadmapItem(r *domain.RoadmapItem) error {
+	if err := requireField("title", r.Title); err != nil {
+		return err
+	}
+	switch r.Type {
+	case domain.Epic, domain.Feature, domain.Task, domain.Bugfix, domain.Refactor:
+	default:
+		return fmt.Errorf("unknown type %q", r.Type)
+	}
+	switch r.Priority {
+	case domain.PriorityLow, domain.PriorityMedium, domain.PriorityHigh, domain.PriorityCritical:
+	default:
+		return fmt.Errorf("unknown priority %q", r.Priority)
+	}
+	switch r.RiskLevel {
+	case domain.RiskLow, domain.RiskMedium, domain.RiskHigh:
+	default:
+		return fmt.Errorf("unknown risk_level %q", r.RiskLevel)
+	}
+	switch r.ReadinessLevel {
+	case "", domain.ReadinessReady, domain.ReadinessReview, domain.ReadinessNeedsRefinement, domain.ReadinessBlocked:
+	default:
+		return fmt.Errorf("unknown readiness_level %q", r.ReadinessLevel)
+	}
+	return nil
+}
+
+func checkContract(c *domain.ContractDefinition) error {
+	switch c.ContractType {
+	case domain.REST, domain.GraphQL, domain.CLI, domain.InternalFunction, domain.Event:
+	default:
+		return fmt.Errorf("unknown contract_type %q", c.ContractType)
+	}
+	if c.InputSchema == nil {
+		c.InputSchema = map[string]interface{}{}
+	}
+	if c.OutputSchema == nil {
+		c.OutputSchema = map[string]interface{}{}
+	}
+	if err := drift.ValidateContract(*c); err != nil {
+		return err
+	}
+	// Patched schemas must still pass the JSON Schema meta-schemas.
+	if report := lintContract(c, schemalint.Ruleset{}); !report.Passed {
+		return &SchemaLintError{Report: report}
+	}
+	return nil
+}
+
+// applyContractUpdate saves the approved proposal p's change from old to c
+// the way UpdateContract saves a manual one: governance holds are read
+// through repos, then the schema, lint and version bump checks run and a
+// revision is recorded.
+func applyContractUpdate(ctx context.Context, repos Repositories, p *domain.AiProposal, old, c *domain.ContractDefinition, userID uuid.UUID) error {
+	reasons, err := contractHolds(ctx, repos.Proposals, old, p)
+	if err != nil {
+		return err
+	}
+	if len(reasons) > 0 {
+		return fmt.Errorf("%w: %v", ErrContractLocked, reasons)
+	}
+	return saveContractUpdate(ctx, repos, old, c, userID)
+}
+
+// previewPatch applies a JSON_PATCH proposal to its target's current state
+// without saving it. Every problem with the proposal wraps ErrInvalidProposal.
+func (s *aiProposalService) previewPatch(ctx context.Context, repos Repositories, p *domain.AiProposal) (*domain.PatchPreview, patchTarget, error) {
+	invalid := func(format string, args ...interface{}) error {
+		return fmt.Errorf("%w: %s", ErrInvalidProposal, fmt.Sprintf(format, args...))
+	}
+	d, err := domain.ParsePatchProposalDiff(p.Diff)
+	if err != nil {
+		return nil, nil, invalid("%v", err)
+	}
+	target, ok := patchTargets[d.TargetType]
+	if !ok {
+		return nil, nil, invalid("unknown target_type %q", d.TargetType)
+	}
+	rm, err := repos.RoadmapItems.Get(ctx, p.RoadmapItemID)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to fetch roadmap item: %w", err)
+	}
+	before, projectID, err := target.load(ctx, repos, d.TargetID)
+	if err != nil {
+		return nil, nil, invalid("%s %s: %v", d.TargetType, d.TargetID, err)
+	}
+	if projectID != rm.ProjectID {
+		return nil, nil, invalid("%s %s belongs to another project", d.TargetType, d.TargetID)
+	}
+	out, err := d.Patch.Apply(before)
+	if err != nil {
+		return nil, nil, invalid("%v", err)
+	}
+	after, ok := out.(map[string]interface{})
+	if !ok {
+		return nil, nil, invalid("patch replaced the %s document with %T", d.TargetType, out)
+	}
+	if err := target.validate(ctx, repos, d.TargetID, after); err != nil {
+		return nil, nil, invalid("%v", err)
+	}
+	return &domain.PatchPreview{
+		ProposalID: p.ID,
+		TargetType: d.TargetType,
+		TargetID:   d.TargetID,
+		Before:     before,
+		After:      after,
+		Changes:    jsonpatch.Diff(before, after),
+	}, target, nil
+}
+
+// PreviewProposal shows the before and after of a JSON_PATCH proposal's
+// target. An error wrapping ErrInvalidProposal means the patch no longer
+// applies cleanly.
+func (s *aiProposalService) PreviewProposal(ctx context.Context, id uuid.UUID) (*domain.PatchPreview, error) {
+	p, err := s.repo.Get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if p.ProposalType != domain.JSONPatch {
+		return nil, ErrNotPatchProposal
+	}
+	var preview *domain.PatchPreview
+	err = s.uow.Do(ctx, func(ctx context.Context, repos Repositories) error {
+		preview, _, err = s.previewPatch(ctx, repos, p)
+		return err
+	})
+	return preview, err
+}
+
+// applyJSONPatch saves the patched target and records its before and after
+// in the target's audit trail.
+func (s *aiProposalService) applyJSONPatch(ctx context.Context, repos Repositories, p *domain.AiProposal, userID uuid.UUID) error {
+	preview, target, err := s.previewPatch(ctx, repos, p)
+	if err != nil {
+		return err
+	}
+	if err := target.save(ctx, repos, p, userID, preview.TargetID, preview.After); err != nil {
+		return err
+	}
+	return NewAuditLogService(repos.AuditLogs).Log(ctx, strings.ToLower(string(preview.TargetType)), preview.TargetID, "JSON_PATCH", userID,
+		preview.Before,
+		map[string]interface{}{"document": preview.After, "proposal_id": p.ID},
+	)
+}
@@ -0,0 +1,174 @@
+package app
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/SpecForgeVC/SpecForge/internal/domain"
+	"github.com/SpecForgeVC/SpecForge/internal/drift"
+	"github.com/SpecForgeVC/SpecForge/internal/jsonpatch"
+	"github.com/google/uuid"
+)
+
+// currentBase loads the proposal's target as it is now, with its hash.
+func (s *aiProposalService) currentBase(ctx context.Context, repos Repositories, p *domain.AiProposal) (map[string]interface{}, string, error) {
+	target, ok := patchTargets[p.TargetType]
+	if !ok {
+		return nil, "", fmt.Errorf("unknown target_type %q", p.TargetType)
+	}
+	doc, _, err := target.load(ctx, repos, p.TargetID)
+	if err != nil {
+		return nil, "", fmt.Errorf("%s %s: %w", p.TargetType, p.TargetID, err)
+	}
+	hash, err := domain.DocumentHash(doc)
+	if err != nil {
+		return nil, "", err
+	}
+	return doc, hash, nil
+}
+
+// captureBase records the version of the target the proposal was made
+// against. Proposals that do not change an existing entity have no base.
+func (s *aiProposalService) captureBase(ctx context.Context, repos Repositories, p *domain.AiProposal) error {
+	t, err := domain.TargetOf(*p)
+	if err != nil {
+		return fmt.Errorf("%w: %v", ErrInvalidProposal, err)
+	}
+	if t == nil {
+		return nil
+	}
+	p.TargetType, p.TargetID = t.Type, t.ID
+	doc, hash, err := s.currentBase(ctx, repos, p)
+	if err != nil {
+		return fmt.Errorf("%w: %v", ErrInvalidProposal, err)
+	}
+	p.BaseHash, p.BaseDocument = hash, doc
+	return nil
+}
+
+// detectStale reports open proposals whose target changed since their base
+// version as STALE without writing: the stored status catches up when the
+// proposal is approved or another proposal on its target is applied. Targets
+// that can no longer be loaded are left for approval to report.
+func (s *aiProposalService) detectStale(ctx context.Context, proposals []*domain.AiProposal) error {
+	var open []*domain.AiProposal
+	for _, p := range proposals {
+		if p.IsOpen() && p.BaseHash != "" {
+			open = append(open, p)
+		}
+	}
+	if len(open) == 0 {
+		return nil
+	}
+	return s.uow.Read(ctx, func(ctx context.Context, repos Repositories) error {
+		type targetKey struct {
+			kind domain.PatchTargetType
+			id   uuid.UUID
+		}
+		hashes := make(map[targetKey]string)
+		for _, p := range open {
+			key := targetKey{p.TargetType, p.TargetID}
+			hash, seen := hashes[key]
+			if !seen {
+				_, current, err := s.currentBase(ctx, repos, p)
+				if err != nil {
+					continue
+				}
+				hash = current
+				hashes[key] = hash
+			}
+			if hash != p.BaseHash {
+				p.Status = domain.Stale
+			}
+		}
+		return nil
+	})
+}
+
+// RebaseProposal moves a proposal's base to the current version of its
+// target. It succeeds when nothing the proposal changes was changed on the
+// target in the meantime and the change still validates; the proposal then
+// returns to review with earlier votes superseded. Otherwise the proposal is
+// left as it was and the conflicts are reported. A proposal that is not STALE
+// and whose target is unchanged is already current and left as it is.
+func (s *aiProposalService) RebaseProposal(ctx context.Context, id uuid.UUID, userID uuid.UUID) (*domain.RebaseResult, error) {
+	p, err := s.repo.Get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if p.Status != domain.Stale && !p.IsOpen() {
+		return nil, fmt.Errorf("%w: proposal is %s", ErrProposalNotReviewable, p.Status)
+	}
+	if p.BaseHash == "" {
+		return nil, fmt.Errorf("%w: proposal has no base version to rebase", ErrInvalidProposal)
+	}
+
+	result := &domain.RebaseResult{Proposal: p}
+	err = s.uow.Do(ctx, func(ctx context.Context, repos Repositories) error {
+		t, err := domain.TargetOf(*p)
+		if err != nil {
+			return fmt.Errorf("%w: %v", ErrInvalidProposal, err)
+		}
+		current, hash, err := s.currentBase(ctx, repos, p)
+		if err != nil {
+			return err
+		}
+		if p.Status != domain.Stale && hash == p.BaseHash {
+			return nil
+		}
+		if result.Conflicts = domain.RebaseConflicts(t.Paths, p.BaseDocument, current); len(result.Conflicts) > 0 {
+			return nil
+		}
+		if err := s.checkRebased(ctx, repos, p); err != nil {
+			return err
+		}
+
+		if err := repos.ProposalReviews.Supersede(ctx, id); err != nil {
+			return err
+		}
+		if err := repos.Proposals.Rebase(ctx, id, p.Diff, hash, current); err != nil {
+			return err
+		}
+		if err := NewAuditLogService(repos.AuditLogs).Log(ctx, "ai_proposal", id, "REBASE", userID,
+			map[string]interface{}{"status": p.Status, "base_hash": p.BaseHash},
+			map[string]interface{}{"status": domain.Pending, "base_hash": hash},
+		); err != nil {
+			return err
+		}
+		p.Status, p.ReviewedBy = domain.Pending, uuid.Nil
+		p.BaseHash, p.BaseDocument = hash, current
+		result.Rebased = true
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// checkRebased re-applies the proposal's change to the target as it is now.
+// Proposals that merge values cannot fail to apply and need no check.
+func (s *aiProposalService) checkRebased(ctx context.Context, repos Repositories, p *domain.AiProposal) error {
+	switch p.ProposalType {
+	case domain.JSONPatch:
+		_, _, err := s.previewPatch(ctx, repos, p)
+		return err
+	case domain.ModifySchema:
+		rawPatch, ok := p.Diff["json_patch"]
+		if !ok {
+			return nil
+		}
+		patch, err := jsonpatch.Decode(rawPatch)
+		if err != nil {
+			return fmt.Errorf("%w: %v", ErrInvalidProposal, err)
+		}
+		contract, err := repos.Contracts.Get(ctx, p.TargetID)
+		if err != nil {
+			return err
+		}
+		if _, err := drift.ApplyContractPatch(*contract, patch); err != nil {
+			return fmt.Errorf("%w: %v", ErrInvalidProposal, err)
+		}
+	}
+	return nil
+}
@@ -2,48 +2,82 @@ package app
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"strings"
+	"time"
 
 	"github.com/SpecForgeVC/SpecForge/internal/domain"
+	"github.com/SpecForgeVC/SpecForge/internal/drift"
+	"github.com/SpecForgeVC/SpecForge/internal/jsonpatch"
 	"github.com/google/uuid"
 )
 
+var (
+	ErrProposalNotReviewable = errors.New("proposal is not awaiting review")
+	ErrSelfApproval          = errors.New("the author of a proposal cannot approve it")
+	ErrReviewCommentRequired = errors.New("a comment is required when requesting changes")
+	ErrProposalStale         = errors.New("proposal is stale: its target changed since it was made; rebase it first")
+)
+
+// aiProposalService reads through its repositories and makes every review
+// decision, including applying an approved proposal, in one unit of work.
 type aiProposalService struct {
-	repo         AiProposalRepository
-	roadmapRepo  RoadmapItemRepository
-	snapshotRepo SnapshotRepository
-	varRepo      VariableRepository
-	contractRepo ContractRepository
-	auditLog     AuditLogService
+	repo        AiProposalRepository
+	reviews     ProposalReviewRepository
+	roadmapRepo RoadmapItemRepository
+	policyRepo  GovernancePolicyRepository
+	uow         UnitOfWork
+	auditLog    AuditLogService
 }
 
 func NewAiProposalService(
 	repo AiProposalRepository,
+	reviews ProposalReviewRepository,
 	rmRepo RoadmapItemRepository,
-	sRepo SnapshotRepository,
-	varRepo VariableRepository,
-	contractRepo ContractRepository,
+	policyRepo GovernancePolicyRepository,
+	uow UnitOfWork,
 	al AuditLogService,
 ) AiProposalService {
 	return &aiProposalService{
-		repo:         repo,
-		roadmapRepo:  rmRepo,
-		snapshotRepo: sRepo,
-		varRepo:      varRepo,
-		contractRepo: contractRepo,
-		auditLog:     al,
+		repo:        repo,
+		reviews:     reviews,
+		roadmapRepo: rmRepo,
+		policyRepo:  policyRepo,
+		uow:         uow,
+		auditLog:    al,
 	}
 }
 
 func (s *aiProposalService) GetProposal(ctx context.Context, id uuid.UUID) (*domain.AiProposal, error) {
-	return s.repo.Get(ctx, id)
+	p, err := s.repo.Get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.detectStale(ctx, []*domain.AiProposal{p}); err != nil {
+		return nil, err
+	}
+	return p, nil
 }
 
 func (s *aiProposalService) ListProposals(ctx context.Context, projectID uuid.UUID) ([]domain.AiProposal, error) {
-	return s.repo.ListByProject(ctx, projectID)
+	proposals, err := s.repo.ListByProject(ctx, projectID)
+	if err != nil {
+		return nil, err
+	}
+	refs := make([]*domain.AiProposal, len(proposals))
+	for i := range proposals {
+		refs[i] = &proposals[i]
+	}
+	if err := s.detectStale(ctx, refs); err != nil {
+		return nil, err
+	}
+	return proposals, nil
 }
 
-func (s *aiProposalService) CreateProposal(ctx context.Context, roadmapItemID uuid.UUID, pType domain.ProposalType, diff map[string]interface{}, reasoning string, confidence float64) (*domain.AiProposal, error) {
+// CreateProposal files a proposal for review. createdBy is the user or agent
+// token that authored it; it may not approve the proposal itself.
+func (s *aiProposalService) CreateProposal(ctx context.Context, roadmapItemID uuid.UUID, pType domain.ProposalType, diff map[string]interface{}, reasoning string, confidence float64, createdBy uuid.UUID) (*domain.AiProposal, error) {
 	p := &domain.AiProposal{
 		ID:              uuid.New(),
 		RoadmapItemID:   roadmapItemID,
@@ -52,63 +86,270 @@ func (s *aiProposalService) CreateProposal(ctx context.Context, roadmapItemID uu
 		Reasoning:       reasoning,
 		ConfidenceScore: confidence,
 		Status:          domain.Pending,
+		CreatedBy:       createdBy,
+	}
+	err := s.uow.Do(ctx, func(ctx context.Context, repos Repositories) error {
+		if pType == domain.JSONPatch {
+			// Reject patches that do not apply or would leave the target
+			// invalid before anyone is asked to review them.
+			if _, _, err := s.previewPatch(ctx, repos, p); err != nil {
+				return err
+			}
+		}
+		return s.captureBase(ctx, repos, p)
+	})
+	if err != nil {
+		return nil, err
 	}
 	if err := s.repo.Create(ctx, p); err != nil {
 		return nil, err
 	}
-	s.auditLog.Log(ctx, "ai_proposal", p.ID, "CREATE", uuid.Nil, nil, map[string]interface{}{"type": p.ProposalType})
+	s.auditLog.Log(ctx, "ai_proposal", p.ID, "CREATE", createdBy, nil, map[string]interface{}{"type": p.ProposalType})
 	return p, nil
 }
 
-func (s *aiProposalService) ApproveProposal(ctx context.Context, id uuid.UUID, userID uuid.UUID) error {
-	p, err := s.repo.Get(ctx, id)
+// GetReviewState returns the proposal's votes and how far it is from the
+// quorum its roadmap item requires.
+func (s *aiProposalService) GetReviewState(ctx context.Context, id uuid.UUID) (*domain.ProposalReviewState, error) {
+	p, err := s.GetProposal(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	return s.reviewState(ctx, p, s.reviews)
+}
+
+// reviewState reads the votes through reviews so that it sees votes written
+// earlier in the same unit of work.
+func (s *aiProposalService) reviewState(ctx context.Context, p *domain.AiProposal, reviews ProposalReviewRepository) (*domain.ProposalReviewState, error) {
+	rm, err := s.roadmapRepo.Get(ctx, p.RoadmapItemID)
 	if err != nil {
+		return nil, fmt.Errorf("failed to fetch roadmap item for proposal review: %w", err)
+	}
+	policy, err := projectPolicy(ctx, s.policyRepo, rm.ProjectID)
+	if err != nil {
+		return nil, err
+	}
+	votes, err := reviews.List(ctx, p.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	review := policy.Policy.ReviewPolicy()
+	quorum := review.Standard
+	if rm.IsHighRisk() {
+		quorum = review.HighRisk
+	}
+
+	// Only each reviewer's latest vote on the current revision counts.
+	latest := make(map[uuid.UUID]domain.ProposalReview)
+	var order []uuid.UUID
+	for _, r := range votes {
+		if r.Superseded {
+			continue
+		}
+		if _, ok := latest[r.ReviewerID]; !ok {
+			order = append(order, r.ReviewerID)
+		}
+		latest[r.ReviewerID] = r
+	}
+	var approverRoles []string
+	approvedBy := []uuid.UUID{}
+	for _, reviewerID := range order {
+		if r := latest[reviewerID]; r.Decision == domain.ReviewApprove {
+			approvedBy = append(approvedBy, reviewerID)
+			approverRoles = append(approverRoles, string(r.Role))
+		}
+	}
+	met, missing := quorum.Evaluate(approverRoles)
+
+	if votes == nil {
+		votes = []domain.ProposalReview{}
+	}
+	return &domain.ProposalReviewState{
+		Proposal:   *p,
+		HighRisk:   rm.IsHighRisk(),
+		Quorum:     quorum,
+		QuorumMet:  met,
+		Missing:    missing,
+		Reviews:    votes,
+		ApprovedBy: approvedBy,
+	}, nil
+}
+
+func (s *aiProposalService) recordReview(ctx context.Context, repos Repositories, p *domain.AiProposal, reviewer domain.Principal, decision domain.ReviewDecision, comment string) error {
+	review := &domain.ProposalReview{
+		ID:         uuid.New(),
+		ProposalID: p.ID,
+		ReviewerID: reviewer.UserID,
+		Role:       reviewer.Role,
+		Decision:   decision,
+		Comment:    strings.TrimSpace(comment),
+		CreatedAt:  time.Now(),
+	}
+	if err := repos.ProposalReviews.Create(ctx, review); err != nil {
 		return err
 	}
+	return NewAuditLogService(repos.AuditLogs).Log(ctx, "ai_proposal", p.ID, "REVIEW_"+string(decision), reviewer.UserID, nil,
+		map[string]interface{}{"decision": decision, "role": reviewer.Role, "comment": review.Comment},
+	)
+}
+
+// ApproveProposal records the reviewer's approval and applies the proposal
+// once the approvals meet the quorum. The proposal's author cannot approve it.
+// The vote, status change, diff, snapshot and audit entries commit together:
+// if the diff cannot be applied nothing is written and the error is returned.
+func (s *aiProposalService) ApproveProposal(ctx context.Context, id uuid.UUID, reviewer domain.Principal, comment string) (*domain.ProposalReviewState, error) {
+	var p *domain.AiProposal
+	var state *domain.ProposalReviewState
+	var staleHash string
+	var rejected error
+	err := s.uow.Do(ctx, func(ctx context.Context, repos Repositories) error {
+		// The row lock serializes concurrent reviews, so only one of them can
+		// see the proposal PENDING and apply it.
+		var err error
+		if p, err = repos.Proposals.GetForUpdate(ctx, id); err != nil {
+			rejected = err
+			return err
+		}
+		if rejected = approvable(p, reviewer); rejected != nil {
+			return rejected
+		}
+		if p.BaseHash != "" {
+			_, hash, err := s.currentBase(ctx, repos, p)
+			if err != nil {
+				return err
+			}
+			if hash != p.BaseHash {
+				staleHash = hash
+				return ErrProposalStale
+			}
+		}
+		if err := s.recordReview(ctx, repos, p, reviewer, domain.ReviewApprove, comment); err != nil {
+			return err
+		}
+		if state, err = s.reviewState(ctx, p, repos.ProposalReviews); err != nil {
+			return err
+		}
+		if !state.QuorumMet {
+			return nil
+		}
+		return s.applyApproved(ctx, repos, p, reviewer.UserID, state.ApprovedBy)
+	})
+	if rejected != nil {
+		return nil, rejected
+	}
+	if errors.Is(err, ErrProposalStale) {
+		if _, markErr := s.repo.MarkStale(ctx, p.TargetType, p.TargetID, staleHash); markErr != nil {
+			return nil, markErr
+		}
+		return nil, err
+	}
+	if err != nil {
+		// Recorded outside the rolled-back unit of work so the failure stays traceable.
+		s.auditLog.Log(ctx, "ai_proposal", id, "APPLY_FAILED", reviewer.UserID, nil,
+			map[string]interface{}{"error": err.Error()})
+		return nil, err
+	}
+	if !state.QuorumMet {
+		return state, nil
+	}
+	state.Proposal.Status = domain.Approved
+	state.Proposal.ReviewedBy = reviewer.UserID
+	return state, nil
+}
+
+// approvable reports why reviewer may not approve p, if anything.
+func approvable(p *domain.AiProposal, reviewer domain.Principal) error {
+	if p.Status == domain.Stale {
+		return ErrProposalStale
+	}
 	if p.Status != domain.Pending {
-		return fmt.Errorf("proposal is already %s", p.Status)
+		return fmt.Errorf("%w: proposal is %s", ErrProposalNotReviewable, p.Status)
+	}
+	if p.CreatedBy != uuid.Nil && p.CreatedBy == reviewer.UserID {
+		return ErrSelfApproval
 	}
+	return nil
+}
+
+// applyApproved marks the proposal approved, applies its diff and snapshots
+// the result. userID is the reviewer whose approval completed the quorum.
+func (s *aiProposalService) applyApproved(ctx context.Context, repos Repositories, p *domain.AiProposal, userID uuid.UUID, approvedBy []uuid.UUID) error {
+	id := p.ID
 
-	// 1. Update proposal status first
-	if err := s.repo.UpdateStatus(ctx, id, domain.Approved, userID); err != nil {
+	// 1. Update proposal status
+	if err := repos.Proposals.UpdateStatus(ctx, id, domain.Approved, userID); err != nil {
 		return err
 	}
-	s.auditLog.Log(ctx, "ai_proposal", id, "APPROVE", userID,
+	if err := NewAuditLogService(repos.AuditLogs).Log(ctx, "ai_proposal", id, "APPROVE", userID,
 		map[string]interface{}{"status": p.Status},
-		map[string]interface{}{"status": domain.Approved},
-	)
+		map[string]interface{}{"status": domain.Approved, "approved_by": approvedBy},
+	); err != nil {
+		return err
+	}
 
 	// 2. Fetch the roadmap item to apply diff
-	rm, err := s.roadmapRepo.Get(ctx, p.RoadmapItemID)
+	rm, err := repos.RoadmapItems.Get(ctx, p.RoadmapItemID)
 	if err != nil {
 		return fmt.Errorf("failed to fetch roadmap item for proposal application: %w", err)
 	}
 
 	// 3. Apply diff based on ProposalType
-	if err := s.applyProposalDiff(ctx, rm, p, userID); err != nil {
-		// Log the failure but don't fail the approval — status is already updated
-		s.auditLog.Log(ctx, "ai_proposal", id, "APPLY_DIFF_FAILED", userID, nil,
-			map[string]interface{}{"error": err.Error()})
+	if err := s.applyProposalDiff(ctx, repos, rm, p, userID); err != nil {
+		return fmt.Errorf("failed to apply proposal: %w", err)
+	}
+	// Other open proposals on the same target were made against the old version.
+	if p.BaseHash != "" {
+		_, hash, err := s.currentBase(ctx, repos, p)
+		if err != nil {
+			return err
+		}
+		if _, err := repos.Proposals.MarkStale(ctx, p.TargetType, p.TargetID, hash); err != nil {
+			return err
+		}
 	}
 
 	// 4. Create version snapshot capturing the state at approval time
+	data := map[string]interface{}{
+		"proposal_id":   id,
+		"proposal_type": p.ProposalType,
+		"diff":          p.Diff,
+		"roadmap_item":  rm,
+	}
+	// Approved contract states are the baseline for drift scoring and monitoring.
+	contracts, err := repos.Contracts.List(ctx, p.RoadmapItemID)
+	if err != nil {
+		return err
+	}
+	data["contracts"] = contractStates(contracts)
 	snap := &domain.VersionSnapshot{
 		ID:            uuid.New(),
 		RoadmapItemID: p.RoadmapItemID,
-		SnapshotData: map[string]interface{}{
-			"proposal_id":   id,
-			"proposal_type": p.ProposalType,
-			"diff":          p.Diff,
-			"roadmap_item":  rm,
-		},
-		CreatedBy: userID,
+		SnapshotData:  data,
+		CreatedBy:     userID,
+	}
+	return repos.Snapshots.Create(ctx, snap)
+}
+
+// contractStates maps contract IDs to {"input", "output"}, the shape drift checks read from snapshots.
+func contractStates(contracts []domain.ContractDefinition) map[string]interface{} {
+	states := make(map[string]interface{}, len(contracts))
+	for _, c := range contracts {
+		states[c.ID.String()] = map[string]interface{}{
+			"input":  c.InputSchema,
+			"output": c.OutputSchema,
+		}
 	}
-	return s.snapshotRepo.Create(ctx, snap)
+	return states
 }
 
 // applyProposalDiff mutates the roadmap item (and related entities) based on the proposal type.
-func (s *aiProposalService) applyProposalDiff(ctx context.Context, rm *domain.RoadmapItem, p *domain.AiProposal, userID uuid.UUID) error {
+func (s *aiProposalService) applyProposalDiff(ctx context.Context, repos Repositories, rm *domain.RoadmapItem, p *domain.AiProposal, userID uuid.UUID) error {
 	switch p.ProposalType {
+	case domain.JSONPatch:
+		// The patch is re-validated against the target as it is now.
+		return s.applyJSONPatch(ctx, repos, p, userID)
+
 	case domain.EditDescription:
 		// Apply description, business context, and/or technical context changes
 		if v, ok := p.Diff["description"].(string); ok && v != "" {
@@ -120,7 +361,7 @@ func (s *aiProposalService) applyProposalDiff(ctx context.Context, rm *domain.Ro
 		if v, ok := p.Diff["technical_context"].(string); ok && v != "" {
 			rm.TechnicalContext = v
 		}
-		return s.roadmapRepo.Update(ctx, rm)
+		return repos.RoadmapItems.Update(ctx, rm)
 
 	case domain.ModifySchema:
 		// Apply schema changes to a specific contract
@@ -132,21 +373,36 @@ func (s *aiProposalService) applyProposalDiff(ctx context.Context, rm *domain.Ro
 		if err != nil {
 			return fmt.Errorf("invalid contract_id in diff: %w", err)
 		}
-		contract, err := s.contractRepo.Get(ctx, contractID)
+		contract, err := repos.Contracts.GetForUpdate(ctx, contractID)
 		if err != nil {
 			return fmt.Errorf("failed to fetch contract: %w", err)
 		}
+		// Drift fixes carry an RFC 6902 patch, re-validated against the current contract.
+		if rawPatch, ok := p.Diff["json_patch"]; ok {
+			patch, err := jsonpatch.Decode(rawPatch)
+			if err != nil {
+				return err
+			}
+			patched, err := drift.ApplyContractPatch(*contract, patch)
+			if err != nil {
+				return err
+			}
+			return applySchemaChange(ctx, repos, p, contract, patched, userID)
+		}
+		merged := *contract
 		if inputSchema, ok := p.Diff["input_schema"].(map[string]interface{}); ok {
+			merged.InputSchema = copySchema(contract.InputSchema)
 			for k, v := range inputSchema {
-				contract.InputSchema[k] = v
+				merged.InputSchema[k] = v
 			}
 		}
 		if outputSchema, ok := p.Diff["output_schema"].(map[string]interface{}); ok {
+			merged.OutputSchema = copySchema(contract.OutputSchema)
 			for k, v := range outputSchema {
-				contract.OutputSchema[k] = v
+				merged.OutputSchema[k] = v
 			}
 		}
-		return s.contractRepo.Update(ctx, contract)
+		return applySchemaChange(ctx, repos, p, contract, &merged, userID)
 
 	case domain.AddVariable:
 		// Create a new variable from the diff
@@ -176,7 +432,7 @@ func (s *aiProposalService) applyProposalDiff(ctx context.Context, rm *domain.Ro
 			Description:     description,
 			ValidationRules: validationRules,
 		}
-		return s.varRepo.Create(ctx, newVar)
+		return repos.Variables.Create(ctx, newVar)
 
 	case domain.RemoveField:
 		// Remove a field from a contract's input or output schema
@@ -188,7 +444,7 @@ func (s *aiProposalService) applyProposalDiff(ctx context.Context, rm *domain.Ro
 		if err != nil {
 			return fmt.Errorf("invalid contract_id in diff: %w", err)
 		}
-		contract, err := s.contractRepo.Get(ctx, contractID)
+		contract, err := repos.Contracts.GetForUpdate(ctx, contractID)
 		if err != nil {
 			return fmt.Errorf("failed to fetch contract: %w", err)
 		}
@@ -212,24 +468,128 @@ func (s *aiProposalService) applyProposalDiff(ctx context.Context, rm *domain.Ro
 			contract.BackwardCompatible = false
 			contract.DeprecatedFields = append(contract.DeprecatedFields, fieldName)
 		}
-		return s.contractRepo.Update(ctx, contract)
+		return repos.Contracts.Update(ctx, contract)
 
 	default:
 		return fmt.Errorf("unknown proposal type: %s", p.ProposalType)
 	}
 }
 
-func (s *aiProposalService) RejectProposal(ctx context.Context, id uuid.UUID, userID uuid.UUID) error {
+// applySchemaChange saves a ModifySchema proposal's change to old through
+// the contract update checks. The proposal carries no version, so the
+// contract's version is raised by the bump the change's drift requires.
+func applySchemaChange(ctx context.Context, repos Repositories, p *domain.AiProposal, old, c *domain.ContractDefinition, userID uuid.UUID) error {
+	if current, err := domain.ParseSemVer(old.Version); err == nil {
+		report, err := diffContracts(old, c)
+		if err != nil {
+			return err
+		}
+		c.Version = current.Bump(domain.RequiredBump(report)).String()
+	}
+	return applyContractUpdate(ctx, repos, p, old, c, userID)
+}
+
+func copySchema(schema map[string]interface{}) map[string]interface{} {
+	out := make(map[string]interface{}, len(schema))
+	for k, v := range schema {
+		out[k] = v
+	}
+	return out
+}
+
+// RequestChanges records the reviewer's request and sends the proposal back
+// to its author; it returns to review through ResubmitProposal.
+func (s *aiProposalService) RequestChanges(ctx context.Context, id uuid.UUID, reviewer domain.Principal, comment string) (*domain.ProposalReviewState, error) {
+	if strings.TrimSpace(comment) == "" {
+		return nil, ErrReviewCommentRequired
+	}
 	p, err := s.repo.Get(ctx, id)
 	if err != nil {
-		return err
+		return nil, err
 	}
-	if err := s.repo.UpdateStatus(ctx, id, domain.Rejected, userID); err != nil {
-		return err
+	if p.Status != domain.Pending {
+		return nil, fmt.Errorf("%w: proposal is %s", ErrProposalNotReviewable, p.Status)
 	}
-	s.auditLog.Log(ctx, "ai_proposal", id, "REJECT", userID,
-		map[string]interface{}{"status": p.Status},
-		map[string]interface{}{"status": domain.Rejected},
-	)
-	return nil
+	return s.decide(ctx, p, reviewer, domain.ReviewRequestChanges, comment, domain.ChangesRequested, "REQUEST_CHANGES")
+}
+
+// RejectProposal records the reviewer's rejection and closes the proposal.
+func (s *aiProposalService) RejectProposal(ctx context.Context, id uuid.UUID, reviewer domain.Principal, comment string) (*domain.ProposalReviewState, error) {
+	p, err := s.repo.Get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if p.Status != domain.Pending && p.Status != domain.ChangesRequested {
+		return nil, fmt.Errorf("%w: proposal is %s", ErrProposalNotReviewable, p.Status)
+	}
+	return s.decide(ctx, p, reviewer, domain.ReviewReject, comment, domain.Rejected, "REJECT")
+}
+
+// decide records the reviewer's vote and moves the proposal to status in one
+// unit of work.
+func (s *aiProposalService) decide(ctx context.Context, p *domain.AiProposal, reviewer domain.Principal, decision domain.ReviewDecision, comment string, status domain.ProposalStatus, action string) (*domain.ProposalReviewState, error) {
+	err := s.uow.Do(ctx, func(ctx context.Context, repos Repositories) error {
+		if err := s.recordReview(ctx, repos, p, reviewer, decision, comment); err != nil {
+			return err
+		}
+		if err := repos.Proposals.UpdateStatus(ctx, p.ID, status, reviewer.UserID); err != nil {
+			return err
+		}
+		return NewAuditLogService(repos.AuditLogs).Log(ctx, "ai_proposal", p.ID, action, reviewer.UserID,
+			map[string]interface{}{"status": p.Status},
+			map[string]interface{}{"status": status},
+		)
+	})
+	if err != nil {
+		return nil, err
+	}
+	p.Status = status
+	p.ReviewedBy = reviewer.UserID
+	return s.reviewState(ctx, p, s.reviews)
+}
+
+// ResubmitProposal replaces the diff of a proposal that had changes
+// requested and returns it to review. Earlier votes are superseded, so the
+// revised proposal needs a full quorum again.
+func (s *aiProposalService) ResubmitProposal(ctx context.Context, id uuid.UUID, diff map[string]interface{}, reasoning string, userID uuid.UUID) (*domain.AiProposal, error) {
+	p, err := s.repo.Get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if p.Status != domain.ChangesRequested {
+		return nil, fmt.Errorf("%w: only proposals with changes requested can be resubmitted, proposal is %s", ErrProposalNotReviewable, p.Status)
+	}
+	if diff == nil {
+		diff = p.Diff
+	}
+	if reasoning == "" {
+		reasoning = p.Reasoning
+	}
+	err = s.uow.Do(ctx, func(ctx context.Context, repos Repositories) error {
+		if p.ProposalType == domain.JSONPatch {
+			revised := *p
+			revised.Diff = diff
+			if _, _, err := s.previewPatch(ctx, repos, &revised); err != nil {
+				return err
+			}
+		}
+		if err := repos.ProposalReviews.Supersede(ctx, id); err != nil {
+			return err
+		}
+		if err := repos.Proposals.Resubmit(ctx, id, diff, reasoning); err != nil {
+			return err
+		}
+		return NewAuditLogService(repos.AuditLogs).Log(ctx, "ai_proposal", id, "RESUBMIT", userID,
+			map[string]interface{}{"status": p.Status, "diff": p.Diff},
+			map[string]interface{}{"status": domain.Pending, "diff": diff},
+		)
+	})
+	if err != nil {
+		return nil, err
+	}
+	p.Diff = diff
+	p.Reasoning = reasoning
+	p.Status = domain.Pending
+	p.ReviewedBy = uuid.Nil
+	return p, nil
 }
@@ -0,0 +1,521 @@
+package app
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/SpecForgeVC/SpecForge/internal/domain"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+type memProposalRepo struct {
+	AiProposalRepository
+	proposal domain.AiProposal
+}
+
+func (m *memProposalRepo) Create(ctx context.Context, p *domain.AiProposal) error {
+	m.proposal = *p
+	return nil
+}
+func (m *memProposalRepo) Get(ctx context.Context, id uuid.UUID) (*domain.AiProposal, error) {
+	p := m.proposal
+	return &p, nil
+}
+func (m *memProposalRepo) GetForUpdate(ctx context.Context, id uuid.UUID) (*domain.AiProposal, error) {
+	return m.Get(ctx, id)
+}
+func (m *memProposalRepo) ListByRoadmapItem(ctx context.Context, roadmapItemID uuid.UUID) ([]domain.AiProposal, error) {
+	return []domain.AiProposal{m.proposal}, nil
+}
+func (m *memProposalRepo) UpdateStatus(ctx context.Context, id uuid.UUID, status domain.ProposalStatus, reviewedBy uuid.UUID) error {
+	m.proposal.Status = status
+	m.proposal.ReviewedBy = reviewedBy
+	return nil
+}
+func (m *memProposalRepo) Resubmit(ctx context.Context, id uuid.UUID, diff map[string]interface{}, reasoning string) error {
+	m.proposal.Diff = diff
+	m.proposal.Reasoning = reasoning
+	m.proposal.Status = domain.Pending
+	return nil
+}
+func (m *memProposalRepo) Rebase(ctx context.Context, id uuid.UUID, diff map[string]interface{}, baseHash string, baseDocument map[string]interface{}) error {
+	m.proposal.Diff = diff
+	m.proposal.BaseHash = baseHash
+	m.proposal.BaseDocument = baseDocument
+	m.proposal.Status = domain.Pending
+	m.proposal.ReviewedBy = uuid.Nil
+	return nil
+}
+func (m *memProposalRepo) MarkStale(ctx context.Context, targetType domain.PatchTargetType, targetID uuid.UUID, currentHash string) (int64, error) {
+	p := &m.proposal
+	if p.TargetType != targetType || p.TargetID != targetID || !p.IsOpen() || p.BaseHash == currentHash {
+		return 0, nil
+	}
+	p.Status = domain.Stale
+	return 1, nil
+}
+
+type memReviewRepo struct {
+	reviews []domain.ProposalReview
+}
+
+func (m *memReviewRepo) Create(ctx context.Context, review *domain.ProposalReview) error {
+	m.reviews = append(m.reviews, *review)
+	return nil
+}
+func (m *memReviewRepo) List(ctx context.Context, proposalID uuid.UUID) ([]domain.ProposalReview, error) {
+	return m.reviews, nil
+}
+func (m *memReviewRepo) Supersede(ctx context.Context, proposalID uuid.UUID) error {
+	for i := range m.reviews {
+		m.reviews[i].Superseded = true
+	}
+	return nil
+}
+
+type memRequirementRepo struct {
+	RequirementRepository
+	requirement domain.Requirement
+}
+
+func (m *memRequirementRepo) Get(ctx context.Context, id uuid.UUID) (*domain.Requirement, error) {
+	r := m.requirement
+	return &r, nil
+}
+func (m *memRequirementRepo) Update(ctx context.Context, r *domain.Requirement) error {
+	m.requirement = *r
+	return nil
+}
+
+type stubSnapshotRepo struct {
+	SnapshotRepository
+	created int
+}
+
+func (s *stubSnapshotRepo) Create(ctx context.Context, snap *domain.VersionSnapshot) error {
+	s.created++
+	return nil
+}
+
+type memAuditRepo struct {
+	AuditLogRepository
+	logs []domain.AuditLog
+}
+
+func (m *memAuditRepo) Create(ctx context.Context, log *domain.AuditLog) error {
+	m.logs = append(m.logs, *log)
+	return nil
+}
+
+// memUnitOfWork runs fn against the in-memory repositories and, when fn
+// fails, restores the state of those it knows how to, as a rollback would.
+type memUnitOfWork struct {
+	repos Repositories
+}
+
+func (u *memUnitOfWork) Read(ctx context.Context, fn func(ctx context.Context, repos Repositories) error) error {
+	return fn(ctx, u.repos)
+}
+
+func (u *memUnitOfWork) Do(ctx context.Context, fn func(ctx context.Context, repos Repositories) error) error {
+	var restore []func()
+	if p, ok := u.repos.Proposals.(*memProposalRepo); ok {
+		saved := p.proposal
+		restore = append(restore, func() { p.proposal = saved })
+	}
+	if r, ok := u.repos.ProposalReviews.(*memReviewRepo); ok {
+		saved := append([]domain.ProposalReview(nil), r.reviews...)
+		restore = append(restore, func() { r.reviews = saved })
+	}
+	if t, ok := u.repos.RoadmapStatusTransitions.(*memTransitionRepo); ok {
+		saved := append([]domain.RoadmapStatusTransition(nil), t.transitions...)
+		restore = append(restore, func() { t.transitions = saved })
+	}
+	if c, ok := u.repos.Contracts.(*storedContractRepo); ok {
+		saved := c.contract
+		restore = append(restore, func() { c.contract = saved })
+	}
+	if c, ok := u.repos.Contracts.(*memContractRepo); ok {
+		saved := append([]domain.ContractDefinition(nil), c.contracts...)
+		restore = append(restore, func() { c.contracts = saved })
+	}
+	var revisions *memRevisionRepo
+	switch r := u.repos.ContractRevisions.(type) {
+	case *memRevisionRepo:
+		revisions = r
+	case *failingRevisionRepo:
+		revisions = &r.memRevisionRepo
+	}
+	if revisions != nil {
+		saved := append([]domain.ContractRevision(nil), revisions.revisions...)
+		restore = append(restore, func() { revisions.revisions = saved })
+	}
+	if a, ok := u.repos.AuditLogs.(*memAuditRepo); ok {
+		saved := append([]domain.AuditLog(nil), a.logs...)
+		restore = append(restore, func() { a.logs = saved })
+	}
+	if err := fn(ctx, u.repos); err != nil {
+		for _, r := range restore {
+			r()
+		}
+		return err
+	}
+	return nil
+}
+
+func newTestProposalService(item *domain.RoadmapItem, author uuid.UUID) (AiProposalService, *memProposalRepo, *stubSnapshotRepo) {
+	svc, proposals, snapshots, _ := newTestProposalServiceWithRepos(item, author)
+	return svc, proposals, snapshots
+}
+
+func newTestProposalServiceWithRepos(item *domain.RoadmapItem, author uuid.UUID) (AiProposalService, *memProposalRepo, *stubSnapshotRepo, Repositories) {
+	proposals := &memProposalRepo{proposal: domain.AiProposal{
+		ID:            uuid.New(),
+		RoadmapItemID: item.ID,
+		ProposalType:  domain.EditDescription,
+		Diff:          map[string]interface{}{"description": "v1"},
+		Status:        domain.Pending,
+		CreatedBy:     author,
+	}}
+	roadmapRepo := new(mockRoadmapRepo)
+	roadmapRepo.On("Get", mock.Anything, item.ID).Return(item, nil)
+	contracts := &storedContractRepo{contract: domain.ContractDefinition{
+		ID:            uuid.New(),
+		RoadmapItemID: item.ID,
+		ContractType:  domain.REST,
+		Version:       "1.0.0",
+		InputSchema:   userSchema("id"),
+		OutputSchema:  userSchema("id"),
+	}}
+	contracts.On("List", mock.Anything, item.ID).Return([]domain.ContractDefinition{}, nil)
+	audit := new(mockAuditLog)
+	audit.On("Log", mock.Anything, "ai_proposal", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil)
+	snapshots := &stubSnapshotRepo{}
+	reviews := &memReviewRepo{}
+	uow := &memUnitOfWork{repos: Repositories{
+		RoadmapItems:      roadmapRepo,
+		Contracts:         contracts,
+		ContractRevisions: &memRevisionRepo{},
+		Requirements:      &memRequirementRepo{requirement: domain.Requirement{ID: uuid.New(), RoadmapItemID: item.ID, Title: "Login"}},
+		Snapshots:         snapshots,
+		Proposals:         proposals,
+		ProposalReviews:   reviews,
+		AuditLogs:         &memAuditRepo{},
+	}}
+	svc := NewAiProposalService(proposals, reviews, roadmapRepo, &memGovPolicyRepo{}, uow, audit)
+	return svc, proposals, snapshots, uow.repos
+}
+
+func TestApproveProposal_HighRiskQuorum(t *testing.T) {
+	ctx := context.Background()
+	author := domain.Principal{UserID: uuid.New(), Role: domain.RoleReviewer}
+	reviewer := domain.Principal{UserID: uuid.New(), Role: domain.RoleReviewer}
+	admin := domain.Principal{UserID: uuid.New(), Role: domain.RoleAdmin}
+	item := &domain.RoadmapItem{ID: uuid.New(), ProjectID: uuid.New(), BreakingChange: true}
+	svc, proposals, snapshots := newTestProposalService(item, author.UserID)
+	id := proposals.proposal.ID
+
+	_, err := svc.ApproveProposal(ctx, id, author, "")
+	assert.ErrorIs(t, err, ErrSelfApproval)
+
+	state, err := svc.ApproveProposal(ctx, id, reviewer, "looks right")
+	assert.NoError(t, err)
+	assert.True(t, state.HighRisk)
+	assert.False(t, state.QuorumMet)
+	assert.Equal(t, []string{"1 of 2 required approvals", "an approval from role ADMIN"}, state.Missing)
+	assert.Equal(t, domain.Pending, proposals.proposal.Status)
+
+	// A repeated vote replaces the reviewer's earlier one instead of adding to it.
+	state, err = svc.ApproveProposal(ctx, id, reviewer, "still right")
+	assert.NoError(t, err)
+	assert.Len(t, state.ApprovedBy, 1)
+
+	state, err = svc.ApproveProposal(ctx, id, admin, "")
+	assert.NoError(t, err)
+	assert.True(t, state.QuorumMet)
+	assert.Equal(t, domain.Approved, proposals.proposal.Status)
+	assert.Equal(t, 1, snapshots.created)
+}
+
+func TestRequestChanges_Resubmit(t *testing.T) {
+	ctx := context.Background()
+	author := domain.Principal{UserID: uuid.New(), Role: domain.RoleAIAgent}
+	reviewer := domain.Principal{UserID: uuid.New(), Role: domain.RoleReviewer}
+	item := &domain.RoadmapItem{ID: uuid.New(), ProjectID: uuid.New(), RiskLevel: domain.RiskLow}
+	svc, proposals, _ := newTestProposalService(item, author.UserID)
+	id := proposals.proposal.ID
+
+	_, err := svc.RequestChanges(ctx, id, reviewer, "")
+	assert.ErrorIs(t, err, ErrReviewCommentRequired)
+
+	state, err := svc.RequestChanges(ctx, id, reviewer, "Keep the original wording")
+	assert.NoError(t, err)
+	assert.Equal(t, domain.ChangesRequested, state.Proposal.Status)
+
+	_, err = svc.ApproveProposal(ctx, id, reviewer, "")
+	assert.ErrorIs(t, err, ErrProposalNotReviewable)
+
+	resubmitted, err := svc.ResubmitProposal(ctx, id, map[string]interface{}{"description": "v2"}, "", author.UserID)
+	assert.NoError(t, err)
+	assert.Equal(t, domain.Pending, resubmitted.Status)
+
+	state, err = svc.GetReviewState(ctx, id)
+	assert.NoError(t, err)
+	assert.Empty(t, state.ApprovedBy)
+	assert.Equal(t, 1, state.Quorum.MinApprovals)
+
+	state, err = svc.ApproveProposal(ctx, id, reviewer, "")
+	assert.NoError(t, err)
+	assert.True(t, state.QuorumMet)
+	assert.Equal(t, domain.Approved, proposals.proposal.Status)
+}
+
+func TestApproveProposal_RollsBackWhenApplyFails(t *testing.T) {
+	ctx := context.Background()
+	reviewer := domain.Principal{UserID: uuid.New(), Role: domain.RoleReviewer}
+	item := &domain.RoadmapItem{ID: uuid.New(), ProjectID: uuid.New(), RiskLevel: domain.RiskLow}
+	svc, proposals, snapshots := newTestProposalService(item, uuid.New())
+	proposals.proposal.ProposalType = domain.ModifySchema // no contract_id, so the diff cannot be applied
+	id := proposals.proposal.ID
+
+	_, err := svc.ApproveProposal(ctx, id, reviewer, "")
+	assert.Error(t, err)
+	assert.Equal(t, domain.Pending, proposals.proposal.Status)
+	assert.Zero(t, snapshots.created)
+
+	state, err := svc.GetReviewState(ctx, id)
+	assert.NoError(t, err)
+	assert.Empty(t, state.Reviews, "the vote must roll back with the failed apply")
+}
+
+// racedProposalRepo shows locked reads the status a concurrent approval
+// committed while plain reads still see the proposal PENDING.
+type racedProposalRepo struct {
+	*memProposalRepo
+	committed domain.ProposalStatus
+}
+
+func (r *racedProposalRepo) GetForUpdate(ctx context.Context, id uuid.UUID) (*domain.AiProposal, error) {
+	p, err := r.memProposalRepo.Get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	p.Status = r.committed
+	return p, nil
+}
+
+func TestApproveProposal_ChecksStatusUnderLock(t *testing.T) {
+	ctx := context.Background()
+	reviewer := domain.Principal{UserID: uuid.New(), Role: domain.RoleReviewer}
+	item := &domain.RoadmapItem{ID: uuid.New(), ProjectID: uuid.New(), RiskLevel: domain.RiskLow}
+	_, proposals, snapshots, repos := newTestProposalServiceWithRepos(item, uuid.New())
+	repos.Proposals = &racedProposalRepo{memProposalRepo: proposals, committed: domain.Approved}
+	audit := new(mockAuditLog)
+	svc := NewAiProposalService(proposals, repos.ProposalReviews, repos.RoadmapItems, &memGovPolicyRepo{}, &memUnitOfWork{repos: repos}, audit)
+
+	_, err := svc.ApproveProposal(ctx, proposals.proposal.ID, reviewer, "")
+	assert.ErrorIs(t, err, ErrProposalNotReviewable)
+	assert.Zero(t, snapshots.created)
+	assert.Empty(t, repos.ProposalReviews.(*memReviewRepo).reviews)
+	audit.AssertNotCalled(t, "Log", mock.Anything, "ai_proposal", mock.Anything, "APPLY_FAILED", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestJSONPatchProposal(t *testing.T) {
+	ctx := context.Background()
+	author := uuid.New()
+	reviewer := domain.Principal{UserID: uuid.New(), Role: domain.RoleReviewer}
+	item := &domain.RoadmapItem{ID: uuid.New(), ProjectID: uuid.New(), RiskLevel: domain.RiskLow}
+	svc, _, _, repos := newTestProposalServiceWithRepos(item, author)
+	requirements := repos.Requirements.(*memRequirementRepo)
+	diff := func(patch string) map[string]interface{} {
+		var ops interface{}
+		assert.NoError(t, json.Unmarshal([]byte(patch), &ops))
+		return map[string]interface{}{"target_type": "REQUIREMENT", "target_id": requirements.requirement.ID.String(), "patch": ops}
+	}
+
+	for name, patch := range map[string]string{
+		"empty title":      `[{"op":"replace","path":"/title","value":" "}]`,
+		"fixed field":      `[{"op":"add","path":"/roadmap_item_id","value":"` + uuid.NewString() + `"}]`,
+		"unknown field":    `[{"op":"add","path":"/owner","value":"me"}]`,
+		"wrong type":       `[{"op":"replace","path":"/testable","value":"yes"}]`,
+		"missing path":     `[{"op":"remove","path":"/nope"}]`,
+		"not an operation": `[{"op":"merge","path":"/title"}]`,
+	} {
+		_, err := svc.CreateProposal(ctx, item.ID, domain.JSONPatch, diff(patch), "", 0.9, author)
+		assert.ErrorIs(t, err, ErrInvalidProposal, name)
+	}
+
+	p, err := svc.CreateProposal(ctx, item.ID, domain.JSONPatch, diff(`[{"op":"replace","path":"/title","value":"Sign in"},{"op":"replace","path":"/testable","value":true}]`), "", 0.9, author)
+	assert.NoError(t, err)
+
+	preview, err := svc.PreviewProposal(ctx, p.ID)
+	assert.NoError(t, err)
+	var summaries []string
+	for _, c := range preview.Changes {
+		summaries = append(summaries, c.Summary)
+	}
+	assert.Equal(t, []string{"/testable: false → true", `/title: "Login" → "Sign in"`}, summaries)
+
+	state, err := svc.ApproveProposal(ctx, p.ID, reviewer, "")
+	assert.NoError(t, err)
+	assert.True(t, state.QuorumMet)
+	assert.Equal(t, "Sign in", requirements.requirement.Title)
+	assert.True(t, requirements.requirement.Testable)
+	assert.Equal(t, item.ID, requirements.requirement.RoadmapItemID)
+}
+
+func TestJSONPatchProposal_Contract(t *testing.T) {
+	ctx := context.Background()
+	author := uuid.New()
+	reviewer := domain.Principal{UserID: uuid.New(), Role: domain.RoleReviewer}
+	item := &domain.RoadmapItem{ID: uuid.New(), ProjectID: uuid.New(), RiskLevel: domain.RiskLow}
+	svc, _, _, repos := newTestProposalServiceWithRepos(item, author)
+	contracts := repos.Contracts.(*storedContractRepo)
+	revisions := repos.ContractRevisions.(*memRevisionRepo)
+	diff := func(patch string) map[string]interface{} {
+		var ops interface{}
+		assert.NoError(t, json.Unmarshal([]byte(patch), &ops))
+		return map[string]interface{}{"target_type": "CONTRACT", "target_id": contracts.contract.ID.String(), "patch": ops}
+	}
+
+	// The version is checked against the patch's drift, as in a manual edit.
+	for name, patch := range map[string]string{
+		"missing bump": `[{"op":"add","path":"/output_schema/properties/name","value":{"type":"string"}}]`,
+		"regression":   `[{"op":"replace","path":"/version","value":"0.9.0"}]`,
+		"not semver":   `[{"op":"replace","path":"/version","value":"latest"}]`,
+	} {
+		_, err := svc.CreateProposal(ctx, item.ID, domain.JSONPatch, diff(patch), "", 0.9, author)
+		assert.ErrorIs(t, err, ErrInvalidProposal, name)
+	}
+
+	p, err := svc.CreateProposal(ctx, item.ID, domain.JSONPatch, diff(`[{"op":"add","path":"/output_schema/properties/name","value":{"type":"string"}},{"op":"replace","path":"/version","value":"1.1.0"}]`), "", 0.9, author)
+	assert.NoError(t, err)
+	state, err := svc.ApproveProposal(ctx, p.ID, reviewer, "")
+	assert.NoError(t, err)
+	assert.True(t, state.QuorumMet)
+	assert.Equal(t, "1.1.0", contracts.contract.Version)
+	assert.Contains(t, contracts.contract.OutputSchema["properties"], "name")
+	if assert.Len(t, revisions.revisions, 2) {
+		assert.Equal(t, domain.VersionBumpMinor, revisions.revisions[1].Bump)
+		assert.Equal(t, reviewer.UserID, revisions.revisions[1].CreatedBy)
+	}
+}
+
+func TestModifySchemaProposal_DriftFix(t *testing.T) {
+	ctx := context.Background()
+	author := uuid.New()
+	reviewer := domain.Principal{UserID: uuid.New(), Role: domain.RoleReviewer}
+	item := &domain.RoadmapItem{ID: uuid.New(), ProjectID: uuid.New(), RiskLevel: domain.RiskLow}
+	svc, _, _, repos := newTestProposalServiceWithRepos(item, author)
+	contracts := repos.Contracts.(*storedContractRepo)
+	revisions := repos.ContractRevisions.(*memRevisionRepo)
+
+	p, err := svc.CreateProposal(ctx, item.ID, domain.ModifySchema, map[string]interface{}{
+		"contract_id": contracts.contract.ID.String(),
+		"json_patch": []interface{}{
+			map[string]interface{}{"op": "add", "path": "/output_schema/properties/name", "value": map[string]interface{}{"type": "string"}},
+		},
+	}, "", 0.9, author)
+	assert.NoError(t, err)
+	_, err = svc.ApproveProposal(ctx, p.ID, reviewer, "")
+	assert.NoError(t, err)
+
+	// The additive fix moves the contract to the next minor version and is recorded as a revision.
+	assert.Equal(t, "1.1.0", contracts.contract.Version)
+	if assert.Len(t, revisions.revisions, 2) {
+		assert.Equal(t, "1.1.0", revisions.revisions[1].Version)
+		assert.Equal(t, domain.VersionBumpMinor, revisions.revisions[1].Bump)
+		assert.Contains(t, revisions.revisions[1].OutputSchema["properties"], "name")
+	}
+}
+
+func TestRebaseProposal(t *testing.T) {
+	ctx := context.Background()
+	author := uuid.New()
+	reviewer := domain.Principal{UserID: uuid.New(), Role: domain.RoleReviewer}
+	item := &domain.RoadmapItem{ID: uuid.New(), ProjectID: uuid.New(), RiskLevel: domain.RiskLow}
+	svc, proposals, _, repos := newTestProposalServiceWithRepos(item, author)
+	requirements := repos.Requirements.(*memRequirementRepo)
+	retitle := map[string]interface{}{
+		"target_type": "REQUIREMENT",
+		"target_id":   requirements.requirement.ID.String(),
+		"patch":       []interface{}{map[string]interface{}{"op": "replace", "path": "/title", "value": "Sign in"}},
+	}
+
+	p, err := svc.CreateProposal(ctx, item.ID, domain.JSONPatch, retitle, "", 0.9, author)
+	assert.NoError(t, err)
+	assert.NotEmpty(t, p.BaseHash)
+
+	// An unrelated edit to the target makes the proposal stale but rebases cleanly.
+	requirements.requirement.Description = "Users sign in with email"
+	got, err := svc.GetProposal(ctx, p.ID)
+	assert.NoError(t, err)
+	assert.Equal(t, domain.Stale, got.Status)
+	assert.Equal(t, domain.Pending, proposals.proposal.Status, "reads must not write the stale status")
+	_, err = svc.ApproveProposal(ctx, p.ID, reviewer, "")
+	assert.ErrorIs(t, err, ErrProposalStale)
+
+	result, err := svc.RebaseProposal(ctx, p.ID, author)
+	assert.NoError(t, err)
+	assert.True(t, result.Rebased)
+	assert.Equal(t, domain.Pending, proposals.proposal.Status)
+
+	state, err := svc.ApproveProposal(ctx, p.ID, reviewer, "")
+	assert.NoError(t, err)
+	assert.True(t, state.QuorumMet)
+	assert.Equal(t, "Sign in", requirements.requirement.Title)
+	assert.Equal(t, "Users sign in with email", requirements.requirement.Description)
+
+	// A conflicting edit is reported and leaves the proposal stale.
+	p, err = svc.CreateProposal(ctx, item.ID, domain.JSONPatch, map[string]interface{}{
+		"target_type": "REQUIREMENT",
+		"target_id":   requirements.requirement.ID.String(),
+		"patch":       []interface{}{map[string]interface{}{"op": "replace", "path": "/title", "value": "Log in"}},
+	}, "", 0.9, author)
+	assert.NoError(t, err)
+	requirements.requirement.Title = "Authenticate"
+	_, err = svc.ApproveProposal(ctx, p.ID, reviewer, "")
+	assert.ErrorIs(t, err, ErrProposalStale)
+	assert.Equal(t, domain.Stale, proposals.proposal.Status)
+
+	result, err = svc.RebaseProposal(ctx, p.ID, author)
+	assert.NoError(t, err)
+	assert.False(t, result.Rebased)
+	if assert.Len(t, result.Conflicts, 1) {
+		assert.Equal(t, "/title", result.Conflicts[0].Path)
+		assert.Equal(t, "Authenticate", result.Conflicts[0].Upstream.After)
+	}
+	assert.Equal(t, domain.Stale, proposals.proposal.Status)
+}
+
+func TestRebaseProposal_UnchangedTargetIsNoOp(t *testing.T) {
+	ctx := context.Background()
+	author := uuid.New()
+	reviewer := domain.Principal{UserID: uuid.New(), Role: domain.RoleReviewer}
+	item := &domain.RoadmapItem{ID: uuid.New(), ProjectID: uuid.New(), BreakingChange: true}
+	svc, proposals, _, repos := newTestProposalServiceWithRepos(item, author)
+	requirements := repos.Requirements.(*memRequirementRepo)
+
+	p, err := svc.CreateProposal(ctx, item.ID, domain.JSONPatch, map[string]interface{}{
+		"target_type": "REQUIREMENT",
+		"target_id":   requirements.requirement.ID.String(),
+		"patch":       []interface{}{map[string]interface{}{"op": "replace", "path": "/title", "value": "Sign in"}},
+	}, "", 0.9, author)
+	assert.NoError(t, err)
+	state, err := svc.ApproveProposal(ctx, p.ID, reviewer, "")
+	assert.NoError(t, err)
+	assert.False(t, state.QuorumMet)
+
+	result, err := svc.RebaseProposal(ctx, p.ID, author)
+	assert.NoError(t, err)
+	assert.False(t, result.Rebased)
+	assert.Empty(t, result.Conflicts)
+	assert.Equal(t, domain.Pending, proposals.proposal.Status)
+
+	state, err = svc.GetReviewState(ctx, p.ID)
+	assert.NoError(t, err)
+	assert.Equal(t, []uuid.UUID{reviewer.UserID}, state.ApprovedBy, "the earlier vote must still count")
+}
@@ -125,7 +125,7 @@ func (s *refinementService) runOrchestrator(session *domain.RefinementSession) {
 	case "schema_suggestion":
 		systemPrompt = "You are an expert API Architect. Analyze the Roadmap Item context (Title, Description) and the existing partial Contract (Type, other schemas). Generate the requested JSON Schema for `{target_field}`. It must be distinct and appropriate for the specific role (e.g., Error schema should define error codes/messages, not copy Input). Return JSON with a single field 'schema' containing the JSON schema object."
 	case "validation_rule":
-		systemPrompt = "You are an expert Security and Quality Engineer. Analyze the project context and generate appropriate validation rules. These rules protect variables, contracts, and business logic. Return a JSON object with a single field 'rules', which is an array of objects. Each object must have: 'name' (descriptive), 'rule_type' (e.g., 'REGEX', 'RANGE', 'ENUM', 'CUSTOM'), 'description' (clear explanation), and 'rule_config' (a JSON object with specific parameters for the type, e.g., {'pattern': '^v.+'} or {'min': 0, 'max': 100}). Return ONLY valid JSON."
+		systemPrompt = "You are an expert Security and Quality Engineer. Analyze the project context and generate appropriate validation rules. These rules protect variables, contracts, and business logic. Return a JSON object with a single field 'rules', which is an array of objects. Each object must have: 'name' (descriptive), 'rule_type' (e.g., 'REGEX', 'RANGE', 'ENUM', 'CUSTOM'), 'description' (clear explanation), and 'rule_config' (a JSON object with the parameters of the type: {'pattern': '^v.+'} for REGEX, {'min': 0, 'max': 100} for RANGE, {'values': ['A', 'B']} for ENUM, {'expression': 'value > 0'} for CUSTOM). Return ONLY valid JSON."
 	case "consolidate_contract":
 		systemPrompt = "You are an expert API Architect. You have been provided with multiple partial or overlapping API contracts and their environment variables. Your task is to CONSOLIDATE them into a single, comprehensive OpenAPI 3.1.0 specification. Merge overlapping paths, unify schemas into a consistent 'components/schemas' section, resolve naming conflicts, and ensure all input variables are captured. Return ONLY raw JSON, no markdown headings or explanations. Return a JSON object with two fields: 'contract' (the complete OpenAPI 3.1.0 spec object) and 'variables' (a unified array of variable definitions where each object has 'name', 'description', 'type', and 'required')."
 	default:
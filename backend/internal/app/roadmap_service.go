@@ -2,23 +2,65 @@ package app
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"strings"
+	"time"
 
 	"github.com/SpecForgeVC/SpecForge/internal/domain"
+	"github.com/SpecForgeVC/SpecForge/internal/domain/governance"
 	"github.com/google/uuid"
 )
 
+// ErrStatusTransitionRejected is wrapped by every StatusTransitionError.
+var ErrStatusTransitionRejected = errors.New("status transition rejected")
+
+// StatusTransitionFailure says why a status transition was rejected.
+type StatusTransitionFailure string
+
+const (
+	TransitionNotAllowed     StatusTransitionFailure = "NOT_ALLOWED"
+	TransitionForbidden      StatusTransitionFailure = "FORBIDDEN"
+	TransitionReasonRequired StatusTransitionFailure = "REASON_REQUIRED"
+	TransitionBlocked        StatusTransitionFailure = "GOVERNANCE_BLOCKED"
+)
+
+// StatusTransitionError rejects a roadmap item status change. Reasons carries
+// the failing governance findings when the transition is blocked, and Allowed
+// the transitions the workflow offers from the current status.
+type StatusTransitionError struct {
+	From    domain.RoadmapItemStatus      `json:"from"`
+	To      domain.RoadmapItemStatus      `json:"to"`
+	Failure StatusTransitionFailure       `json:"failure"`
+	Reasons []string                      `json:"reasons"`
+	Allowed []domain.StatusTransitionRule `json:"allowed_transitions"`
+}
+
+func (e *StatusTransitionError) Error() string {
+	msg := fmt.Sprintf("cannot move roadmap item from %s to %s", e.From, e.To)
+	if len(e.Reasons) > 0 {
+		msg += ": " + strings.Join(e.Reasons, "; ")
+	}
+	return msg
+}
+
+func (e *StatusTransitionError) Unwrap() error { return ErrStatusTransitionRejected }
+
 type roadmapItemService struct {
 	repo                RoadmapItemRepository
+	transitions         RoadmapStatusTransitionRepository
 	auditLog            AuditLogService
 	featureIntelligence FeatureIntelligenceService
 	governance          GovernanceService
 	alignment           AlignmentService
+	uow                 UnitOfWork
 }
 
-func NewRoadmapItemService(repo RoadmapDependencyRepository, roadmapRepo RoadmapItemRepository, auditLog AuditLogService, fi FeatureIntelligenceService, gov GovernanceService, alignment AlignmentService) RoadmapItemService {
+func NewRoadmapItemService(repo RoadmapDependencyRepository, roadmapRepo RoadmapItemRepository, transitions RoadmapStatusTransitionRepository, uow UnitOfWork, auditLog AuditLogService, fi FeatureIntelligenceService, gov GovernanceService, alignment AlignmentService) RoadmapItemService {
 	return &roadmapItemService{
 		repo:                roadmapRepo,
+		transitions:         transitions,
+		uow:                 uow,
 		auditLog:            auditLog,
 		featureIntelligence: fi,
 		governance:          gov,
@@ -34,8 +76,11 @@ func (s *roadmapItemService) ListRoadmapItems(ctx context.Context, projectID uui
 	return s.repo.List(ctx, projectID)
 }
 
+// CreateRoadmapItem stores a new item. Items always enter the workflow as
+// DRAFT; later statuses are reached through TransitionRoadmapItem.
 func (s *roadmapItemService) CreateRoadmapItem(ctx context.Context, item *domain.RoadmapItem, userID uuid.UUID) (*domain.RoadmapItem, error) {
 	item.ID = uuid.New()
+	item.Status = domain.StatusDraft
 	if err := s.repo.Create(ctx, item); err != nil {
 		return nil, err
 	}
@@ -52,7 +97,10 @@ func (s *roadmapItemService) CreateRoadmapItem(ctx context.Context, item *domain
 	return item, nil
 }
 
-func (s *roadmapItemService) UpdateRoadmapItem(ctx context.Context, id uuid.UUID, title, description, businessContext, technicalContext string, status domain.RoadmapItemStatus, userID uuid.UUID) (*domain.RoadmapItem, error) {
+// UpdateRoadmapItem edits the item's content. A status other than the
+// current one (an empty status keeps it) is subject to the same workflow
+// checks as TransitionRoadmapItem.
+func (s *roadmapItemService) UpdateRoadmapItem(ctx context.Context, id uuid.UUID, title, description, businessContext, technicalContext string, status domain.RoadmapItemStatus, reason string, actor domain.Principal) (*domain.RoadmapItem, error) {
 	oldItem, err := s.repo.Get(ctx, id)
 	if err != nil {
 		return nil, err
@@ -62,35 +110,141 @@ func (s *roadmapItemService) UpdateRoadmapItem(ctx context.Context, id uuid.UUID
 	item.Description = description
 	item.BusinessContext = businessContext
 	item.TechnicalContext = technicalContext
+	if status == "" {
+		status = oldItem.Status
+	}
 
-	// Governance Check on Status Transition
-	if status == domain.StatusComplete || status == domain.StatusInProgress {
-		canBuild, reasons, err := s.governance.CanBuildFeature(ctx, id)
-		if err != nil {
+	if status != oldItem.Status {
+		if err := s.checkTransition(ctx, oldItem, status, reason, actor.Role); err != nil {
 			return nil, err
 		}
-		if !canBuild {
-			return nil, fmt.Errorf("governance check failed: %v", reasons)
-		}
 	}
 
 	item.Status = status
-	if err := s.repo.Update(ctx, &item); err != nil {
+	err = s.uow.Do(ctx, func(ctx context.Context, repos Repositories) error {
+		if err := repos.RoadmapItems.Update(ctx, &item); err != nil {
+			return err
+		}
+		if err := NewAuditLogService(repos.AuditLogs).Log(ctx, "roadmap_item", id, "UPDATE", actor.UserID, map[string]interface{}{"status": oldItem.Status}, map[string]interface{}{"status": item.Status}); err != nil {
+			return err
+		}
+		if status == oldItem.Status {
+			return nil
+		}
+		return s.recordTransition(ctx, repos, oldItem, status, reason, actor)
+	})
+	if err != nil {
 		return nil, err
 	}
-	s.auditLog.Log(ctx, "roadmap_item", id, "UPDATE", userID, map[string]interface{}{"status": oldItem.Status}, map[string]interface{}{"status": item.Status})
 
-	// Trigger intelligence recalculation
-	if item.Type == domain.Feature {
-		_, _ = s.featureIntelligence.CalculateFeatureScore(ctx, id)
+	s.afterChange(ctx, &item)
+	return &item, nil
+}
+
+// TransitionRoadmapItem moves the item along the workflow. The move must be a
+// workflow edge the actor's role may take, carry a reason if it is a
+// back-edge, and pass the edge's governance gate.
+func (s *roadmapItemService) TransitionRoadmapItem(ctx context.Context, id uuid.UUID, to domain.RoadmapItemStatus, reason string, actor domain.Principal) (*domain.RoadmapItem, error) {
+	oldItem, err := s.repo.Get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.checkTransition(ctx, oldItem, to, reason, actor.Role); err != nil {
+		return nil, err
 	}
 
-	// Trigger alignment check
-	_, _ = s.alignment.TriggerAlignmentCheck(ctx, item.ProjectID)
+	item := *oldItem
+	item.Status = to
+	err = s.uow.Do(ctx, func(ctx context.Context, repos Repositories) error {
+		if err := repos.RoadmapItems.Update(ctx, &item); err != nil {
+			return err
+		}
+		return s.recordTransition(ctx, repos, oldItem, to, reason, actor)
+	})
+	if err != nil {
+		return nil, err
+	}
 
+	s.afterChange(ctx, &item)
 	return &item, nil
 }
 
+func (s *roadmapItemService) ListStatusTransitions(ctx context.Context, id uuid.UUID) ([]domain.RoadmapStatusTransition, error) {
+	return s.transitions.List(ctx, id)
+}
+
+func (s *roadmapItemService) checkTransition(ctx context.Context, item *domain.RoadmapItem, to domain.RoadmapItemStatus, reason string, role domain.Role) error {
+	reject := func(failure StatusTransitionFailure, reasons ...string) error {
+		return &StatusTransitionError{
+			From:    item.Status,
+			To:      to,
+			Failure: failure,
+			Reasons: reasons,
+			Allowed: domain.NextStatuses(item.Status),
+		}
+	}
+
+	rule := domain.FindStatusTransition(item.Status, to)
+	if rule == nil {
+		return reject(TransitionNotAllowed, fmt.Sprintf("The workflow does not allow moving from %s to %s", item.Status, to))
+	}
+	if !rule.Allows(role) {
+		return reject(TransitionForbidden, fmt.Sprintf("Role %s may not move an item from %s to %s", role, item.Status, to))
+	}
+	if rule.RequiresReason && strings.TrimSpace(reason) == "" {
+		return reject(TransitionReasonRequired, fmt.Sprintf("Moving an item back from %s to %s requires a reason", item.Status, to))
+	}
+
+	var allowed bool
+	var reasons []string
+	var err error
+	switch rule.Gate {
+	case "":
+		return nil
+	case governance.GateBuild:
+		allowed, reasons, err = s.governance.CanBuildFeature(ctx, item.ID)
+	case governance.GateDeploy:
+		allowed, reasons, err = s.governance.CanDeployFeature(ctx, item.ID)
+	}
+	if err != nil {
+		return err
+	}
+	if !allowed {
+		return reject(TransitionBlocked, reasons...)
+	}
+	return nil
+}
+
+// recordTransition writes the transition history entry and its audit log
+// within the caller's unit of work.
+func (s *roadmapItemService) recordTransition(ctx context.Context, repos Repositories, item *domain.RoadmapItem, to domain.RoadmapItemStatus, reason string, actor domain.Principal) error {
+	t := &domain.RoadmapStatusTransition{
+		ID:             uuid.New(),
+		RoadmapItemID:  item.ID,
+		FromStatus:     item.Status,
+		ToStatus:       to,
+		Reason:         strings.TrimSpace(reason),
+		TransitionedBy: actor.UserID,
+		Role:           actor.Role,
+		CreatedAt:      time.Now(),
+	}
+	if err := repos.RoadmapStatusTransitions.Create(ctx, t); err != nil {
+		return err
+	}
+	return NewAuditLogService(repos.AuditLogs).Log(ctx, "roadmap_item", item.ID, "STATUS_TRANSITION", actor.UserID,
+		map[string]interface{}{"status": item.Status},
+		map[string]interface{}{"status": to, "reason": t.Reason, "role": actor.Role},
+	)
+}
+
+// afterChange refreshes the derived intelligence and alignment state.
+func (s *roadmapItemService) afterChange(ctx context.Context, item *domain.RoadmapItem) {
+	if item.Type == domain.Feature {
+		_, _ = s.featureIntelligence.CalculateFeatureScore(ctx, item.ID)
+	}
+	_, _ = s.alignment.TriggerAlignmentCheck(ctx, item.ProjectID)
+}
+
 func (s *roadmapItemService) DeleteRoadmapItem(ctx context.Context, id uuid.UUID, userID uuid.UUID) error {
 	item, err := s.repo.Get(ctx, id)
 	if err != nil {
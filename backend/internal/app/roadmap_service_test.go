@@ -0,0 +1,106 @@
+package app
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/SpecForgeVC/SpecForge/internal/domain"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+type memTransitionRepo struct {
+	transitions []domain.RoadmapStatusTransition
+}
+
+func (m *memTransitionRepo) Create(ctx context.Context, t *domain.RoadmapStatusTransition) error {
+	m.transitions = append(m.transitions, *t)
+	return nil
+}
+func (m *memTransitionRepo) List(ctx context.Context, roadmapItemID uuid.UUID) ([]domain.RoadmapStatusTransition, error) {
+	return m.transitions, nil
+}
+
+type stubAlignmentService struct{ AlignmentService }
+
+func (s *stubAlignmentService) TriggerAlignmentCheck(ctx context.Context, projectID uuid.UUID) (*domain.AlignmentReport, error) {
+	return nil, nil
+}
+
+func newTestRoadmapService(item *domain.RoadmapItem) (RoadmapItemService, *mockGovService, *memTransitionRepo) {
+	roadmapRepo := new(mockRoadmapRepo)
+	roadmapRepo.On("Get", mock.Anything, item.ID).Return(item, nil)
+	audit := new(mockAuditLog)
+	audit.On("Log", mock.Anything, "roadmap_item", item.ID, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil)
+	gov := new(mockGovService)
+	transitions := &memTransitionRepo{}
+	uow := &memUnitOfWork{repos: Repositories{
+		RoadmapItems:             roadmapRepo,
+		RoadmapStatusTransitions: transitions,
+		AuditLogs:                &memAuditRepo{},
+	}}
+	svc := NewRoadmapItemService(nil, roadmapRepo, transitions, uow, audit, new(mockFiService), gov, &stubAlignmentService{})
+	return svc, gov, transitions
+}
+
+func TestTransitionRoadmapItem_Workflow(t *testing.T) {
+	ctx := context.Background()
+	engineer := domain.Principal{UserID: uuid.New(), Role: domain.RoleEngineer}
+	reviewer := domain.Principal{UserID: uuid.New(), Role: domain.RoleReviewer}
+
+	item := &domain.RoadmapItem{ID: uuid.New(), ProjectID: uuid.New(), Type: domain.Epic, Status: domain.StatusDraft}
+	svc, _, transitions := newTestRoadmapService(item)
+
+	var transitionErr *StatusTransitionError
+	_, err := svc.TransitionRoadmapItem(ctx, item.ID, domain.StatusComplete, "", engineer)
+	assert.ErrorIs(t, err, ErrStatusTransitionRejected)
+	assert.True(t, errors.As(err, &transitionErr))
+	assert.Equal(t, TransitionNotAllowed, transitionErr.Failure)
+	assert.Len(t, transitionErr.Allowed, 1)
+
+	_, err = svc.UpdateRoadmapItem(ctx, item.ID, "t", "", "", "", domain.StatusComplete, "", engineer)
+	assert.ErrorIs(t, err, ErrStatusTransitionRejected, "PATCH must not bypass the workflow")
+
+	updated, err := svc.TransitionRoadmapItem(ctx, item.ID, domain.StatusInReview, "", engineer)
+	assert.NoError(t, err)
+	assert.Equal(t, domain.StatusInReview, updated.Status)
+	assert.Len(t, transitions.transitions, 1)
+	assert.Equal(t, engineer.UserID, transitions.transitions[0].TransitionedBy)
+
+	item.Status = domain.StatusInReview
+	_, err = svc.TransitionRoadmapItem(ctx, item.ID, domain.StatusApproved, "", engineer)
+	assert.True(t, errors.As(err, &transitionErr))
+	assert.Equal(t, TransitionForbidden, transitionErr.Failure)
+
+	_, err = svc.TransitionRoadmapItem(ctx, item.ID, domain.StatusDraft, " ", reviewer)
+	assert.True(t, errors.As(err, &transitionErr))
+	assert.Equal(t, TransitionReasonRequired, transitionErr.Failure)
+
+	_, err = svc.TransitionRoadmapItem(ctx, item.ID, domain.StatusDraft, "Acceptance criteria are missing", reviewer)
+	assert.NoError(t, err)
+	assert.Equal(t, "Acceptance criteria are missing", transitions.transitions[1].Reason)
+	assert.Equal(t, domain.RoleReviewer, transitions.transitions[1].Role)
+}
+
+func TestTransitionRoadmapItem_GovernanceGate(t *testing.T) {
+	ctx := context.Background()
+	engineer := domain.Principal{UserID: uuid.New(), Role: domain.RoleEngineer}
+	item := &domain.RoadmapItem{ID: uuid.New(), ProjectID: uuid.New(), Type: domain.Epic, Status: domain.StatusApproved}
+	svc, gov, transitions := newTestRoadmapService(item)
+	gov.On("CanBuildFeature", mock.Anything, item.ID).Return(false, []string{"Overall Intelligence Score is too low (40 < 50)"}, nil).Once()
+
+	var transitionErr *StatusTransitionError
+	_, err := svc.TransitionRoadmapItem(ctx, item.ID, domain.StatusInProgress, "", engineer)
+	assert.True(t, errors.As(err, &transitionErr))
+	assert.Equal(t, TransitionBlocked, transitionErr.Failure)
+	assert.Equal(t, []string{"Overall Intelligence Score is too low (40 < 50)"}, transitionErr.Reasons)
+	assert.Empty(t, transitions.transitions)
+
+	gov.On("CanBuildFeature", mock.Anything, item.ID).Return(true, []string(nil), nil)
+	updated, err := svc.TransitionRoadmapItem(ctx, item.ID, domain.StatusInProgress, "", engineer)
+	assert.NoError(t, err)
+	assert.Equal(t, domain.StatusInProgress, updated.Status)
+	assert.Len(t, transitions.transitions, 1)
+}
@@ -2,11 +2,18 @@ package app
 
 import (
 	"context"
+	"errors"
+	"fmt"
+	"strings"
 
 	"github.com/SpecForgeVC/SpecForge/internal/domain"
+	"github.com/SpecForgeVC/SpecForge/internal/rules"
 	"github.com/google/uuid"
 )
 
+// ErrInvalidValidationRule wraps rule configs the rule engine cannot compile.
+var ErrInvalidValidationRule = errors.New("invalid validation rule")
+
 type validationRuleService struct {
 	repo     ValidationRuleRepository
 	auditLog AuditLogService
@@ -28,10 +35,13 @@ func (s *validationRuleService) CreateValidationRule(ctx context.Context, projec
 	rule := &domain.ValidationRule{
 		ProjectID:   projectID,
 		Name:        name,
-		RuleType:    rType,
+		RuleType:    strings.ToUpper(rType),
 		RuleConfig:  config,
 		Description: description,
 	}
+	if err := checkValidationRule(rule); err != nil {
+		return nil, err
+	}
 	if err := s.repo.Create(ctx, rule); err != nil {
 		return nil, err
 	}
@@ -48,10 +58,13 @@ func (s *validationRuleService) UpdateValidationRule(ctx context.Context, id uui
 		ID:          id,
 		ProjectID:   old.ProjectID,
 		Name:        name,
-		RuleType:    rType,
+		RuleType:    strings.ToUpper(rType),
 		RuleConfig:  config,
 		Description: description,
 	}
+	if err := checkValidationRule(rule); err != nil {
+		return nil, err
+	}
 	if err := s.repo.Update(ctx, rule); err != nil {
 		return nil, err
 	}
@@ -70,3 +83,55 @@ func (s *validationRuleService) DeleteValidationRule(ctx context.Context, id uui
 	s.auditLog.Log(ctx, "validation_rule", id, "DELETE", userID, map[string]interface{}{"name": old.Name}, nil)
 	return nil
 }
+
+// checkValidationRule rejects rules the engine could not execute.
+func checkValidationRule(r *domain.ValidationRule) error {
+	if err := requireField("name", r.Name); err != nil {
+		return fmt.Errorf("%w: %v", ErrInvalidValidationRule, err)
+	}
+	if r.RuleConfig == nil {
+		r.RuleConfig = map[string]interface{}{}
+	}
+	if _, err := rules.Compile(*r); err != nil {
+		return fmt.Errorf("%w: %v", ErrInvalidValidationRule, err)
+	}
+	return nil
+}
+
+// EvaluateValidationRules runs the project's rules, or the given subset of
+// them. With a payload each rule checks its bound locations in it; with a
+// variable the rules bound to that variable check value; otherwise every
+// selected rule checks value itself.
+func (s *validationRuleService) EvaluateValidationRules(ctx context.Context, projectID uuid.UUID, ruleIDs []uuid.UUID, variable string, value, payload interface{}) (*rules.Report, error) {
+	all, err := s.repo.List(ctx, projectID)
+	if err != nil {
+		return nil, err
+	}
+	selected := all
+	if len(ruleIDs) > 0 {
+		byID := make(map[uuid.UUID]domain.ValidationRule, len(all))
+		for _, r := range all {
+			byID[r.ID] = r
+		}
+		selected = make([]domain.ValidationRule, 0, len(ruleIDs))
+		for _, id := range ruleIDs {
+			r, ok := byID[id]
+			if !ok {
+				return nil, fmt.Errorf("%w: rule %s does not belong to project %s", ErrInvalidValidationRule, id, projectID)
+			}
+			selected = append(selected, r)
+		}
+	}
+
+	set := rules.CompileAll(selected)
+	var report rules.Report
+	switch {
+	case payload != nil:
+		report = set.Evaluate(payload)
+	case variable != "":
+		report = set.TestVariable(variable, value)
+	default:
+		report = set.Test(value)
+	}
+	return &report, nil
+}
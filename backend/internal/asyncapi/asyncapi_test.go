@@ -0,0 +1,207 @@
+package asyncapi
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/SpecForgeVC/SpecForge/internal/domain/drift"
+)
+
+const ordersV2 = `
+asyncapi: 2.6.0
+info:
+  title: Orders
+  version: 1.0.0
+channels:
+  orders.created:
+    subscribe:
+      operationId: onOrderCreated
+      message:
+        $ref: '#/components/messages/OrderCreated'
+  orders.cancelled:
+    publish:
+      message:
+        name: OrderCancelled
+        payload:
+          type: object
+          properties:
+            id: {type: string}
+components:
+  messages:
+    OrderCreated:
+      name: OrderCreated
+      contentType: application/json
+      headers:
+        type: object
+        properties:
+          traceId: {type: string}
+      payload:
+        type: object
+        required: [id]
+        properties:
+          id: {type: string}
+          total: {type: number}
+`
+
+const ordersV3 = `
+asyncapi: 3.0.0
+info:
+  title: Orders
+  version: 2.0.0
+channels:
+  orderCreated:
+    address: orders.created
+    messages:
+      OrderCreated:
+        $ref: '#/components/messages/OrderCreated'
+  orderCancelledV2:
+    address: orders.cancelled.v2
+    messages:
+      OrderCancelled:
+        payload:
+          type: object
+          properties:
+            id: {type: string}
+operations:
+  onOrderCreated:
+    action: send
+    channel:
+      $ref: '#/channels/orderCreated'
+  cancelOrder:
+    action: receive
+    channel:
+      $ref: '#/channels/orderCancelledV2'
+components:
+  messages:
+    OrderCreated:
+      name: OrderCreated
+      contentType: application/json
+      headers:
+        type: object
+        required: [tenantId]
+        properties:
+          traceId: {type: string}
+          tenantId: {type: string}
+      payload:
+        type: object
+        required: [id]
+        properties:
+          id: {type: string}
+`
+
+func TestLoad_ExtractsChannels(t *testing.T) {
+	doc, err := Load([]byte(ordersV2))
+	if err != nil {
+		t.Fatalf("Load v2 failed: %v", err)
+	}
+	if len(doc.Channels) != 2 {
+		t.Fatalf("expected 2 channels, got %+v", doc.Channels)
+	}
+	created := doc.Channels[1]
+	if created.Name != "orders.created" || created.Operations[0] != "send" {
+		t.Errorf("unexpected channel %+v", created)
+	}
+	if len(created.Messages) != 1 || created.Messages[0].Name != "OrderCreated" || created.Messages[0].Payload == nil {
+		t.Errorf("expected resolved OrderCreated message with payload, got %+v", created.Messages)
+	}
+
+	doc, err = Load([]byte(ordersV3))
+	if err != nil {
+		t.Fatalf("Load v3 failed: %v", err)
+	}
+	for _, ch := range doc.Channels {
+		if ch.ID == "orderCreated" && (ch.Name != "orders.created" || len(ch.Operations) != 1 || ch.Operations[0] != "send") {
+			t.Errorf("expected orderCreated on address orders.created with send, got %+v", ch)
+		}
+	}
+}
+
+func TestLoad_Validation(t *testing.T) {
+	_, err := Load([]byte("asyncapi: 3.0.0\ninfo: {}\noperations:\n  x:\n    action: publish\n"))
+	var vErr *ValidationError
+	if !errors.As(err, &vErr) {
+		t.Fatalf("expected ValidationError, got %v", err)
+	}
+	if len(vErr.Problems) < 3 {
+		t.Errorf("expected missing title, version and bad operation to be reported, got %v", vErr.Problems)
+	}
+	if _, err := Load([]byte("openapi: 3.0.0\n")); err == nil {
+		t.Errorf("expected non-AsyncAPI document to be rejected")
+	}
+}
+
+func TestCompare_V2ToV3(t *testing.T) {
+	base, err := Load([]byte(ordersV2))
+	if err != nil {
+		t.Fatal(err)
+	}
+	prop, err := Load([]byte(ordersV3))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	report := Compare(base, prop)
+	found := map[drift.DriftType]drift.DriftItem{}
+	for _, item := range report.Items {
+		found[item.Type] = item
+	}
+
+	if _, ok := found[drift.MethodRemoved]; ok {
+		t.Errorf("expected 2.x publish to map onto 3.x receive without operation drift, got %+v", report.Items)
+	}
+	if item, ok := found[drift.ChannelRenamed]; !ok || item.Proposed != "orders.cancelled.v2" {
+		t.Errorf("expected orders.cancelled rename, got %+v", report.Items)
+	}
+	if item, ok := found[drift.RequiredFieldRemoved]; !ok || item.Baseline != "total" {
+		t.Errorf("expected payload field 'total' removal, got %+v", report.Items)
+	}
+	if item, ok := found[drift.RequiredHeaderAdded]; !ok || item.Severity != drift.Breaking {
+		t.Errorf("expected breaking required header addition, got %+v", report.Items)
+	}
+}
+
+func TestCompare_PayloadDirectionFollowsOperation(t *testing.T) {
+	doc := func(operation, required, statuses string) string {
+		return `
+asyncapi: 2.6.0
+info: {title: Orders, version: 1.0.0}
+channels:
+  orders.updated:
+    ` + operation + `:
+      message:
+        name: OrderUpdated
+        payload:
+          type: object
+          required: [` + required + `]
+          properties:
+            id: {type: string}
+            status: {type: string, enum: [` + statuses + `]}
+`
+	}
+	severities := func(operation string) map[drift.DriftType]drift.DriftSeverity {
+		base, err := Load([]byte(doc(operation, "id", "open, closed")))
+		if err != nil {
+			t.Fatal(err)
+		}
+		prop, err := Load([]byte(doc(operation, "id, status", "open, closed, disputed")))
+		if err != nil {
+			t.Fatal(err)
+		}
+		found := map[drift.DriftType]drift.DriftSeverity{}
+		for _, item := range Compare(base, prop).Items {
+			found[item.Type] = item.Severity
+		}
+		return found
+	}
+
+	// 2.x publish: outside producers write the message the application receives.
+	received := severities("publish")
+	if received[drift.RequiredFieldAdded] != drift.Breaking || received[drift.EnumValueAdded] != drift.Warning {
+		t.Errorf("expected received payloads to be graded as requests, got %v", received)
+	}
+	// 2.x subscribe: subscribers read the message the application sends.
+	sent := severities("subscribe")
+	if sent[drift.RequiredFieldAdded] != drift.Info || sent[drift.EnumValueAdded] != drift.Breaking {
+		t.Errorf("expected sent payloads to be graded as responses, got %v", sent)
+	}
+}
@@ -0,0 +1,162 @@
+package asyncapi
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/SpecForgeVC/SpecForge/internal/domain"
+	"github.com/SpecForgeVC/SpecForge/internal/domain/drift"
+	"github.com/SpecForgeVC/SpecForge/internal/openapi"
+)
+
+// Compare reports drift between two AsyncAPI documents. Channels are matched by
+// address; a removed and an added channel carrying the same message names are
+// reported as a rename. Payloads go through drift.CompareSchema.
+func Compare(baseline, proposed *Document) drift.DriftReport {
+	var items []drift.DriftItem
+
+	propByName := make(map[string]domain.EventChannel, len(proposed.Channels))
+	for _, ch := range proposed.Channels {
+		propByName[ch.Name] = ch
+	}
+	baseByName := make(map[string]bool, len(baseline.Channels))
+	for _, ch := range baseline.Channels {
+		baseByName[ch.Name] = true
+	}
+
+	var added []domain.EventChannel
+	for _, ch := range proposed.Channels {
+		if !baseByName[ch.Name] {
+			added = append(added, ch)
+		}
+	}
+	renamedTo := make(map[string]bool)
+
+	for _, base := range baseline.Channels {
+		if prop, ok := propByName[base.Name]; ok {
+			items = append(items, compareChannel("/channels:"+base.Name, base, prop)...)
+			continue
+		}
+		if target, ok := findRename(base, added, renamedTo); ok {
+			renamedTo[target.Name] = true
+			items = append(items, newItem(drift.ChannelRenamed, "/channels:"+base.Name, base.Name, target.Name,
+				fmt.Sprintf("Channel '%s' renamed to '%s'", base.Name, target.Name)))
+			items = append(items, compareChannel("/channels:"+target.Name, base, target)...)
+			continue
+		}
+		items = append(items, newItem(drift.ChannelRemoved, "/channels", base.Name, nil, fmt.Sprintf("Channel '%s' removed", base.Name)))
+	}
+
+	for _, ch := range added {
+		if !renamedTo[ch.Name] {
+			items = append(items, newItem(drift.ChannelAdded, "/channels", nil, ch.Name, fmt.Sprintf("Channel '%s' added", ch.Name)))
+		}
+	}
+
+	return drift.NewDriftReport(items)
+}
+
+func compareChannel(location string, base, prop domain.EventChannel) []drift.DriftItem {
+	var items []drift.DriftItem
+
+	for _, op := range base.Operations {
+		if !contains(prop.Operations, op) {
+			items = append(items, newItem(drift.MethodRemoved, location, op, nil, fmt.Sprintf("Operation '%s' removed from channel", op)))
+		}
+	}
+	for _, op := range prop.Operations {
+		if !contains(base.Operations, op) {
+			items = append(items, newItem(drift.NewMethod, location, nil, op, fmt.Sprintf("Operation '%s' added to channel", op)))
+		}
+	}
+
+	dir := messageDirection(base, prop)
+	for _, bm := range base.Messages {
+		pm, ok := findMessage(prop.Messages, bm.Name)
+		if !ok {
+			items = append(items, newItem(drift.MessageRemoved, location, bm.Name, nil, fmt.Sprintf("Message '%s' removed", bm.Name)))
+			continue
+		}
+		loc := location + ":" + bm.Name
+		items = append(items, drift.CompareSchemaDirection(openapi.ToSchema(bm.Payload), openapi.ToSchema(pm.Payload), loc+":payload", dir)...)
+		for _, item := range drift.CompareSchemaDirection(openapi.ToSchema(bm.Headers), openapi.ToSchema(pm.Headers), loc+":headers", dir) {
+			if item.Type == drift.RequiredFieldAdded {
+				item.Type = drift.RequiredHeaderAdded
+				item.Severity = drift.GetSeverity(item.Type, item.Baseline, item.Proposed)
+				item.Description = fmt.Sprintf("Required header '%v' added", item.Proposed)
+			}
+			items = append(items, item)
+		}
+		if bm.ContentType != pm.ContentType && bm.ContentType != "" {
+			items = append(items, newItem(drift.FieldTypeChanged, loc+":contentType", bm.ContentType, pm.ContentType,
+				fmt.Sprintf("Content type changed from %s to %s", bm.ContentType, pm.ContentType)))
+		}
+	}
+	for _, pm := range prop.Messages {
+		if _, ok := findMessage(base.Messages, pm.Name); !ok {
+			items = append(items, newItem(drift.MessageAdded, location, nil, pm.Name, fmt.Sprintf("Message '%s' added", pm.Name)))
+		}
+	}
+
+	return items
+}
+
+// messageDirection grades a channel's messages by who writes them. Outside
+// producers write the messages the application receives, so those are graded
+// like requests; subscribers read what the application sends, graded like
+// responses. Channels with both or no known operations get the stricter of the two.
+func messageDirection(base, prop domain.EventChannel) drift.Direction {
+	ops := append(append([]string{}, base.Operations...), prop.Operations...)
+	receive, send := contains(ops, "receive"), contains(ops, "send")
+	switch {
+	case receive && !send:
+		return drift.RequestDirection
+	case send && !receive:
+		return drift.ResponseDirection
+	default:
+		return drift.BothDirections
+	}
+}
+
+func findRename(base domain.EventChannel, added []domain.EventChannel, taken map[string]bool) (domain.EventChannel, bool) {
+	key := messageKey(base)
+	if key == "" {
+		return domain.EventChannel{}, false
+	}
+	for _, ch := range added {
+		if !taken[ch.Name] && messageKey(ch) == key {
+			return ch, true
+		}
+	}
+	return domain.EventChannel{}, false
+}
+
+func messageKey(ch domain.EventChannel) string {
+	names := make([]string, 0, len(ch.Messages))
+	for _, m := range ch.Messages {
+		names = append(names, m.Name)
+	}
+	sort.Strings(names)
+	return strings.Join(names, ",")
+}
+
+func findMessage(list []domain.EventMessage, name string) (domain.EventMessage, bool) {
+	for _, m := range list {
+		if m.Name == name {
+			return m, true
+		}
+	}
+	return domain.EventMessage{}, false
+}
+
+func newItem(t drift.DriftType, loc string, base, prop any, desc string) drift.DriftItem {
+	return drift.DriftItem{
+		Type:        t,
+		Severity:    drift.GetSeverity(t, base, prop),
+		Location:    loc,
+		Baseline:    base,
+		Proposed:    prop,
+		Description: desc,
+	}
+}
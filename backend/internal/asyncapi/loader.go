@@ -0,0 +1,268 @@
+package asyncapi
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/SpecForgeVC/SpecForge/internal/domain"
+	"github.com/SpecForgeVC/SpecForge/internal/openapi"
+)
+
+// Document is the channel view of an AsyncAPI 2.x or 3.x document.
+type Document struct {
+	Version  string                `json:"version"`
+	Title    string                `json:"title"`
+	Channels []domain.EventChannel `json:"channels"`
+}
+
+// ValidationError lists every problem found in a document.
+type ValidationError struct {
+	Problems []string `json:"problems"`
+}
+
+func (e *ValidationError) Error() string {
+	return "invalid AsyncAPI document: " + strings.Join(e.Problems, "; ")
+}
+
+// IsAsyncAPI reports whether a contract schema holds an AsyncAPI document.
+func IsAsyncAPI(schema map[string]interface{}) bool {
+	_, ok := schema["asyncapi"]
+	return ok
+}
+
+// Load parses an AsyncAPI document from YAML or JSON.
+func Load(data []byte) (*Document, error) {
+	root, err := openapi.Parse(data)
+	if err != nil {
+		return nil, err
+	}
+	return FromMap(root)
+}
+
+// FromMap validates an already-decoded AsyncAPI document and extracts its channels.
+// Local $refs are resolved; message payloads and headers are returned as JSON Schema.
+func FromMap(root map[string]interface{}) (*Document, error) {
+	version, _ := root["asyncapi"].(string)
+	var problems []string
+	switch {
+	case version == "":
+		return nil, &ValidationError{Problems: []string{"missing 'asyncapi' version field"}}
+	case !strings.HasPrefix(version, "2.") && !strings.HasPrefix(version, "3."):
+		return nil, &ValidationError{Problems: []string{fmt.Sprintf("unsupported asyncapi version %s (expected 2.x or 3.x)", version)}}
+	}
+
+	info, _ := root["info"].(map[string]interface{})
+	title, _ := info["title"].(string)
+	if title == "" {
+		problems = append(problems, "info.title is required")
+	}
+	if v, _ := info["version"].(string); v == "" {
+		problems = append(problems, "info.version is required")
+	}
+	if _, ok := root["channels"].(map[string]interface{}); !ok && root["channels"] != nil {
+		problems = append(problems, "channels must be an object")
+	}
+
+	// 3.x operations reference channels by $ref; capture the ids before inlining.
+	opChannels := operationChannels(root)
+
+	resolved, err := openapi.ResolveRefs(root)
+	if err != nil {
+		return nil, err
+	}
+
+	doc := &Document{Version: version, Title: title}
+	if strings.HasPrefix(version, "2.") {
+		doc.Channels, problems = channelsV2(resolved, problems)
+	} else {
+		doc.Channels, problems = channelsV3(resolved, opChannels, problems)
+	}
+	if len(problems) > 0 {
+		return nil, &ValidationError{Problems: problems}
+	}
+	return doc, nil
+}
+
+// ValidateContract checks the input schema of an EVENT contract. Contracts that do
+// not embed an AsyncAPI document keep the plain JSON Schema behaviour.
+func ValidateContract(input map[string]interface{}) error {
+	if !IsAsyncAPI(input) {
+		return nil
+	}
+	_, err := FromMap(input)
+	return err
+}
+
+// Channels extracts the channel list of an EVENT contract, or nil if it has none.
+func Channels(c domain.ContractDefinition) []domain.EventChannel {
+	if c.ContractType != domain.Event || !IsAsyncAPI(c.InputSchema) {
+		return nil
+	}
+	doc, err := FromMap(c.InputSchema)
+	if err != nil {
+		return nil
+	}
+	return doc.Channels
+}
+
+// v2Actions maps 2.x operations onto 3.x actions: a 2.x "publish" operation is
+// one other parties publish to, so the application receives it.
+var v2Actions = map[string]string{"publish": "receive", "subscribe": "send"}
+
+func channelsV2(root map[string]interface{}, problems []string) ([]domain.EventChannel, []string) {
+	channels, _ := root["channels"].(map[string]interface{})
+	var out []domain.EventChannel
+	for _, address := range sortedKeys(channels) {
+		item, _ := channels[address].(map[string]interface{})
+		ch := domain.EventChannel{Name: address, Operations: []string{}, Messages: []domain.EventMessage{}}
+		for _, action := range []string{"publish", "subscribe"} {
+			op, ok := item[action].(map[string]interface{})
+			if !ok {
+				continue
+			}
+			ch.Operations = append(ch.Operations, v2Actions[action])
+			opID, _ := op["operationId"].(string)
+			msg, _ := op["message"].(map[string]interface{})
+			if msg == nil {
+				problems = append(problems, fmt.Sprintf("channels.%s.%s.message is required", address, action))
+				continue
+			}
+			candidates := []interface{}{msg}
+			if oneOf, ok := msg["oneOf"].([]interface{}); ok {
+				candidates = oneOf
+			}
+			for i, raw := range candidates {
+				m, _ := raw.(map[string]interface{})
+				fallback := opID
+				if fallback == "" {
+					fallback = action
+				}
+				if len(candidates) > 1 {
+					fallback = fmt.Sprintf("%s[%d]", fallback, i)
+				}
+				message, problem := toMessage(m, fallback, fmt.Sprintf("channels.%s.%s.message", address, action))
+				if problem != "" {
+					problems = append(problems, problem)
+				}
+				ch.Messages = appendMessage(ch.Messages, message)
+			}
+		}
+		out = append(out, ch)
+	}
+	return out, problems
+}
+
+func channelsV3(root map[string]interface{}, opChannels map[string][]string, problems []string) ([]domain.EventChannel, []string) {
+	channels, _ := root["channels"].(map[string]interface{})
+	var out []domain.EventChannel
+	for _, id := range sortedKeys(channels) {
+		item, _ := channels[id].(map[string]interface{})
+		address, _ := item["address"].(string)
+		if address == "" {
+			address = id
+		}
+		ch := domain.EventChannel{Name: address, ID: id, Operations: opChannels[id], Messages: []domain.EventMessage{}}
+		if ch.Operations == nil {
+			ch.Operations = []string{}
+		}
+		messages, _ := item["messages"].(map[string]interface{})
+		for _, key := range sortedKeys(messages) {
+			m, _ := messages[key].(map[string]interface{})
+			message, problem := toMessage(m, key, fmt.Sprintf("channels.%s.messages.%s", id, key))
+			if problem != "" {
+				problems = append(problems, problem)
+			}
+			ch.Messages = appendMessage(ch.Messages, message)
+		}
+		out = append(out, ch)
+	}
+
+	ops, _ := root["operations"].(map[string]interface{})
+	for _, id := range sortedKeys(ops) {
+		op, _ := ops[id].(map[string]interface{})
+		if action, _ := op["action"].(string); action != "send" && action != "receive" {
+			problems = append(problems, fmt.Sprintf("operations.%s.action must be 'send' or 'receive'", id))
+		}
+		if _, ok := op["channel"].(map[string]interface{}); !ok {
+			problems = append(problems, fmt.Sprintf("operations.%s.channel is required", id))
+		}
+	}
+	return out, problems
+}
+
+// operationChannels maps 3.x channel ids to the actions of the operations that reference them.
+func operationChannels(root map[string]interface{}) map[string][]string {
+	out := make(map[string][]string)
+	ops, _ := root["operations"].(map[string]interface{})
+	for _, id := range sortedKeys(ops) {
+		op, _ := ops[id].(map[string]interface{})
+		action, _ := op["action"].(string)
+		ch, _ := op["channel"].(map[string]interface{})
+		ref, _ := ch["$ref"].(string)
+		channelID, ok := strings.CutPrefix(ref, "#/channels/")
+		if !ok || action == "" {
+			continue
+		}
+		if !contains(out[channelID], action) {
+			out[channelID] = append(out[channelID], action)
+		}
+	}
+	return out
+}
+
+func toMessage(m map[string]interface{}, fallback, location string) (domain.EventMessage, string) {
+	name, _ := m["name"].(string)
+	if name == "" {
+		name, _ = m["messageId"].(string)
+	}
+	if name == "" {
+		name = fallback
+	}
+	message := domain.EventMessage{Name: name}
+	message.ContentType, _ = m["contentType"].(string)
+
+	var problem string
+	if raw, ok := m["payload"]; ok {
+		payload, isMap := raw.(map[string]interface{})
+		if !isMap {
+			problem = location + ".payload must be a schema object"
+		}
+		// 3.x allows a multi-format schema wrapper.
+		if inner, ok := payload["schema"].(map[string]interface{}); ok && payload["schemaFormat"] != nil {
+			payload = inner
+		}
+		message.Payload = payload
+	}
+	if headers, ok := m["headers"].(map[string]interface{}); ok {
+		message.Headers = headers
+	}
+	return message, problem
+}
+
+func appendMessage(list []domain.EventMessage, m domain.EventMessage) []domain.EventMessage {
+	for _, existing := range list {
+		if existing.Name == m.Name {
+			return list
+		}
+	}
+	return append(list, m)
+}
+
+func sortedKeys(m map[string]interface{}) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+func contains(list []string, v string) bool {
+	for _, s := range list {
+		if s == v {
+			return true
+		}
+	}
+	return false
+}
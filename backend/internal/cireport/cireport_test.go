@@ -0,0 +1,89 @@
+package cireport
+
+import (
+	"encoding/json"
+	"encoding/xml"
+	"strings"
+	"testing"
+
+	"github.com/SpecForgeVC/SpecForge/internal/domain"
+	"github.com/SpecForgeVC/SpecForge/internal/domain/drift"
+	"github.com/google/uuid"
+)
+
+var driftReport = drift.NewDriftReport([]drift.DriftItem{
+	{Type: drift.PathRemoved, Severity: drift.Critical, Location: "/users", Description: "Path removed"},
+	{Type: drift.PathRemoved, Severity: drift.Critical, Location: "/orders", Description: "Path removed", Waived: true},
+	{Type: drift.NewPath, Severity: drift.Info, Location: "/pets", Description: "Path added"},
+})
+
+func TestSARIF(t *testing.T) {
+	data, err := SARIF(FromDriftReport("spec-check", "openapi.yaml", driftReport))
+	if err != nil {
+		t.Fatalf("SARIF failed: %v", err)
+	}
+
+	var log sarifLog
+	if err := json.Unmarshal(data, &log); err != nil {
+		t.Fatalf("invalid SARIF JSON: %v", err)
+	}
+	if log.Version != "2.1.0" || len(log.Runs) != 1 {
+		t.Fatalf("expected a single SARIF 2.1.0 run, got %+v", log)
+	}
+	run := log.Runs[0]
+	if len(run.Tool.Driver.Rules) != 2 || run.Tool.Driver.Rules[0].ID != "PATH_REMOVED" {
+		t.Errorf("expected one rule per drift type in report order, got %+v", run.Tool.Driver.Rules)
+	}
+	if len(run.Results) != 3 {
+		t.Fatalf("expected 3 results, got %d", len(run.Results))
+	}
+	for _, res := range run.Results {
+		if run.Tool.Driver.Rules[res.RuleIndex].ID != res.RuleID {
+			t.Errorf("ruleIndex %d does not point at %s", res.RuleIndex, res.RuleID)
+		}
+		if res.Locations[0].PhysicalLocation.ArtifactLocation.URI != "openapi.yaml" {
+			t.Errorf("expected results to be attributed to the artifact, got %+v", res.Locations)
+		}
+		if res.Locations[0].LogicalLocations[0].FullyQualifiedName == "/orders" && len(res.Suppressions) != 1 {
+			t.Errorf("expected waived item to be suppressed")
+		}
+	}
+	if run.Results[1].Level != LevelNote || run.Results[2].Level != LevelError {
+		t.Errorf("expected INFO as note and CRITICAL as error, got %s and %s", run.Results[1].Level, run.Results[2].Level)
+	}
+}
+
+func TestJUnit(t *testing.T) {
+	report := FromAlignmentReport("alignment", "", domain.AlignmentReport{
+		Conflicts: []domain.Conflict{
+			{Type: domain.ConflictSchemaMismatch, Severity: domain.SeverityCritical, SourceID: uuid.New(), TargetID: uuid.New(), Description: "user.id is string in A and int in B"},
+		},
+		MissingDependencies: []string{"billing"},
+	})
+	data, err := JUnit(report)
+	if err != nil {
+		t.Fatalf("JUnit failed: %v", err)
+	}
+	if !strings.HasPrefix(string(data), "<?xml") {
+		t.Errorf("expected an XML header")
+	}
+
+	var doc junitTestSuites
+	if err := xml.Unmarshal(data, &doc); err != nil {
+		t.Fatalf("invalid JUnit XML: %v", err)
+	}
+	if doc.Tests != 2 || doc.Failures != 1 || len(doc.Suites[0].TestCases) != 2 {
+		t.Errorf("expected 2 test cases with 1 failure, got %+v", doc)
+	}
+	if tc := doc.Suites[0].TestCases[0]; tc.Failure == nil || tc.Failure.Type != "SCHEMA_MISMATCH" {
+		t.Errorf("expected the conflict to fail with its conflict type, got %+v", tc)
+	}
+	if !report.Failed() {
+		t.Errorf("expected report with a critical conflict to fail")
+	}
+
+	empty, _ := JUnit(FromLegacyDriftReport("drift", "", domain.DriftReport{}))
+	if !strings.Contains(string(empty), `name="no findings"`) {
+		t.Errorf("expected a passing test case for an empty report, got %s", empty)
+	}
+}
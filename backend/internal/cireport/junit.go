@@ -0,0 +1,88 @@
+package cireport
+
+import (
+	"encoding/xml"
+	"fmt"
+)
+
+type junitTestSuites struct {
+	XMLName  xml.Name         `xml:"testsuites"`
+	Name     string           `xml:"name,attr"`
+	Tests    int              `xml:"tests,attr"`
+	Failures int              `xml:"failures,attr"`
+	Skipped  int              `xml:"skipped,attr"`
+	Suites   []junitTestSuite `xml:"testsuite"`
+}
+
+type junitTestSuite struct {
+	Name      string          `xml:"name,attr"`
+	Tests     int             `xml:"tests,attr"`
+	Failures  int             `xml:"failures,attr"`
+	Skipped   int             `xml:"skipped,attr"`
+	TestCases []junitTestCase `xml:"testcase"`
+}
+
+type junitTestCase struct {
+	Name      string        `xml:"name,attr"`
+	ClassName string        `xml:"classname,attr"`
+	Failure   *junitFailure `xml:"failure,omitempty"`
+	Skipped   *junitSkipped `xml:"skipped,omitempty"`
+	SystemOut string        `xml:"system-out,omitempty"`
+}
+
+type junitFailure struct {
+	Message string `xml:"message,attr"`
+	Type    string `xml:"type,attr"`
+	Text    string `xml:",chardata"`
+}
+
+type junitSkipped struct {
+	Message string `xml:"message,attr"`
+}
+
+// JUnit renders the report as one test suite with a test case per check,
+// named after the check's rule and classed by its location. Errors fail,
+// suppressed checks are skipped and warnings and notes pass with their message
+// as output. A report without checks yields a single passing test case so CI
+// still records the run.
+func JUnit(r Report) ([]byte, error) {
+	suite := junitTestSuite{Name: r.Name}
+	for _, c := range r.Checks {
+		tc := junitTestCase{Name: c.RuleID, ClassName: c.Location}
+		if tc.ClassName == "" {
+			tc.ClassName = r.Name
+		}
+		switch {
+		case c.Suppressed:
+			tc.Skipped = &junitSkipped{Message: "waived: " + c.Message}
+			suite.Skipped++
+		case c.Level == LevelError:
+			tc.Failure = &junitFailure{
+				Message: c.Message,
+				Type:    c.RuleID,
+				Text:    fmt.Sprintf("%s at %s: %s", c.Severity, c.Location, c.Message),
+			}
+			suite.Failures++
+		default:
+			tc.SystemOut = fmt.Sprintf("%s: %s", c.Severity, c.Message)
+		}
+		suite.TestCases = append(suite.TestCases, tc)
+	}
+	if len(suite.TestCases) == 0 {
+		suite.TestCases = append(suite.TestCases, junitTestCase{Name: "no findings", ClassName: r.Name})
+	}
+	suite.Tests = len(suite.TestCases)
+
+	doc := junitTestSuites{
+		Name:     toolName,
+		Tests:    suite.Tests,
+		Failures: suite.Failures,
+		Skipped:  suite.Skipped,
+		Suites:   []junitTestSuite{suite},
+	}
+	data, err := xml.MarshalIndent(doc, "", "  ")
+	if err != nil {
+		return nil, err
+	}
+	return append([]byte(xml.Header), data...), nil
+}
@@ -0,0 +1,209 @@
+// Package cireport renders drift and alignment reports in the formats CI
+// systems consume: SARIF 2.1.0 for code scanning and JUnit XML for test reports.
+package cireport
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/SpecForgeVC/SpecForge/internal/domain"
+	"github.com/SpecForgeVC/SpecForge/internal/domain/drift"
+)
+
+type Format string
+
+const (
+	FormatSARIF Format = "sarif"
+	FormatJUnit Format = "junit"
+)
+
+func ParseFormat(s string) (Format, error) {
+	switch Format(strings.ToLower(s)) {
+	case FormatSARIF:
+		return FormatSARIF, nil
+	case FormatJUnit:
+		return FormatJUnit, nil
+	}
+	return "", fmt.Errorf("unsupported report format %q (expected sarif or junit)", s)
+}
+
+// Level is a SARIF result level; JUnit treats only LevelError as a failure.
+type Level string
+
+const (
+	LevelError   Level = "error"
+	LevelWarning Level = "warning"
+	LevelNote    Level = "note"
+)
+
+// Check is one finding of a report. RuleID is the DriftType or ConflictType
+// it was raised for and Severity the report's own severity label.
+type Check struct {
+	RuleID     string
+	Level      Level
+	Severity   string
+	Location   string
+	Message    string
+	Suppressed bool
+}
+
+// Report is a format-neutral view of a drift or alignment report.
+// Artifact is the URI findings are attributed to in SARIF output.
+type Report struct {
+	Name     string
+	Artifact string
+	Checks   []Check
+}
+
+// Failed reports whether any unsuppressed check is an error.
+func (r Report) Failed() bool {
+	for _, c := range r.Checks {
+		if c.Level == LevelError && !c.Suppressed {
+			return true
+		}
+	}
+	return false
+}
+
+// Render returns the report in the given format along with its content type.
+func Render(format Format, r Report) ([]byte, string, error) {
+	switch format {
+	case FormatSARIF:
+		data, err := SARIF(r)
+		return data, "application/sarif+json", err
+	case FormatJUnit:
+		data, err := JUnit(r)
+		return data, "application/xml", err
+	}
+	return nil, "", fmt.Errorf("unsupported report format %q", format)
+}
+
+// FileName returns a download file name for a report with the given base name.
+func FileName(base string, format Format) string {
+	if format == FormatSARIF {
+		return base + ".sarif"
+	}
+	return base + ".junit.xml"
+}
+
+// FromDriftReport converts a drift engine report. Waived items are kept as suppressed checks.
+func FromDriftReport(name, artifact string, r drift.DriftReport) Report {
+	out := Report{Name: name, Artifact: artifact, Checks: make([]Check, 0, len(r.Items))}
+	for _, item := range r.Items {
+		out.Checks = append(out.Checks, Check{
+			RuleID:     string(item.Type),
+			Level:      driftLevel(item.Severity),
+			Severity:   string(item.Severity),
+			Location:   item.Location,
+			Message:    item.Description,
+			Suppressed: item.Waived,
+		})
+	}
+	return out
+}
+
+func driftLevel(s drift.DriftSeverity) Level {
+	switch s {
+	case drift.Critical, drift.Breaking:
+		return LevelError
+	case drift.Warning:
+		return LevelWarning
+	}
+	return LevelNote
+}
+
+// RuleBreakingChange is the rule legacy drift reports are mapped to; they carry no drift type.
+const RuleBreakingChange = "BREAKING_CHANGE"
+
+// FromLegacyDriftReport converts a snapshot drift report. Reports carrying
+// classified details are converted finding by finding; older ones only list
+// breaking changes.
+func FromLegacyDriftReport(name, artifact string, r domain.DriftReport) Report {
+	if r.Details != nil {
+		return FromDriftReport(name, artifact, *r.Details)
+	}
+	out := Report{Name: name, Artifact: artifact, Checks: make([]Check, 0, len(r.BreakingChanges))}
+	for _, bc := range r.BreakingChanges {
+		severity := bc.Severity
+		if severity == "" {
+			severity = drift.Breaking
+		}
+		out.Checks = append(out.Checks, Check{
+			RuleID:   RuleBreakingChange,
+			Level:    LevelError,
+			Severity: string(severity),
+			Location: bc.Field,
+			Message:  bc.Issue,
+		})
+	}
+	return out
+}
+
+// Rules for alignment findings that are not typed conflicts.
+const (
+	RuleMissingDependency  = "MISSING_DEPENDENCY"
+	RuleCircularDependency = "CIRCULAR_DEPENDENCY"
+	RuleOverlap            = "OVERLAP"
+)
+
+// FromAlignmentReport converts an alignment report. Conflicts locate as
+// "source -> target"; dependency findings locate at the dependency they describe.
+func FromAlignmentReport(name, artifact string, r domain.AlignmentReport) Report {
+	out := Report{Name: name, Artifact: artifact}
+	for _, c := range r.Conflicts {
+		out.Checks = append(out.Checks, Check{
+			RuleID:   string(c.Type),
+			Level:    alignmentLevel(c.Severity),
+			Severity: string(c.Severity),
+			Location: fmt.Sprintf("%s -> %s", c.SourceID, c.TargetID),
+			Message:  c.Description,
+		})
+	}
+	for _, dep := range r.CircularDependencies {
+		out.Checks = append(out.Checks, Check{
+			RuleID:   RuleCircularDependency,
+			Level:    LevelError,
+			Severity: string(domain.SeverityError),
+			Location: dep,
+			Message:  "Circular dependency: " + dep,
+		})
+	}
+	for _, dep := range r.MissingDependencies {
+		out.Checks = append(out.Checks, Check{
+			RuleID:   RuleMissingDependency,
+			Level:    LevelWarning,
+			Severity: string(domain.SeverityWarning),
+			Location: dep,
+			Message:  "Missing dependency: " + dep,
+		})
+	}
+	for _, o := range r.Overlaps {
+		out.Checks = append(out.Checks, Check{
+			RuleID:   RuleOverlap,
+			Level:    LevelNote,
+			Severity: string(domain.SeverityInfo),
+			Location: strings.Join(o.SharedFields, ", "),
+			Message:  o.Description,
+		})
+	}
+	return out
+}
+
+func alignmentLevel(s domain.Severity) Level {
+	switch s {
+	case domain.SeverityCritical, domain.SeverityError:
+		return LevelError
+	case domain.SeverityWarning:
+		return LevelWarning
+	}
+	return LevelNote
+}
+
+// ruleDescription turns a rule ID such as PATH_REMOVED into "Path removed".
+func ruleDescription(id string) string {
+	s := strings.ToLower(strings.ReplaceAll(id, "_", " "))
+	if s == "" {
+		return s
+	}
+	return strings.ToUpper(s[:1]) + s[1:]
+}
@@ -0,0 +1,164 @@
+package clispec
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/SpecForgeVC/SpecForge/internal/domain"
+	"github.com/SpecForgeVC/SpecForge/internal/domain/drift"
+)
+
+const baselineCLI = `{
+  "name": "specforge",
+  "env": [{"name": "SPECFORGE_TOKEN", "required": true}],
+  "exit_codes": [
+    {"code": 0, "meaning": "success"},
+    {"code": 2, "meaning": "drift detected"},
+    {"code": 3, "meaning": "spec not found"}
+  ],
+  "subcommands": [
+    {
+      "name": "drift",
+      "subcommands": [
+        {
+          "name": "check",
+          "flags": [
+            {"name": "format", "shorthand": "f", "type": "string", "default": "table"},
+            {"name": "fail-on", "type": "string", "default": "breaking"},
+            {"name": "timeout", "type": "duration", "default": "30s"}
+          ],
+          "args": [{"name": "spec", "required": true}],
+          "output_formats": ["table", "json", "sarif"]
+        }
+      ]
+    },
+    {"name": "login"}
+  ]
+}`
+
+const proposedCLI = `{
+  "name": "specforge",
+  "env": [{"name": "SPECFORGE_TOKEN", "required": true}],
+  "exit_codes": [
+    {"code": 0, "meaning": "Success"},
+    {"code": 2, "meaning": "policy violation"},
+    {"code": 4, "meaning": "network error"}
+  ],
+  "subcommands": [
+    {
+      "name": "drift",
+      "subcommands": [
+        {
+          "name": "check",
+          "flags": [
+            {"name": "format", "type": "string", "default": "json"},
+            {"name": "timeout", "type": "duration", "default": "30s"},
+            {"name": "project", "type": "string", "required": true}
+          ],
+          "args": [{"name": "spec", "required": true}],
+          "output_formats": ["table", "json"]
+        }
+      ]
+    },
+    {"name": "auth"}
+  ]
+}`
+
+func input(t *testing.T, doc string) map[string]interface{} {
+	t.Helper()
+	var cmd map[string]interface{}
+	if err := json.Unmarshal([]byte(doc), &cmd); err != nil {
+		t.Fatal(err)
+	}
+	return map[string]interface{}{Key: cmd}
+}
+
+func findItem(report drift.DriftReport, t drift.DriftType, location string) *drift.DriftItem {
+	for i := range report.Items {
+		if report.Items[i].Type == t && report.Items[i].Location == location {
+			return &report.Items[i]
+		}
+	}
+	return nil
+}
+
+func TestCompare_ClassifiesChanges(t *testing.T) {
+	base, err := Parse(input(t, baselineCLI))
+	if err != nil {
+		t.Fatalf("baseline: %v", err)
+	}
+	prop, err := Parse(input(t, proposedCLI))
+	if err != nil {
+		t.Fatalf("proposed: %v", err)
+	}
+	report := Compare(base, prop)
+
+	cases := []struct {
+		driftType drift.DriftType
+		location  string
+		severity  drift.DriftSeverity
+	}{
+		{drift.FlagRemoved, "specforge drift check --fail-on", drift.Critical},
+		{drift.FlagRemoved, "specforge drift check --format", drift.Critical},
+		{drift.FlagDefaultChanged, "specforge drift check --format", drift.Breaking},
+		{drift.RequiredFieldAdded, "specforge drift check --project", drift.Breaking},
+		{drift.OutputFormatRemoved, "specforge drift check", drift.Breaking},
+		{drift.ExitCodeChanged, "specforge exit:2", drift.Breaking},
+		{drift.ExitCodeRemoved, "specforge exit:3", drift.Breaking},
+		{drift.ExitCodeAdded, "specforge exit:4", drift.Warning},
+		{drift.CommandRemoved, "specforge login", drift.Critical},
+		{drift.CommandAdded, "specforge auth", drift.Warning},
+	}
+	for _, tc := range cases {
+		item := findItem(report, tc.driftType, tc.location)
+		if item == nil {
+			t.Errorf("expected %s at %s, got %+v", tc.driftType, tc.location, report.Items)
+			continue
+		}
+		if item.Severity != tc.severity {
+			t.Errorf("expected %s at %s to be %s, got %s", tc.driftType, tc.location, tc.severity, item.Severity)
+		}
+	}
+	if findItem(report, drift.ExitCodeChanged, "specforge exit:0") != nil {
+		t.Errorf("expected case-only meaning change to be ignored")
+	}
+	if findItem(report, drift.FlagDefaultChanged, "specforge drift check --timeout") != nil {
+		t.Errorf("expected unchanged default not to be reported")
+	}
+}
+
+func TestParse_Validation(t *testing.T) {
+	bad := `{
+  "name": "tool",
+  "flags": [
+    {"name": "--count", "type": "int", "default": 1.5},
+    {"name": "out", "type": "string", "default": "x", "required": true},
+    {"name": "wait", "type": "duration", "default": "soon"}
+  ],
+  "args": [{"name": "src"}, {"name": "dst", "required": true}],
+  "env": [{"name": "lower_case"}],
+  "exit_codes": [{"code": 300, "meaning": ""}]
+}`
+	_, err := Parse(input(t, bad))
+	var vErr *ValidationError
+	if !errors.As(err, &vErr) {
+		t.Fatalf("expected ValidationError, got %v", err)
+	}
+	if len(vErr.Problems) < 8 {
+		t.Errorf("expected every problem to be reported, got %v", vErr.Problems)
+	}
+
+	if _, err := Parse(input(t, `{"name": "tool", "flags": [{"name": "x", "type": "boolean"}]}`)); err == nil {
+		t.Errorf("expected an unknown flag type without a default to be rejected")
+	}
+	if _, err := Parse(input(t, `{"name": "tool", "flags": [{"name": "x", "type": "bool", "defualt": true}]}`)); err == nil {
+		t.Errorf("expected unknown keys to be rejected")
+	}
+	if err := ValidateContract(map[string]interface{}{"type": "object"}); err != nil {
+		t.Errorf("expected contracts without a command tree to pass, got %v", err)
+	}
+	if cmd := Command(domain.ContractDefinition{ContractType: domain.CLI, InputSchema: input(t, baselineCLI)}); cmd == nil || len(cmd.Subcommands) != 2 {
+		t.Errorf("expected command tree to be extracted, got %+v", cmd)
+	}
+}
@@ -0,0 +1,256 @@
+package clispec
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+
+	"github.com/SpecForgeVC/SpecForge/internal/domain"
+	"github.com/SpecForgeVC/SpecForge/internal/domain/drift"
+)
+
+// Compare reports drift between two command trees. Locations are invocation
+// paths: "tool drift check", "tool drift check --format", "tool drift check <spec>",
+// "tool drift check $TOKEN" and "tool drift check exit:2".
+func Compare(baseline, proposed *domain.CLICommand) drift.DriftReport {
+	var items []drift.DriftItem
+	if baseline.Name != proposed.Name {
+		items = append(items, newItem(drift.CommandRemoved, baseline.Name, baseline.Name, proposed.Name,
+			fmt.Sprintf("Binary renamed from '%s' to '%s'", baseline.Name, proposed.Name)))
+	}
+	items = append(items, compareCommand(proposed.Name, baseline, proposed)...)
+	return drift.NewDriftReport(items)
+}
+
+func compareCommand(path string, base, prop *domain.CLICommand) []drift.DriftItem {
+	var items []drift.DriftItem
+	items = append(items, compareFlags(path, base.Flags, prop.Flags)...)
+	items = append(items, compareArgs(path, base.Args, prop.Args)...)
+	items = append(items, compareEnv(path, base.Env, prop.Env)...)
+	items = append(items, compareExitCodes(path, base.ExitCodes, prop.ExitCodes)...)
+
+	for _, f := range base.OutputFormats {
+		if !contains(prop.OutputFormats, f) {
+			items = append(items, newItem(drift.OutputFormatRemoved, path, f, nil, fmt.Sprintf("Output format '%s' removed from '%s'", f, path)))
+		}
+	}
+	for _, f := range prop.OutputFormats {
+		if !contains(base.OutputFormats, f) {
+			items = append(items, newItem(drift.OutputFormatAdded, path, nil, f, fmt.Sprintf("Output format '%s' added to '%s'", f, path)))
+		}
+	}
+
+	for i := range base.Subcommands {
+		bs := &base.Subcommands[i]
+		sub := path + " " + bs.Name
+		ps := findCommand(prop.Subcommands, bs.Name)
+		if ps == nil {
+			items = append(items, newItem(drift.CommandRemoved, sub, bs.Name, nil, fmt.Sprintf("Command '%s' removed", sub)))
+			continue
+		}
+		items = append(items, compareCommand(sub, bs, ps)...)
+	}
+	for _, ps := range prop.Subcommands {
+		if findCommand(base.Subcommands, ps.Name) == nil {
+			sub := path + " " + ps.Name
+			items = append(items, newItem(drift.CommandAdded, sub, nil, ps.Name, fmt.Sprintf("Command '%s' added", sub)))
+		}
+	}
+	return items
+}
+
+func compareFlags(path string, base, prop []domain.CLIFlag) []drift.DriftItem {
+	var items []drift.DriftItem
+	for _, bf := range base {
+		loc := path + " --" + bf.Name
+		pf, ok := findFlag(prop, bf.Name)
+		if !ok {
+			items = append(items, newItem(drift.FlagRemoved, loc, bf.Name, nil, fmt.Sprintf("Flag '--%s' removed", bf.Name)))
+			continue
+		}
+		if bf.Shorthand != "" && bf.Shorthand != pf.Shorthand {
+			items = append(items, newItem(drift.FlagRemoved, loc, "-"+bf.Shorthand, shorthand(pf),
+				fmt.Sprintf("Shorthand '-%s' of '--%s' removed", bf.Shorthand, bf.Name)))
+		}
+		if bf.Type != pf.Type {
+			items = append(items, newItem(drift.FieldTypeChanged, loc, string(bf.Type), string(pf.Type),
+				fmt.Sprintf("Type of '--%s' changed from %s to %s", bf.Name, bf.Type, pf.Type)))
+		} else if !reflect.DeepEqual(bf.Default, pf.Default) {
+			items = append(items, newItem(drift.FlagDefaultChanged, loc, bf.Default, pf.Default,
+				fmt.Sprintf("Default of '--%s' changed from %v to %v", bf.Name, bf.Default, pf.Default)))
+		}
+		items = append(items, compareRequired(loc, "'--"+bf.Name+"'", bf.Required, pf.Required)...)
+	}
+	for _, pf := range prop {
+		if _, ok := findFlag(base, pf.Name); ok {
+			continue
+		}
+		loc := path + " --" + pf.Name
+		if pf.Required {
+			items = append(items, newItem(drift.RequiredFieldAdded, loc, nil, pf.Name, fmt.Sprintf("Required flag '--%s' added", pf.Name)))
+			continue
+		}
+		items = append(items, newItem(drift.FieldAdded, loc, nil, pf.Name, fmt.Sprintf("Optional flag '--%s' added", pf.Name)))
+	}
+	return items
+}
+
+// compareArgs matches positional arguments by index; their names are documentation only.
+func compareArgs(path string, base, prop []domain.CLIArg) []drift.DriftItem {
+	var items []drift.DriftItem
+	for i, ba := range base {
+		loc := fmt.Sprintf("%s <%s>", path, ba.Name)
+		if i >= len(prop) {
+			items = append(items, newItem(drift.ArgumentRemoved, loc, ba.Name, nil, fmt.Sprintf("Positional argument '%s' removed", ba.Name)))
+			continue
+		}
+		pa := prop[i]
+		if ba.Name != pa.Name {
+			items = append(items, newItem(drift.MetadataChanged, loc, ba.Name, pa.Name,
+				fmt.Sprintf("Positional argument %d renamed from '%s' to '%s'", i+1, ba.Name, pa.Name)))
+		}
+		if ba.Variadic && !pa.Variadic {
+			items = append(items, newItem(drift.ConstraintTightened, loc, true, false, fmt.Sprintf("Argument '%s' no longer accepts multiple values", ba.Name)))
+		}
+		items = append(items, compareRequired(loc, "argument '"+ba.Name+"'", ba.Required, pa.Required)...)
+	}
+	for _, pa := range prop[min(len(base), len(prop)):] {
+		loc := fmt.Sprintf("%s <%s>", path, pa.Name)
+		if pa.Required {
+			items = append(items, newItem(drift.RequiredFieldAdded, loc, nil, pa.Name, fmt.Sprintf("Required positional argument '%s' added", pa.Name)))
+			continue
+		}
+		items = append(items, newItem(drift.FieldAdded, loc, nil, pa.Name, fmt.Sprintf("Optional positional argument '%s' added", pa.Name)))
+	}
+	return items
+}
+
+func compareEnv(path string, base, prop []domain.CLIEnvVar) []drift.DriftItem {
+	var items []drift.DriftItem
+	for _, be := range base {
+		loc := path + " $" + be.Name
+		pe, ok := findEnv(prop, be.Name)
+		if !ok {
+			items = append(items, newItem(drift.EnvVarRemoved, loc, be.Name, nil, fmt.Sprintf("Environment variable '%s' is no longer read", be.Name)))
+			continue
+		}
+		if be.Default != pe.Default {
+			items = append(items, newItem(drift.FlagDefaultChanged, loc, be.Default, pe.Default,
+				fmt.Sprintf("Default of '%s' changed from '%s' to '%s'", be.Name, be.Default, pe.Default)))
+		}
+		items = append(items, compareRequired(loc, "'"+be.Name+"'", be.Required, pe.Required)...)
+	}
+	for _, pe := range prop {
+		if _, ok := findEnv(base, pe.Name); ok {
+			continue
+		}
+		loc := path + " $" + pe.Name
+		if pe.Required {
+			items = append(items, newItem(drift.RequiredFieldAdded, loc, nil, pe.Name, fmt.Sprintf("Required environment variable '%s' added", pe.Name)))
+			continue
+		}
+		items = append(items, newItem(drift.FieldAdded, loc, nil, pe.Name, fmt.Sprintf("Optional environment variable '%s' added", pe.Name)))
+	}
+	return items
+}
+
+// compareExitCodes flags codes whose meaning changed: scripts branch on them.
+func compareExitCodes(path string, base, prop []domain.CLIExitCode) []drift.DriftItem {
+	var items []drift.DriftItem
+	for _, bc := range base {
+		loc := fmt.Sprintf("%s exit:%d", path, bc.Code)
+		pc, ok := findExitCode(prop, bc.Code)
+		if !ok {
+			items = append(items, newItem(drift.ExitCodeRemoved, loc, bc.Meaning, nil, fmt.Sprintf("Exit code %d (%s) removed", bc.Code, bc.Meaning)))
+			continue
+		}
+		if normalize(bc.Meaning) != normalize(pc.Meaning) {
+			items = append(items, newItem(drift.ExitCodeChanged, loc, bc.Meaning, pc.Meaning,
+				fmt.Sprintf("Exit code %d changed meaning from '%s' to '%s'", bc.Code, bc.Meaning, pc.Meaning)))
+		}
+	}
+	for _, pc := range prop {
+		if _, ok := findExitCode(base, pc.Code); !ok {
+			items = append(items, newItem(drift.ExitCodeAdded, fmt.Sprintf("%s exit:%d", path, pc.Code), nil, pc.Meaning,
+				fmt.Sprintf("Exit code %d (%s) added", pc.Code, pc.Meaning)))
+		}
+	}
+	return items
+}
+
+func compareRequired(loc, what string, base, prop bool) []drift.DriftItem {
+	switch {
+	case !base && prop:
+		return []drift.DriftItem{newItem(drift.ConstraintTightened, loc, false, true, fmt.Sprintf("%s became required", what))}
+	case base && !prop:
+		return []drift.DriftItem{newItem(drift.ConstraintLoosened, loc, true, false, fmt.Sprintf("%s became optional", what))}
+	}
+	return nil
+}
+
+func newItem(t drift.DriftType, loc string, base, prop any, desc string) drift.DriftItem {
+	return drift.DriftItem{
+		Type:        t,
+		Severity:    drift.GetSeverity(t, base, prop),
+		Location:    loc,
+		Baseline:    base,
+		Proposed:    prop,
+		Description: desc,
+	}
+}
+
+func findCommand(list []domain.CLICommand, name string) *domain.CLICommand {
+	for i := range list {
+		if list[i].Name == name {
+			return &list[i]
+		}
+	}
+	return nil
+}
+
+func findFlag(list []domain.CLIFlag, name string) (domain.CLIFlag, bool) {
+	for _, f := range list {
+		if f.Name == name {
+			return f, true
+		}
+	}
+	return domain.CLIFlag{}, false
+}
+
+func findEnv(list []domain.CLIEnvVar, name string) (domain.CLIEnvVar, bool) {
+	for _, e := range list {
+		if e.Name == name {
+			return e, true
+		}
+	}
+	return domain.CLIEnvVar{}, false
+}
+
+func findExitCode(list []domain.CLIExitCode, code int) (domain.CLIExitCode, bool) {
+	for _, c := range list {
+		if c.Code == code {
+			return c, true
+		}
+	}
+	return domain.CLIExitCode{}, false
+}
+
+func shorthand(f domain.CLIFlag) any {
+	if f.Shorthand == "" {
+		return nil
+	}
+	return "-" + f.Shorthand
+}
+
+func normalize(s string) string {
+	return strings.ToLower(strings.Join(strings.Fields(s), " "))
+}
+
+func contains(list []string, v string) bool {
+	for _, s := range list {
+		if s == v {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,233 @@
+package clispec
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"math"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/SpecForgeVC/SpecForge/internal/domain"
+)
+
+// Key is the InputSchema key under which CLI contracts store their command tree.
+const Key = "cli"
+
+// ValidationError lists every problem found in a command tree.
+type ValidationError struct {
+	Problems []string `json:"problems"`
+}
+
+func (e *ValidationError) Error() string {
+	return "invalid CLI contract: " + strings.Join(e.Problems, "; ")
+}
+
+var (
+	commandName = regexp.MustCompile(`^[a-zA-Z0-9][a-zA-Z0-9_.-]*$`)
+	flagName    = regexp.MustCompile(`^[a-zA-Z0-9][a-zA-Z0-9_-]*$`)
+	envName     = regexp.MustCompile(`^[A-Z_][A-Z0-9_]*$`)
+)
+
+// IsCLI reports whether a contract schema holds a structured CLI command tree.
+func IsCLI(schema map[string]interface{}) bool {
+	_, ok := schema[Key]
+	return ok
+}
+
+// Parse decodes and validates the command tree stored under input_schema.cli.
+// Unknown keys are rejected so typos such as "defualt" do not pass silently.
+func Parse(input map[string]interface{}) (*domain.CLICommand, error) {
+	raw, ok := input[Key].(map[string]interface{})
+	if !ok {
+		return nil, &ValidationError{Problems: []string{"input_schema.cli must be an object"}}
+	}
+	data, err := json.Marshal(raw)
+	if err != nil {
+		return nil, err
+	}
+	dec := json.NewDecoder(bytes.NewReader(data))
+	dec.DisallowUnknownFields()
+	var cmd domain.CLICommand
+	if err := dec.Decode(&cmd); err != nil {
+		return nil, &ValidationError{Problems: []string{err.Error()}}
+	}
+	if problems := Validate(&cmd); len(problems) > 0 {
+		return nil, &ValidationError{Problems: problems}
+	}
+	return &cmd, nil
+}
+
+// ValidateContract checks the input schema of a CLI contract. Contracts without
+// a command tree keep the plain JSON Schema behaviour.
+func ValidateContract(input map[string]interface{}) error {
+	if !IsCLI(input) {
+		return nil
+	}
+	_, err := Parse(input)
+	return err
+}
+
+// Command extracts the command tree of a CLI contract, or nil if it has none.
+func Command(c domain.ContractDefinition) *domain.CLICommand {
+	if c.ContractType != domain.CLI || !IsCLI(c.InputSchema) {
+		return nil
+	}
+	cmd, err := Parse(c.InputSchema)
+	if err != nil {
+		return nil
+	}
+	return cmd
+}
+
+// Validate returns every structural problem in the command tree.
+func Validate(cmd *domain.CLICommand) []string {
+	return validateCommand(cmd, "", nil)
+}
+
+func validateCommand(cmd *domain.CLICommand, parent string, problems []string) []string {
+	path := strings.TrimSpace(parent + " " + cmd.Name)
+	if !commandName.MatchString(cmd.Name) {
+		problems = append(problems, fmt.Sprintf("command %q: name must be a single word", path))
+	}
+
+	flags := make(map[string]bool)
+	shorthands := make(map[string]bool)
+	for _, f := range cmd.Flags {
+		loc := fmt.Sprintf("%s --%s", path, f.Name)
+		switch {
+		case !flagName.MatchString(f.Name):
+			problems = append(problems, fmt.Sprintf("%s: flag names must not include leading dashes or spaces", loc))
+		case flags[f.Name]:
+			problems = append(problems, fmt.Sprintf("%s: duplicate flag", loc))
+		}
+		flags[f.Name] = true
+		if f.Shorthand != "" {
+			if len(f.Shorthand) != 1 {
+				problems = append(problems, fmt.Sprintf("%s: shorthand must be a single character", loc))
+			} else if shorthands[f.Shorthand] {
+				problems = append(problems, fmt.Sprintf("%s: duplicate shorthand -%s", loc, f.Shorthand))
+			}
+			shorthands[f.Shorthand] = true
+		}
+		if err := checkDefault(f); err != "" {
+			problems = append(problems, fmt.Sprintf("%s: %s", loc, err))
+		}
+	}
+
+	args := make(map[string]bool)
+	optional := false
+	for i, a := range cmd.Args {
+		loc := fmt.Sprintf("%s <%s>", path, a.Name)
+		if a.Name == "" || args[a.Name] {
+			problems = append(problems, fmt.Sprintf("%s: argument names must be unique and non-empty", loc))
+		}
+		args[a.Name] = true
+		if a.Required && optional {
+			problems = append(problems, fmt.Sprintf("%s: required argument cannot follow an optional one", loc))
+		}
+		if a.Variadic && i != len(cmd.Args)-1 {
+			problems = append(problems, fmt.Sprintf("%s: only the last argument can be variadic", loc))
+		}
+		optional = optional || !a.Required
+	}
+
+	env := make(map[string]bool)
+	for _, e := range cmd.Env {
+		if !envName.MatchString(e.Name) {
+			problems = append(problems, fmt.Sprintf("%s $%s: environment variable names must be UPPER_SNAKE_CASE", path, e.Name))
+		} else if env[e.Name] {
+			problems = append(problems, fmt.Sprintf("%s $%s: duplicate environment variable", path, e.Name))
+		}
+		env[e.Name] = true
+	}
+
+	codes := make(map[int]bool)
+	for _, ec := range cmd.ExitCodes {
+		loc := fmt.Sprintf("%s exit %d", path, ec.Code)
+		switch {
+		case ec.Code < 0 || ec.Code > 255:
+			problems = append(problems, fmt.Sprintf("%s: exit codes must be between 0 and 255", loc))
+		case codes[ec.Code]:
+			problems = append(problems, fmt.Sprintf("%s: duplicate exit code", loc))
+		}
+		codes[ec.Code] = true
+		if strings.TrimSpace(ec.Meaning) == "" {
+			problems = append(problems, fmt.Sprintf("%s: meaning is required", loc))
+		}
+	}
+
+	formats := make(map[string]bool)
+	for _, f := range cmd.OutputFormats {
+		if f == "" || formats[f] {
+			problems = append(problems, fmt.Sprintf("command %q: output formats must be unique and non-empty", path))
+		}
+		formats[f] = true
+	}
+
+	subs := make(map[string]bool)
+	for i := range cmd.Subcommands {
+		sub := &cmd.Subcommands[i]
+		if subs[sub.Name] {
+			problems = append(problems, fmt.Sprintf("command %q: duplicate subcommand %q", path, sub.Name))
+		}
+		subs[sub.Name] = true
+		problems = validateCommand(sub, path, problems)
+	}
+	return problems
+}
+
+// checkDefault verifies a flag's type and that its default value matches it.
+// Defaults arrive JSON-decoded, so numbers are float64 and arrays []interface{}.
+func checkDefault(f domain.CLIFlag) string {
+	switch f.Type {
+	case "":
+		return "type is required"
+	case domain.CLIFlagString, domain.CLIFlagInt, domain.CLIFlagFloat, domain.CLIFlagBool,
+		domain.CLIFlagDuration, domain.CLIFlagStringArray, domain.CLIFlagIntArray:
+	default:
+		return fmt.Sprintf("unknown flag type %q", f.Type)
+	}
+	if f.Default == nil {
+		return ""
+	}
+	if f.Required {
+		return "required flags cannot declare a default"
+	}
+	ok := false
+	switch f.Type {
+	case domain.CLIFlagString:
+		_, ok = f.Default.(string)
+	case domain.CLIFlagInt:
+		ok = isInt(f.Default)
+	case domain.CLIFlagFloat:
+		_, ok = f.Default.(float64)
+	case domain.CLIFlagBool:
+		_, ok = f.Default.(bool)
+	case domain.CLIFlagDuration:
+		s, isString := f.Default.(string)
+		_, err := time.ParseDuration(s)
+		ok = isString && err == nil
+	case domain.CLIFlagStringArray, domain.CLIFlagIntArray:
+		list, isList := f.Default.([]interface{})
+		ok = isList
+		for _, v := range list {
+			if f.Type == domain.CLIFlagIntArray {
+				ok = ok && isInt(v)
+			} else {
+				_, isString := v.(string)
+				ok = ok && isString
+			}
+		}
+	}
+	if !ok {
+		return fmt.Sprintf("default %v does not match type %s", f.Default, f.Type)
+	}
+	return ""
+}
+
+func isInt(v interface{}) bool {
+	n, ok := v.(float64)
+	return ok && n == math.Trunc(n)
+}
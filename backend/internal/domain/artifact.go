@@ -3,6 +3,7 @@ package domain
 import (
 	"time"
 
+	"github.com/SpecForgeVC/SpecForge/internal/payload"
 	"github.com/google/uuid"
 )
 
@@ -57,6 +58,25 @@ type ContractBundle struct {
 	Version      string                 `json:"version"`
 	InputSchema  map[string]interface{} `json:"input_schema"`
 	OutputSchema map[string]interface{} `json:"output_schema"`
+	Channels     []EventChannel         `json:"channels,omitempty"`
+	CLI          *CLICommand            `json:"cli,omitempty"`
+}
+
+// EventChannel is a channel (topic/queue) extracted from an AsyncAPI EVENT contract.
+// Operations lists what the application does on it ("send"/"receive"); AsyncAPI
+// 2.x publish/subscribe operations are mapped to receive/send.
+type EventChannel struct {
+	Name       string         `json:"name"`
+	ID         string         `json:"id,omitempty"`
+	Operations []string       `json:"operations"`
+	Messages   []EventMessage `json:"messages"`
+}
+
+type EventMessage struct {
+	Name        string                 `json:"name"`
+	ContentType string                 `json:"content_type,omitempty"`
+	Payload     map[string]interface{} `json:"payload,omitempty"`
+	Headers     map[string]interface{} `json:"headers,omitempty"`
 }
 
 type SchemaBundle struct {
@@ -98,9 +118,14 @@ type AcceptanceCriteria struct {
 	Description string `json:"description"`
 }
 
+// TestSpecification is one test the implementation must carry. Payload
+// sample specifications also name the contract and kind the samples belong to.
 type TestSpecification struct {
-	Type        string `json:"type"`
-	Instruction string `json:"instruction"`
+	Type        string            `json:"type"`
+	Instruction string            `json:"instruction"`
+	ContractID  string            `json:"contract_id,omitempty"`
+	Kind        PayloadKind       `json:"kind,omitempty"`
+	Samples     *payload.Examples `json:"samples,omitempty"`
 }
 
 type BuildPromptBundle struct {
@@ -0,0 +1,60 @@
+package domain
+
+// CLICommand describes a command-line interface contract. The root command is
+// the binary itself; subcommands nest recursively ("tool drift check").
+// CLI contracts store it under input_schema.cli.
+type CLICommand struct {
+	Name          string        `json:"name"`
+	Description   string        `json:"description,omitempty"`
+	Flags         []CLIFlag     `json:"flags,omitempty"`
+	Args          []CLIArg      `json:"args,omitempty"`
+	Env           []CLIEnvVar   `json:"env,omitempty"`
+	ExitCodes     []CLIExitCode `json:"exit_codes,omitempty"`
+	OutputFormats []string      `json:"output_formats,omitempty"`
+	Subcommands   []CLICommand  `json:"subcommands,omitempty"`
+}
+
+// CLIFlag is a named option. Type is one of the CLIFlagType values; Default
+// must match it and is not allowed on required flags.
+type CLIFlag struct {
+	Name        string      `json:"name"`
+	Shorthand   string      `json:"shorthand,omitempty"`
+	Type        CLIFlagType `json:"type"`
+	Default     interface{} `json:"default,omitempty"`
+	Required    bool        `json:"required,omitempty"`
+	Description string      `json:"description,omitempty"`
+}
+
+type CLIFlagType string
+
+const (
+	CLIFlagString      CLIFlagType = "string"
+	CLIFlagInt         CLIFlagType = "int"
+	CLIFlagFloat       CLIFlagType = "float"
+	CLIFlagBool        CLIFlagType = "bool"
+	CLIFlagDuration    CLIFlagType = "duration"
+	CLIFlagStringArray CLIFlagType = "string_array"
+	CLIFlagIntArray    CLIFlagType = "int_array"
+)
+
+// CLIArg is a positional argument. Positional arguments are matched by index.
+type CLIArg struct {
+	Name        string `json:"name"`
+	Required    bool   `json:"required,omitempty"`
+	Variadic    bool   `json:"variadic,omitempty"`
+	Description string `json:"description,omitempty"`
+}
+
+// CLIEnvVar is an environment variable read by the command.
+type CLIEnvVar struct {
+	Name        string `json:"name"`
+	Required    bool   `json:"required,omitempty"`
+	Default     string `json:"default,omitempty"`
+	Description string `json:"description,omitempty"`
+}
+
+// CLIExitCode documents what a process exit status means.
+type CLIExitCode struct {
+	Code    int    `json:"code"`
+	Meaning string `json:"meaning"`
+}
@@ -0,0 +1,26 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/SpecForgeVC/SpecForge/internal/schemalint"
+	"github.com/google/uuid"
+)
+
+// ProjectSchemaLintRuleset is the lint ruleset a project applies to contract
+// schemas when they are saved.
+type ProjectSchemaLintRuleset struct {
+	ProjectID uuid.UUID          `json:"project_id"`
+	Ruleset   schemalint.Ruleset `json:"ruleset"`
+	UpdatedBy uuid.UUID          `json:"updated_by,omitempty"`
+	UpdatedAt time.Time          `json:"updated_at"`
+}
+
+// ContractLint is the lint report of a contract's schemas. ContractID is nil
+// for drafts linted before they are saved.
+type ContractLint struct {
+	ContractID *uuid.UUID        `json:"contract_id,omitempty"`
+	ProjectID  uuid.UUID         `json:"project_id"`
+	Version    string            `json:"version,omitempty"`
+	Report     schemalint.Report `json:"report"`
+}
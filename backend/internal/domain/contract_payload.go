@@ -0,0 +1,63 @@
+package domain
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/SpecForgeVC/SpecForge/internal/payload"
+	"github.com/google/uuid"
+)
+
+// PayloadKind selects which of a contract's schemas a payload is checked against.
+type PayloadKind string
+
+const (
+	PayloadInput  PayloadKind = "INPUT"
+	PayloadOutput PayloadKind = "OUTPUT"
+	PayloadError  PayloadKind = "ERROR"
+)
+
+// ParsePayloadKind accepts a kind in any case.
+func ParsePayloadKind(s string) (PayloadKind, error) {
+	switch k := PayloadKind(strings.ToUpper(strings.TrimSpace(s))); k {
+	case PayloadInput, PayloadOutput, PayloadError:
+		return k, nil
+	}
+	return "", fmt.Errorf("payload kind must be one of input, output, error; got %q", s)
+}
+
+// Schema returns the revision's schema for the kind.
+func (r ContractRevision) Schema(kind PayloadKind) map[string]interface{} {
+	switch kind {
+	case PayloadInput:
+		return r.InputSchema
+	case PayloadOutput:
+		return r.OutputSchema
+	case PayloadError:
+		return r.ErrorSchema
+	}
+	return nil
+}
+
+// PayloadValidation reports whether each payload of a batch is a valid
+// input, output or error of one contract revision.
+type PayloadValidation struct {
+	ContractID uuid.UUID        `json:"contract_id"`
+	Revision   int              `json:"revision"`
+	Version    string           `json:"version"`
+	Kind       PayloadKind      `json:"kind"`
+	Valid      bool             `json:"valid"`
+	Invalid    int              `json:"invalid_count"`
+	Results    []payload.Result `json:"results"`
+}
+
+// ContractExamples are the payload samples generated from one contract
+// revision, keyed by kind. Kinds without a JSON schema to generate from are
+// listed in Unavailable with the reason.
+type ContractExamples struct {
+	ContractID  uuid.UUID                         `json:"contract_id"`
+	Revision    int                               `json:"revision"`
+	Version     string                            `json:"version"`
+	Samples     map[PayloadKind]*payload.Examples `json:"samples"`
+	Unavailable map[PayloadKind]string            `json:"unavailable,omitempty"`
+}
@@ -0,0 +1,174 @@
+package domain
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/SpecForgeVC/SpecForge/internal/domain/drift"
+	"github.com/google/uuid"
+)
+
+// VersionBump classifies the difference between two semantic versions,
+// or the minimum difference a set of changes requires.
+type VersionBump string
+
+const (
+	VersionBumpNone  VersionBump = "NONE"
+	VersionBumpPatch VersionBump = "PATCH"
+	VersionBumpMinor VersionBump = "MINOR"
+	VersionBumpMajor VersionBump = "MAJOR"
+)
+
+var versionBumpRank = map[VersionBump]int{
+	VersionBumpNone:  0,
+	VersionBumpPatch: 1,
+	VersionBumpMinor: 2,
+	VersionBumpMajor: 3,
+}
+
+// Satisfies reports whether b is at least as large as required.
+func (b VersionBump) Satisfies(required VersionBump) bool {
+	return versionBumpRank[b] >= versionBumpRank[required]
+}
+
+// ContractRevision is an immutable copy of a contract as it was saved.
+// A new revision is appended on every create and update.
+type ContractRevision struct {
+	ID               uuid.UUID              `json:"id"`
+	ContractID       uuid.UUID              `json:"contract_id"`
+	Revision         int                    `json:"revision"`
+	ContractType     ContractType           `json:"contract_type"`
+	Version          string                 `json:"version"`
+	InputSchema      map[string]interface{} `json:"input_schema"`
+	OutputSchema     map[string]interface{} `json:"output_schema"`
+	ErrorSchema      map[string]interface{} `json:"error_schema"`
+	DeprecatedFields []string               `json:"deprecated_fields"`
+	Bump             VersionBump            `json:"bump"`
+	CreatedBy        uuid.UUID              `json:"created_by,omitempty"`
+	CreatedAt        time.Time              `json:"created_at"`
+}
+
+// ContractRevisionDiff is the drift between two revisions of the same contract.
+type ContractRevisionDiff struct {
+	ContractID   uuid.UUID         `json:"contract_id"`
+	From         ContractRevision  `json:"from"`
+	To           ContractRevision  `json:"to"`
+	RequiredBump VersionBump       `json:"required_bump"`
+	ActualBump   VersionBump       `json:"actual_bump"`
+	Report       drift.DriftReport `json:"report"`
+}
+
+// SemVer is a parsed MAJOR.MINOR.PATCH version. A leading "v" and missing
+// minor/patch components are accepted ("v2", "1.4"); build metadata is ignored.
+type SemVer struct {
+	Major      int
+	Minor      int
+	Patch      int
+	PreRelease string
+}
+
+func ParseSemVer(v string) (SemVer, error) {
+	raw := strings.TrimPrefix(strings.TrimSpace(v), "v")
+	if i := strings.Index(raw, "+"); i >= 0 {
+		raw = raw[:i]
+	}
+	var sv SemVer
+	if i := strings.Index(raw, "-"); i >= 0 {
+		sv.PreRelease = raw[i+1:]
+		raw = raw[:i]
+	}
+
+	parts := strings.Split(raw, ".")
+	if raw == "" || len(parts) > 3 {
+		return SemVer{}, fmt.Errorf("invalid semantic version %q", v)
+	}
+	nums := []*int{&sv.Major, &sv.Minor, &sv.Patch}
+	for i, p := range parts {
+		n, err := strconv.Atoi(p)
+		if err != nil || n < 0 {
+			return SemVer{}, fmt.Errorf("invalid semantic version %q", v)
+		}
+		*nums[i] = n
+	}
+	return sv, nil
+}
+
+func (v SemVer) String() string {
+	s := fmt.Sprintf("%d.%d.%d", v.Major, v.Minor, v.Patch)
+	if v.PreRelease != "" {
+		s += "-" + v.PreRelease
+	}
+	return s
+}
+
+// Compare returns -1, 0 or 1. A pre-release sorts before its release.
+func (v SemVer) Compare(o SemVer) int {
+	for _, d := range []int{v.Major - o.Major, v.Minor - o.Minor, v.Patch - o.Patch} {
+		if d < 0 {
+			return -1
+		}
+		if d > 0 {
+			return 1
+		}
+	}
+	switch {
+	case v.PreRelease == o.PreRelease:
+		return 0
+	case v.PreRelease == "":
+		return 1
+	case o.PreRelease == "":
+		return -1
+	case v.PreRelease < o.PreRelease:
+		return -1
+	default:
+		return 1
+	}
+}
+
+// BumpTo returns which component changed going from v to next.
+// It does not check direction; callers compare first.
+func (v SemVer) BumpTo(next SemVer) VersionBump {
+	switch {
+	case next.Major != v.Major:
+		return VersionBumpMajor
+	case next.Minor != v.Minor:
+		return VersionBumpMinor
+	case next.Patch != v.Patch || next.PreRelease != v.PreRelease:
+		return VersionBumpPatch
+	default:
+		return VersionBumpNone
+	}
+}
+
+// Bump returns the version after a bump of size b, resetting the lower
+// components and any pre-release. VersionBumpNone returns v unchanged.
+func (v SemVer) Bump(b VersionBump) SemVer {
+	switch b {
+	case VersionBumpMajor:
+		return SemVer{Major: v.Major + 1}
+	case VersionBumpMinor:
+		return SemVer{Major: v.Major, Minor: v.Minor + 1}
+	case VersionBumpPatch:
+		return SemVer{Major: v.Major, Minor: v.Minor, Patch: v.Patch + 1}
+	default:
+		return v
+	}
+}
+
+// RequiredBump maps a drift report onto the smallest acceptable version bump:
+// breaking or critical drift needs a major release, additive drift (warnings)
+// at least a minor one, and metadata-only drift a patch.
+func RequiredBump(report drift.DriftReport) VersionBump {
+	switch {
+	case report.BreakingChanges > 0 || report.CriticalChanges > 0:
+		return VersionBumpMajor
+	case report.Warnings > 0:
+		return VersionBumpMinor
+	case report.Infos > 0:
+		return VersionBumpPatch
+	default:
+		return VersionBumpNone
+	}
+}
@@ -11,7 +11,7 @@ func DetectDrift(input DriftInput) DriftReport {
 	// 1. Compare Paths
 	items = append(items, ComparePaths(input.Baseline.Paths, input.Proposed.Paths)...)
 
-	// 2. Compare Components (Schemas only for now as requested)
+	// 2. Compare Components (schemas and security schemes)
 	items = append(items, compareComponents(input.Baseline.Components, input.Proposed.Components)...)
 
 	return NewDriftReport(items)
@@ -36,5 +36,7 @@ func compareComponents(baseline, proposed Components) []DriftItem {
 		}
 	}
 
+	items = append(items, compareSecuritySchemes(baseline.SecuritySchemes, proposed.SecuritySchemes)...)
+
 	return items
 }
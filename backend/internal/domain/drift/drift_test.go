@@ -2,6 +2,7 @@ package drift
 
 import (
 	"testing"
+	"time"
 )
 
 func TestDetectDrift_RequiredFieldRemoval(t *testing.T) {
@@ -193,3 +194,231 @@ func TestDriftPolicy_Evaluate(t *testing.T) {
 		t.Errorf("Expected report NOT to be blocked for warnings only")
 	}
 }
+
+func TestDriftPolicy_SeverityOverridesAndWaivers(t *testing.T) {
+	now := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	past := now.Add(-time.Hour)
+	future := now.Add(24 * time.Hour)
+
+	newReport := func() DriftReport {
+		return NewDriftReport([]DriftItem{
+			newDriftItem(EnumValueRemoved, "/paths:/internal/jobs:get:responses:200:application/json.status", "queued", nil, "enum value removed"),
+			newDriftItem(EnumValueRemoved, "/paths:/public/jobs:get:responses:200:application/json.status", "queued", nil, "enum value removed"),
+			newDriftItem(FieldAdded, "/components/schemas/Job", nil, "priority", "field added"),
+		})
+	}
+
+	policy := DriftPolicy{
+		BlockOnBreaking: true,
+		BlockOnCritical: true,
+		SeverityOverrides: map[DriftType]DriftSeverity{
+			FieldAdded: Info,
+		},
+		Waivers: []Waiver{
+			{ID: "internal-enums", DriftType: EnumValueRemoved, Path: "/internal/*", Justification: "internal only", Owner: "platform", ExpiresAt: &future},
+		},
+	}
+
+	report := newReport()
+	policy.EvaluateAt(&report, now)
+	if report.Infos != 1 || report.Warnings != 0 {
+		t.Errorf("expected FIELD_ADDED to be overridden to INFO, got infos=%d warnings=%d", report.Infos, report.Warnings)
+	}
+	if len(report.AppliedWaivers) != 1 || report.AppliedWaivers[0].WaiverID != "internal-enums" {
+		t.Fatalf("expected internal-enums waiver to be applied once, got %+v", report.AppliedWaivers)
+	}
+	if !report.Blocked {
+		t.Errorf("expected report to stay blocked by the unwaived /public removal")
+	}
+
+	policy.Waivers = append(policy.Waivers, Waiver{ID: "public-enums", DriftType: EnumValueRemoved, Path: "/public/jobs", Justification: "migration", Owner: "api-team", ExpiresAt: &future})
+	report = newReport()
+	policy.EvaluateAt(&report, now)
+	if report.Blocked {
+		t.Errorf("expected report not to be blocked once every critical item is waived")
+	}
+
+	for i := range policy.Waivers {
+		policy.Waivers[i].ExpiresAt = &past
+	}
+	report = newReport()
+	policy.EvaluateAt(&report, now)
+	if len(report.AppliedWaivers) != 0 || !report.Blocked {
+		t.Errorf("expected expired waivers to be ignored, got %+v blocked=%v", report.AppliedWaivers, report.Blocked)
+	}
+}
+
+func TestDriftPolicy_PathLevelWaivers(t *testing.T) {
+	now := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	report := NewDriftReport([]DriftItem{
+		newDriftItem(PathRemoved, "/paths", "/internal/jobs", nil, "Path '/internal/jobs' removed"),
+		newDriftItem(NewPath, "/paths", nil, "/internal/tasks", "New path '/internal/tasks' added"),
+		newDriftItem(PathRemoved, "/paths", "/public/jobs", nil, "Path '/public/jobs' removed"),
+	})
+	policy := DriftPolicy{
+		BlockOnCritical: true,
+		Waivers: []Waiver{
+			{ID: "internal", Path: "/internal/*", Justification: "internal only", Owner: "platform"},
+		},
+	}
+
+	policy.EvaluateAt(&report, now)
+	if len(report.AppliedWaivers) != 2 {
+		t.Fatalf("expected both /internal path items to be waived, got %+v", report.AppliedWaivers)
+	}
+	for _, item := range report.Items {
+		if want := item.Baseline != "/public/jobs"; item.Waived != want {
+			t.Errorf("item %v/%v: waived=%v, want %v", item.Baseline, item.Proposed, item.Waived, want)
+		}
+	}
+	if !report.Blocked {
+		t.Errorf("expected the unwaived /public/jobs removal to keep the report blocked")
+	}
+}
+
+func TestDriftPolicy_Validate(t *testing.T) {
+	if err := (DriftPolicy{SeverityOverrides: map[DriftType]DriftSeverity{FieldAdded: "LOW"}}).Validate(); err == nil {
+		t.Error("expected unknown severity to be rejected")
+	}
+	if err := (DriftPolicy{Waivers: []Waiver{{Path: "/internal/*", Owner: "platform"}}}).Validate(); err == nil {
+		t.Error("expected waiver without justification to be rejected")
+	}
+}
+
+func securityDoc(security []SecurityRequirement, schemes map[string]SecurityScheme) OpenAPIDocument {
+	return OpenAPIDocument{
+		Paths: map[string]PathItem{
+			"/orders": {Operations: map[string]Operation{
+				"get": {Responses: map[string]Response{}, Security: security},
+			}},
+		},
+		Components: Components{SecuritySchemes: schemes},
+	}
+}
+
+func findDrift(report DriftReport, t DriftType) *DriftItem {
+	for i := range report.Items {
+		if report.Items[i].Type == t {
+			return &report.Items[i]
+		}
+	}
+	return nil
+}
+
+func TestDetectDrift_SecurityAuthRemoved(t *testing.T) {
+	schemes := map[string]SecurityScheme{"oauth": {Type: "oauth2"}}
+	baseline := securityDoc([]SecurityRequirement{{"oauth": {"orders:read"}}}, schemes)
+	proposed := securityDoc([]SecurityRequirement{}, schemes)
+
+	report := DetectDrift(DriftInput{Baseline: baseline, Proposed: proposed})
+	item := findDrift(report, SecurityAuthRemoved)
+	if item == nil || item.Severity != Critical {
+		t.Fatalf("expected critical SECURITY_AUTH_REMOVED, got %+v", report.Items)
+	}
+	if len(report.Items) != 1 {
+		t.Errorf("expected the auth removal to be the only item, got %+v", report.Items)
+	}
+
+	// An optional-auth alternative ({}) also makes the operation anonymous.
+	proposed = securityDoc([]SecurityRequirement{{}, {"oauth": {"orders:read"}}}, schemes)
+	report = DetectDrift(DriftInput{Baseline: baseline, Proposed: proposed})
+	if findDrift(report, SecurityAuthRemoved) == nil {
+		t.Errorf("expected empty requirement alternative to be reported as auth removal, got %+v", report.Items)
+	}
+}
+
+func TestDetectDrift_SecurityScopes(t *testing.T) {
+	schemes := map[string]SecurityScheme{"oauth": {Type: "oauth2"}}
+	baseline := securityDoc([]SecurityRequirement{{"oauth": {"orders:read", "orders:write"}}}, schemes)
+	proposed := securityDoc([]SecurityRequirement{{"oauth": {"orders:admin", "orders:read"}}}, schemes)
+
+	report := DetectDrift(DriftInput{Baseline: baseline, Proposed: proposed})
+	if item := findDrift(report, SecurityScopeAdded); item == nil || item.Proposed != "orders:admin" || item.Severity != Breaking {
+		t.Errorf("expected breaking scope addition for orders:admin, got %+v", item)
+	}
+	if item := findDrift(report, SecurityScopeRemoved); item == nil || item.Baseline != "orders:write" || item.Severity != Warning {
+		t.Errorf("expected scope removal warning for orders:write, got %+v", item)
+	}
+}
+
+func TestDetectDrift_SecurityRequirementChanges(t *testing.T) {
+	schemes := map[string]SecurityScheme{"apiKey": {Type: "apiKey", In: "header", Name: "X-Key"}, "oauth": {Type: "oauth2"}}
+	baseline := securityDoc([]SecurityRequirement{{"apiKey": {}}}, schemes)
+	proposed := securityDoc([]SecurityRequirement{{"apiKey": {}, "oauth": {}}}, schemes)
+
+	report := DetectDrift(DriftInput{Baseline: baseline, Proposed: proposed})
+	if item := findDrift(report, SecurityTightened); item == nil || item.Proposed != "oauth" {
+		t.Errorf("expected oauth to be reported as newly required, got %+v", report.Items)
+	}
+	if findDrift(report, SecurityRequirementRemoved) != nil {
+		t.Errorf("expected a scheme addition, not a requirement removal")
+	}
+
+	proposed = securityDoc([]SecurityRequirement{{"oauth": {}}}, schemes)
+	report = DetectDrift(DriftInput{Baseline: baseline, Proposed: proposed})
+	if findDrift(report, SecurityRequirementRemoved) == nil || findDrift(report, SecurityRequirementAdded) == nil {
+		t.Errorf("expected requirement replacement to report removal and addition, got %+v", report.Items)
+	}
+}
+
+func TestDetectDrift_SecuritySchemeComponents(t *testing.T) {
+	baseline := securityDoc(nil, map[string]SecurityScheme{
+		"apiKey": {Type: "apiKey", In: "header", Name: "X-Key"},
+		"oauth":  {Type: "oauth2"},
+		"legacy": {Type: "http", Scheme: "bearer"},
+	})
+	proposed := securityDoc(nil, map[string]SecurityScheme{
+		"apiKey": {Type: "apiKey", In: "query", Name: "key"},
+		"oauth":  {Type: "http", Scheme: "basic"},
+	})
+
+	report := DetectDrift(DriftInput{Baseline: baseline, Proposed: proposed})
+	if item := findDrift(report, SecuritySchemeChanged); item == nil || item.Location != "/components/securitySchemes/apiKey" {
+		t.Errorf("expected apiKey location change, got %+v", item)
+	}
+	if item := findDrift(report, SecuritySchemeDowngraded); item == nil || item.Severity != Critical {
+		t.Errorf("expected critical downgrade of oauth to basic auth, got %+v", item)
+	}
+	if item := findDrift(report, SecuritySchemeRemoved); item == nil || item.Baseline != "legacy" {
+		t.Errorf("expected legacy scheme removal, got %+v", item)
+	}
+}
+
+func TestCompareSchemaDirection_Constraints(t *testing.T) {
+	minBase, minProp := 1.0, 10.0
+	itemsBase, itemsProp := 5, 3
+	baseline := Schema{Type: "object", Properties: map[string]*Schema{
+		"count": {Type: "number", Minimum: &minBase},
+		"tags":  {Type: "array", MaxItems: &itemsBase},
+	}}
+	proposed := Schema{Type: "object", AllOf: []*Schema{{Type: "object"}}, Properties: map[string]*Schema{
+		"count": {Type: "number", Minimum: &minProp},
+		"tags":  {Type: "array", MaxItems: &itemsProp},
+	}}
+
+	for _, tc := range []struct {
+		dir  Direction
+		want DriftSeverity
+	}{
+		{RequestDirection, Breaking},
+		{ResponseDirection, Info},
+		{BothDirections, Breaking},
+	} {
+		items := CompareSchemaDirection(baseline, proposed, "body", tc.dir)
+		want := map[string]bool{"body/properties/count/minimum": false, "body/properties/tags/maxItems": false, "body/allOf": false}
+		for _, item := range items {
+			if _, ok := want[item.Location]; !ok {
+				continue
+			}
+			want[item.Location] = true
+			if item.Type != ConstraintTightened || item.Severity != tc.want {
+				t.Errorf("%s %s: got %s/%s, want %s/%s", tc.dir, item.Location, item.Type, item.Severity, ConstraintTightened, tc.want)
+			}
+		}
+		for loc, found := range want {
+			if !found {
+				t.Errorf("%s: missing drift at %s", tc.dir, loc)
+			}
+		}
+	}
+}
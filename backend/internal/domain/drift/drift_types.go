@@ -25,9 +25,45 @@ const (
 	FieldAdded          DriftType = "FIELD_ADDED"
 
 	// Security Drift
-	SecuritySchemeRemoved DriftType = "SECURITY_SCHEME_REMOVED"
-	SecurityLoosened      DriftType = "SECURITY_LOOSENED"
-	SecurityTightened     DriftType = "SECURITY_TIGHTENED"
+	SecuritySchemeRemoved      DriftType = "SECURITY_SCHEME_REMOVED"
+	SecuritySchemeAdded        DriftType = "SECURITY_SCHEME_ADDED"
+	SecuritySchemeChanged      DriftType = "SECURITY_SCHEME_CHANGED"
+	SecuritySchemeDowngraded   DriftType = "SECURITY_SCHEME_DOWNGRADED"
+	SecurityLoosened           DriftType = "SECURITY_LOOSENED"
+	SecurityTightened          DriftType = "SECURITY_TIGHTENED"
+	SecurityAuthRemoved        DriftType = "SECURITY_AUTH_REMOVED"
+	SecurityRequirementRemoved DriftType = "SECURITY_REQUIREMENT_REMOVED"
+	SecurityRequirementAdded   DriftType = "SECURITY_REQUIREMENT_ADDED"
+	SecurityScopeAdded         DriftType = "SECURITY_SCOPE_ADDED"
+	SecurityScopeRemoved       DriftType = "SECURITY_SCOPE_REMOVED"
+
+	// GraphQL Drift
+	TypeRemoved         DriftType = "TYPE_REMOVED"
+	TypeAdded           DriftType = "TYPE_ADDED"
+	FieldRemoved        DriftType = "FIELD_REMOVED"
+	ArgumentRemoved     DriftType = "ARGUMENT_REMOVED"
+	ArgumentMadeNonNull DriftType = "ARGUMENT_MADE_NON_NULL"
+	DeprecationAdded    DriftType = "DEPRECATION_ADDED"
+
+	// Event (AsyncAPI) Drift
+	ChannelRemoved      DriftType = "CHANNEL_REMOVED"
+	ChannelAdded        DriftType = "CHANNEL_ADDED"
+	ChannelRenamed      DriftType = "CHANNEL_RENAMED"
+	MessageRemoved      DriftType = "MESSAGE_REMOVED"
+	MessageAdded        DriftType = "MESSAGE_ADDED"
+	RequiredHeaderAdded DriftType = "REQUIRED_HEADER_ADDED"
+
+	// CLI Drift
+	CommandRemoved      DriftType = "COMMAND_REMOVED"
+	CommandAdded        DriftType = "COMMAND_ADDED"
+	FlagRemoved         DriftType = "FLAG_REMOVED"
+	FlagDefaultChanged  DriftType = "FLAG_DEFAULT_CHANGED"
+	EnvVarRemoved       DriftType = "ENV_VAR_REMOVED"
+	ExitCodeRemoved     DriftType = "EXIT_CODE_REMOVED"
+	ExitCodeChanged     DriftType = "EXIT_CODE_CHANGED"
+	ExitCodeAdded       DriftType = "EXIT_CODE_ADDED"
+	OutputFormatRemoved DriftType = "OUTPUT_FORMAT_REMOVED"
+	OutputFormatAdded   DriftType = "OUTPUT_FORMAT_ADDED"
 
 	// Metadata Drift
 	MetadataChanged DriftType = "METADATA_CHANGED"
@@ -86,17 +122,21 @@ type Schema struct {
 	Enum                 []any
 	Nullable             bool
 	AdditionalProperties *Schema
-	MinLength            *int
-	MaxLength            *int
-	MinItems             *int
-	MaxItems             *int
-	Minimum              *float64
-	Maximum              *float64
-	OneOf                []*Schema
-	AnyOf                []*Schema
-	AllOf                []*Schema
-	Description          string
-	Default              any
+	// AdditionalPropertiesDenied is set for `additionalProperties: false`.
+	AdditionalPropertiesDenied bool
+	MinLength                  *int
+	MaxLength                  *int
+	MinItems                   *int
+	MaxItems                   *int
+	Minimum                    *float64
+	Maximum                    *float64
+	Pattern                    string
+	OneOf                      []*Schema
+	AnyOf                      []*Schema
+	AllOf                      []*Schema
+	Description                string
+	Default                    any
+	Ref                        string // set only for circular $refs left unexpanded by the loader
 }
 
 // Components represents the components section of an OpenAPI document.
@@ -109,8 +149,10 @@ type Components struct {
 type SecurityRequirement map[string][]string
 
 // SecurityScheme represents a security scheme definition.
+// Scheme is the HTTP authentication scheme (e.g. "bearer", "basic") for type "http".
 type SecurityScheme struct {
-	Type string
-	In   string
-	Name string
+	Type   string
+	Scheme string
+	In     string
+	Name   string
 }
@@ -95,21 +95,7 @@ func compareResponse(location string, baseline, proposed Response) []DriftItem {
 			items = append(items, newDriftItem(RequiredFieldRemoved, location, contentType, nil, fmt.Sprintf("Content type '%s' removed from response", contentType)))
 			continue
 		}
-		items = append(items, CompareSchema(baseMT.Schema, propMT.Schema, location+":"+contentType)...)
-	}
-	return items
-}
-
-func compareSecurity(location string, baseline, proposed []SecurityRequirement) []DriftItem {
-	var items []DriftItem
-	// Simplified security comparison: check if security requirements were loosened or tightened.
-	if len(baseline) > 0 && len(proposed) == 0 {
-		items = append(items, newDriftItem(SecurityLoosened, location, baseline, nil, "Security requirements removed"))
-	} else if len(baseline) == 0 && len(proposed) > 0 {
-		items = append(items, newDriftItem(SecurityTightened, location, nil, proposed, "Security requirements added"))
-	} else if len(baseline) != len(proposed) {
-		// More complex logic could be added here to compare specific requirements
-		items = append(items, newDriftItem(SecurityLoosened, location, baseline, proposed, "Security requirements changed"))
+		items = append(items, CompareSchemaDirection(baseMT.Schema, propMT.Schema, location+":"+contentType, ResponseDirection)...)
 	}
 	return items
 }
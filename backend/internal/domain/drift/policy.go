@@ -1,24 +1,178 @@
 package drift
 
+import (
+	"fmt"
+	"regexp"
+	"strings"
+	"time"
+)
+
 // DriftPolicy defines the rules for blocking based on drift severity.
 type DriftPolicy struct {
-	BlockOnBreaking bool
-	BlockOnCritical bool
+	BlockOnBreaking bool `json:"block_on_breaking"`
+	BlockOnCritical bool `json:"block_on_critical"`
+
+	// SeverityOverrides replaces the default severity of a drift type.
+	SeverityOverrides map[DriftType]DriftSeverity `json:"severity_overrides,omitempty"`
+	// Waivers exempt matching drift items from blocking until they expire.
+	Waivers []Waiver `json:"waivers,omitempty"`
+}
+
+// Waiver allows a drift type on the paths matching a glob. In Path, '*' matches
+// any run of characters (including '/') and '?' a single character, so
+// "/internal/*" covers every path below /internal/. An empty DriftType waives
+// all drift on the matching paths.
+type Waiver struct {
+	ID            string     `json:"id"`
+	DriftType     DriftType  `json:"drift_type,omitempty"`
+	Path          string     `json:"path"`
+	Justification string     `json:"justification"`
+	Owner         string     `json:"owner"`
+	ExpiresAt     *time.Time `json:"expires_at,omitempty"`
+}
+
+// AppliedWaiver records a waiver that exempted a drift item during evaluation.
+type AppliedWaiver struct {
+	WaiverID      string     `json:"waiver_id"`
+	DriftType     DriftType  `json:"drift_type"`
+	Location      string     `json:"location"`
+	Justification string     `json:"justification"`
+	Owner         string     `json:"owner"`
+	ExpiresAt     *time.Time `json:"expires_at,omitempty"`
+}
+
+// Validate checks that overrides name known severities and waivers are complete.
+func (p DriftPolicy) Validate() error {
+	for t, sev := range p.SeverityOverrides {
+		switch sev {
+		case Info, Warning, Breaking, Critical:
+		default:
+			return fmt.Errorf("severity override for %s: unknown severity %q", t, sev)
+		}
+	}
+	for i, w := range p.Waivers {
+		if w.Path == "" {
+			return fmt.Errorf("waiver %d: path is required", i)
+		}
+		if strings.TrimSpace(w.Justification) == "" {
+			return fmt.Errorf("waiver %d: justification is required", i)
+		}
+		if strings.TrimSpace(w.Owner) == "" {
+			return fmt.Errorf("waiver %d: owner is required", i)
+		}
+	}
+	return nil
 }
 
 // Evaluate applies the policy to a DriftReport and determines if it should be blocked.
 func (p DriftPolicy) Evaluate(report *DriftReport) {
+	p.EvaluateAt(report, time.Now())
+}
+
+// EvaluateAt is Evaluate with an explicit clock, used to decide waiver expiry.
+// Severity overrides are applied to the items and the counts recomputed;
+// waived items stay in the report but do not contribute to blocking.
+func (p DriftPolicy) EvaluateAt(report *DriftReport, now time.Time) {
 	if report == nil {
 		return
 	}
 
-	blocked := false
-	if p.BlockOnCritical && report.CriticalChanges > 0 {
-		blocked = true
+	// Reports built without items only carry counts.
+	if len(report.Items) == 0 {
+		report.Blocked = p.blocks(report.CriticalChanges, report.BreakingChanges)
+		return
 	}
-	if p.BlockOnBreaking && report.BreakingChanges > 0 {
-		blocked = true
+
+	report.AppliedWaivers = nil
+	var critical, breaking int
+	for i := range report.Items {
+		item := &report.Items[i]
+		if sev, ok := p.SeverityOverrides[item.Type]; ok {
+			item.Severity = sev
+		}
+
+		item.Waived = false
+		if w, ok := p.waiverFor(*item, now); ok {
+			item.Waived = true
+			report.AppliedWaivers = append(report.AppliedWaivers, AppliedWaiver{
+				WaiverID:      w.ID,
+				DriftType:     item.Type,
+				Location:      item.Location,
+				Justification: w.Justification,
+				Owner:         w.Owner,
+				ExpiresAt:     w.ExpiresAt,
+			})
+			continue
+		}
+
+		switch item.Severity {
+		case Critical:
+			critical++
+		case Breaking:
+			breaking++
+		}
+	}
+
+	report.tally()
+	report.Blocked = p.blocks(critical, breaking)
+}
+
+func (p DriftPolicy) blocks(critical, breaking int) bool {
+	return (p.BlockOnCritical && critical > 0) || (p.BlockOnBreaking && breaking > 0)
+}
+
+func (p DriftPolicy) waiverFor(item DriftItem, now time.Time) (Waiver, bool) {
+	path := itemPath(item)
+	for _, w := range p.Waivers {
+		if w.ExpiresAt != nil && !now.Before(*w.ExpiresAt) {
+			continue
+		}
+		if w.DriftType != "" && w.DriftType != item.Type {
+			continue
+		}
+		if matchGlob(w.Path, path) || matchGlob(w.Path, item.Location) {
+			return w, true
+		}
 	}
+	return Waiver{}, false
+}
 
-	report.Blocked = blocked
+// itemPath extracts the API path from an item location such as
+// "/paths:/internal/users:get:responses:200", falling back to the location itself.
+// Path-level items (PATH_REMOVED, NEW_PATH) are located at "/paths" and carry
+// the path itself as their baseline or proposed value.
+func itemPath(item DriftItem) string {
+	if item.Location == "/paths" {
+		for _, v := range []any{item.Baseline, item.Proposed} {
+			if path, ok := v.(string); ok && path != "" {
+				return path
+			}
+		}
+	}
+	rest, ok := strings.CutPrefix(item.Location, "/paths:")
+	if !ok {
+		return item.Location
+	}
+	if i := strings.Index(rest, ":"); i >= 0 {
+		return rest[:i]
+	}
+	return rest
+}
+
+func matchGlob(pattern, s string) bool {
+	var b strings.Builder
+	b.WriteString("^")
+	for _, r := range pattern {
+		switch r {
+		case '*':
+			b.WriteString(".*")
+		case '?':
+			b.WriteString(".")
+		default:
+			b.WriteString(regexp.QuoteMeta(string(r)))
+		}
+	}
+	b.WriteString("$")
+	re, err := regexp.Compile(b.String())
+	return err == nil && re.MatchString(s)
 }
@@ -10,6 +10,8 @@ type DriftReport struct {
 	Infos           int         `json:"infos"`
 	Items           []DriftItem `json:"items"`
 	Blocked         bool        `json:"blocked"`
+
+	AppliedWaivers []AppliedWaiver `json:"applied_waivers,omitempty"`
 }
 
 // DriftItem represents a single instance of detected drift.
@@ -20,6 +22,7 @@ type DriftItem struct {
 	Baseline    any           `json:"baseline"`
 	Proposed    any           `json:"proposed"`
 	Description string        `json:"description"`
+	Waived      bool          `json:"waived,omitempty"`
 }
 
 // NewDriftReport initializes a report and sorts items for stability.
@@ -36,18 +39,23 @@ func NewDriftReport(items []DriftItem) DriftReport {
 		return report.Items[i].Type < report.Items[j].Type
 	})
 
-	for _, item := range report.Items {
+	report.tally()
+	return report
+}
+
+// tally recomputes the per-severity counts from the items.
+func (r *DriftReport) tally() {
+	r.CriticalChanges, r.BreakingChanges, r.Warnings, r.Infos = 0, 0, 0, 0
+	for _, item := range r.Items {
 		switch item.Severity {
 		case Critical:
-			report.CriticalChanges++
+			r.CriticalChanges++
 		case Breaking:
-			report.BreakingChanges++
+			r.BreakingChanges++
 		case Warning:
-			report.Warnings++
+			r.Warnings++
 		case Info:
-			report.Infos++
+			r.Infos++
 		}
 	}
-
-	return report
 }
@@ -4,8 +4,39 @@ import (
 	"fmt"
 )
 
+// Direction tells the schema comparison which way data flows through a schema.
+// Consumers send requests and receive responses, so a change that is breaking
+// on one side is usually safe on the other: tightening a request constraint
+// rejects payloads clients already send, while tightening a response
+// constraint only narrows what clients receive.
+type Direction string
+
+const (
+	RequestDirection  Direction = "REQUEST"
+	ResponseDirection Direction = "RESPONSE"
+	// BothDirections grades each change by the stricter of the two, for
+	// schemas that data flows through both ways.
+	BothDirections Direction = "BOTH"
+)
+
 // CompareSchema recursively compares two schemas and returns a list of drift items.
+// Schemas are compared as request (input) schemas.
 func CompareSchema(baseline, proposed Schema, location string) []DriftItem {
+	return CompareSchemaDirection(baseline, proposed, location, RequestDirection)
+}
+
+// CompareSchemaDirection compares two schemas, grading constraint, enum,
+// required and additive changes for the given direction. Removed properties
+// and type changes are critical either way.
+func CompareSchemaDirection(baseline, proposed Schema, location string, dir Direction) []DriftItem {
+	return schemaComparer{dir: dir}.compare(baseline, proposed, location)
+}
+
+type schemaComparer struct {
+	dir Direction
+}
+
+func (c schemaComparer) compare(baseline, proposed Schema, location string) []DriftItem {
 	var items []DriftItem
 
 	// 1. Type change
@@ -15,33 +46,34 @@ func CompareSchema(baseline, proposed Schema, location string) []DriftItem {
 	}
 
 	// 2. Enum changes
-	items = append(items, compareEnums(baseline.Enum, proposed.Enum, location)...)
+	items = append(items, c.compareEnums(baseline.Enum, proposed.Enum, location)...)
 
 	// 3. Nullability changes
 	if !baseline.Nullable && proposed.Nullable {
-		items = append(items, newDriftItem(ConstraintLoosened, location, baseline.Nullable, proposed.Nullable, "Field became nullable"))
+		items = append(items, c.item(ConstraintLoosened, location, baseline.Nullable, proposed.Nullable, "Field became nullable"))
 	} else if baseline.Nullable && !proposed.Nullable {
-		items = append(items, newDriftItem(ConstraintTightened, location, baseline.Nullable, proposed.Nullable, "Field became non-nullable"))
+		items = append(items, c.item(ConstraintTightened, location, baseline.Nullable, proposed.Nullable, "Field became non-nullable"))
 	}
 
 	// 4. Required fields changes
-	items = append(items, compareRequired(baseline.Required, proposed.Required, location)...)
+	items = append(items, c.compareRequired(baseline.Required, proposed.Required, location)...)
 
-	// 5. Constraints (MinLength, MaxLength, etc.)
-	items = append(items, compareConstraints(baseline, proposed, location)...)
+	// 5. Constraints (lengths, bounds, item counts, pattern)
+	items = append(items, c.compareConstraints(baseline, proposed, location)...)
 
 	// 6. Properties comparison (Recursive)
-	items = append(items, compareProperties(baseline.Properties, proposed.Properties, location)...)
+	items = append(items, c.compareProperties(baseline.Properties, proposed.Properties, location)...)
+	items = append(items, c.compareAdditionalProperties(baseline, proposed, location)...)
 
 	// 7. Items comparison (Arrays)
 	if baseline.Items != nil && proposed.Items != nil {
-		items = append(items, CompareSchema(*baseline.Items, *proposed.Items, location+"/items")...)
+		items = append(items, c.compare(*baseline.Items, *proposed.Items, location+"/items")...)
 	}
 
 	// 8. Composition comparison (oneOf, anyOf, allOf)
-	items = append(items, compareComposition("oneOf", baseline.OneOf, proposed.OneOf, location)...)
-	items = append(items, compareComposition("anyOf", baseline.AnyOf, proposed.AnyOf, location)...)
-	items = append(items, compareComposition("allOf", baseline.AllOf, proposed.AllOf, location)...)
+	items = append(items, c.compareComposition("oneOf", baseline.OneOf, proposed.OneOf, location)...)
+	items = append(items, c.compareComposition("anyOf", baseline.AnyOf, proposed.AnyOf, location)...)
+	items = append(items, c.compareComposition("allOf", baseline.AllOf, proposed.AllOf, location)...)
 
 	return items
 }
@@ -57,7 +89,33 @@ func newDriftItem(t DriftType, loc string, base, prop any, desc string) DriftIte
 	}
 }
 
-func compareEnums(baseline, proposed []any, location string) []DriftItem {
+// item builds a drift item whose severity depends on the comparison direction.
+func (c schemaComparer) item(t DriftType, loc string, base, prop any, desc string) DriftItem {
+	item := newDriftItem(t, loc, base, prop, desc)
+	switch c.dir {
+	case ResponseDirection:
+		item.Severity = responseSeverity(t, item.Severity)
+	case BothDirections:
+		item.Severity = stricter(item.Severity, responseSeverity(t, item.Severity))
+	}
+	return item
+}
+
+// responseSeverity inverts the request-side grading for response schemas:
+// whatever widens the set of values a consumer may receive is breaking, and
+// whatever narrows it is informational. New properties stay additive.
+func responseSeverity(t DriftType, requestSeverity DriftSeverity) DriftSeverity {
+	switch t {
+	case ConstraintLoosened, EnumValueAdded, RequiredFieldRemoved:
+		return Breaking
+	case ConstraintTightened, EnumValueRemoved, RequiredFieldAdded:
+		return Info
+	default:
+		return requestSeverity
+	}
+}
+
+func (c schemaComparer) compareEnums(baseline, proposed []any, location string) []DriftItem {
 	var items []DriftItem
 	if len(baseline) == 0 && len(proposed) == 0 {
 		return nil
@@ -73,24 +131,32 @@ func compareEnums(baseline, proposed []any, location string) []DriftItem {
 		propMap[v] = true
 	}
 
+	// An enum introduced on a free-form field narrows it; dropping the enum widens it.
+	if len(baseline) == 0 {
+		return []DriftItem{c.item(ConstraintTightened, location+"/enum", nil, proposed, "enum added")}
+	}
+	if len(proposed) == 0 {
+		return []DriftItem{c.item(ConstraintLoosened, location+"/enum", baseline, nil, "enum removed")}
+	}
+
 	// Check for removals
 	for _, v := range baseline {
 		if !propMap[v] {
-			items = append(items, newDriftItem(EnumValueRemoved, location, v, nil, fmt.Sprintf("Enum value '%v' removed", v)))
+			items = append(items, c.item(EnumValueRemoved, location, v, nil, fmt.Sprintf("Enum value '%v' removed", v)))
 		}
 	}
 
 	// Check for additions
 	for _, v := range proposed {
 		if !baseMap[v] {
-			items = append(items, newDriftItem(EnumValueAdded, location, nil, v, fmt.Sprintf("Enum value '%v' added", v)))
+			items = append(items, c.item(EnumValueAdded, location, nil, v, fmt.Sprintf("Enum value '%v' added", v)))
 		}
 	}
 
 	return items
 }
 
-func compareRequired(baseline, proposed []string, location string) []DriftItem {
+func (c schemaComparer) compareRequired(baseline, proposed []string, location string) []DriftItem {
 	var items []DriftItem
 	baseMap := make(map[string]bool)
 	for _, v := range baseline {
@@ -104,61 +170,75 @@ func compareRequired(baseline, proposed []string, location string) []DriftItem {
 
 	for _, v := range baseline {
 		if !propMap[v] {
-			items = append(items, newDriftItem(RequiredFieldRemoved, location, v, nil, fmt.Sprintf("Required field '%s' removed", v)))
+			items = append(items, c.item(RequiredFieldRemoved, location, v, nil, fmt.Sprintf("Required field '%s' removed", v)))
 		}
 	}
 
 	for _, v := range proposed {
 		if !baseMap[v] {
-			items = append(items, newDriftItem(RequiredFieldAdded, location, nil, v, fmt.Sprintf("Required field '%s' added", v)))
+			items = append(items, c.item(RequiredFieldAdded, location, nil, v, fmt.Sprintf("Required field '%s' added", v)))
 		}
 	}
 
 	return items
 }
 
-func compareConstraints(baseline, proposed Schema, location string) []DriftItem {
+func (c schemaComparer) compareConstraints(baseline, proposed Schema, location string) []DriftItem {
 	var items []DriftItem
 
-	// MinLength
-	if baseline.MinLength != proposed.MinLength {
-		valBase := 0
-		if baseline.MinLength != nil {
-			valBase = *baseline.MinLength
-		}
-		valProp := 0
-		if proposed.MinLength != nil {
-			valProp = *proposed.MinLength
-		}
-
-		if valProp > valBase {
-			items = append(items, newDriftItem(ConstraintTightened, location+"/minLength", baseline.MinLength, proposed.MinLength, "minLength increased"))
-		} else if valProp < valBase {
-			items = append(items, newDriftItem(ConstraintLoosened, location+"/minLength", baseline.MinLength, proposed.MinLength, "minLength decreased"))
+	items = append(items, compareLowerBound(c, location, "minLength", baseline.MinLength, proposed.MinLength)...)
+	items = append(items, compareUpperBound(c, location, "maxLength", baseline.MaxLength, proposed.MaxLength)...)
+	items = append(items, compareLowerBound(c, location, "minItems", baseline.MinItems, proposed.MinItems)...)
+	items = append(items, compareUpperBound(c, location, "maxItems", baseline.MaxItems, proposed.MaxItems)...)
+	items = append(items, compareLowerBound(c, location, "minimum", baseline.Minimum, proposed.Minimum)...)
+	items = append(items, compareUpperBound(c, location, "maximum", baseline.Maximum, proposed.Maximum)...)
+
+	// Any new or different pattern may reject values the old one accepted.
+	if baseline.Pattern != proposed.Pattern {
+		switch {
+		case baseline.Pattern == "":
+			items = append(items, c.item(ConstraintTightened, location+"/pattern", nil, proposed.Pattern, "pattern added"))
+		case proposed.Pattern == "":
+			items = append(items, c.item(ConstraintLoosened, location+"/pattern", baseline.Pattern, nil, "pattern removed"))
+		default:
+			items = append(items, c.item(ConstraintTightened, location+"/pattern", baseline.Pattern, proposed.Pattern, "pattern changed"))
 		}
 	}
 
-	// MaxLength
-	if baseline.MaxLength != proposed.MaxLength {
-		if baseline.MaxLength != nil && proposed.MaxLength != nil {
-			if *proposed.MaxLength < *baseline.MaxLength {
-				items = append(items, newDriftItem(ConstraintTightened, location+"/maxLength", *baseline.MaxLength, *proposed.MaxLength, "maxLength decreased"))
-			} else if *proposed.MaxLength > *baseline.MaxLength {
-				items = append(items, newDriftItem(ConstraintLoosened, location+"/maxLength", *baseline.MaxLength, *proposed.MaxLength, "maxLength increased"))
-			}
-		} else if baseline.MaxLength == nil && proposed.MaxLength != nil {
-			items = append(items, newDriftItem(ConstraintTightened, location+"/maxLength", nil, *proposed.MaxLength, "maxLength added"))
-		} else if baseline.MaxLength != nil && proposed.MaxLength == nil {
-			items = append(items, newDriftItem(ConstraintLoosened, location+"/maxLength", *baseline.MaxLength, nil, "maxLength removed"))
-		}
-	}
+	return items
+}
 
-	// (Similar logic for Minimum, Maximum, MinItems, MaxItems could be added here)
+// compareLowerBound reports changes to a minimum-style keyword; raising or
+// adding the bound tightens the schema.
+func compareLowerBound[T int | float64](c schemaComparer, location, keyword string, baseline, proposed *T) []DriftItem {
+	return compareBound(c, location, keyword, baseline, proposed, func(b, p T) bool { return p > b })
+}
 
-	return items
+// compareUpperBound reports changes to a maximum-style keyword; lowering or
+// adding the bound tightens the schema.
+func compareUpperBound[T int | float64](c schemaComparer, location, keyword string, baseline, proposed *T) []DriftItem {
+	return compareBound(c, location, keyword, baseline, proposed, func(b, p T) bool { return p < b })
 }
 
-func compareProperties(baseline, proposed map[string]*Schema, location string) []DriftItem {
+func compareBound[T int | float64](c schemaComparer, location, keyword string, baseline, proposed *T, tighter func(b, p T) bool) []DriftItem {
+	loc := location + "/" + keyword
+	switch {
+	case baseline == nil && proposed == nil:
+		return nil
+	case baseline == nil:
+		return []DriftItem{c.item(ConstraintTightened, loc, nil, *proposed, keyword+" added")}
+	case proposed == nil:
+		return []DriftItem{c.item(ConstraintLoosened, loc, *baseline, nil, keyword+" removed")}
+	case *baseline == *proposed:
+		return nil
+	case tighter(*baseline, *proposed):
+		return []DriftItem{c.item(ConstraintTightened, loc, *baseline, *proposed, fmt.Sprintf("%s changed from %v to %v", keyword, *baseline, *proposed))}
+	default:
+		return []DriftItem{c.item(ConstraintLoosened, loc, *baseline, *proposed, fmt.Sprintf("%s changed from %v to %v", keyword, *baseline, *proposed))}
+	}
+}
+
+func (c schemaComparer) compareProperties(baseline, proposed map[string]*Schema, location string) []DriftItem {
 	var items []DriftItem
 	if baseline == nil && proposed == nil {
 		return nil
@@ -170,32 +250,50 @@ func compareProperties(baseline, proposed map[string]*Schema, location string) [
 			items = append(items, newDriftItem(RequiredFieldRemoved, location, name, nil, fmt.Sprintf("Property '%s' removed", name)))
 			continue
 		}
-		items = append(items, CompareSchema(*baseline[name], *propProp, location+"/properties/"+name)...)
+		items = append(items, c.compare(*baseline[name], *propProp, location+"/properties/"+name)...)
 	}
 
 	for name := range proposed {
 		if _, exists := baseline[name]; !exists {
-			items = append(items, newDriftItem(FieldAdded, location, nil, name, fmt.Sprintf("Property '%s' added", name)))
+			items = append(items, c.item(FieldAdded, location, nil, name, fmt.Sprintf("Property '%s' added", name)))
 		}
 	}
 
 	return items
 }
 
-func compareComposition(compType string, baseline, proposed []*Schema, location string) []DriftItem {
+// compareAdditionalProperties reports objects being closed to or opened for
+// undeclared properties, and recurses when both sides constrain them with a schema.
+func (c schemaComparer) compareAdditionalProperties(baseline, proposed Schema, location string) []DriftItem {
+	loc := location + "/additionalProperties"
+	if !baseline.AdditionalPropertiesDenied && proposed.AdditionalPropertiesDenied {
+		return []DriftItem{c.item(ConstraintTightened, loc, true, false, "additionalProperties disallowed")}
+	}
+	if baseline.AdditionalPropertiesDenied && !proposed.AdditionalPropertiesDenied {
+		return []DriftItem{c.item(ConstraintLoosened, loc, false, true, "additionalProperties allowed")}
+	}
+	if baseline.AdditionalProperties != nil && proposed.AdditionalProperties != nil {
+		return c.compare(*baseline.AdditionalProperties, *proposed.AdditionalProperties, loc)
+	}
+	return nil
+}
+
+func (c schemaComparer) compareComposition(compType string, baseline, proposed []*Schema, location string) []DriftItem {
 	var items []DriftItem
 	if len(baseline) == 0 && len(proposed) == 0 {
 		return nil
 	}
 
-	// Simple heuristic: compare by index if lengths match, otherwise report count change
-	// In a real engine, we might try to match by structure, but for this task index-based or length-based is a start.
-	// Actually, the requirements emphasize structural comparison.
-
+	// Options are matched by index. For oneOf/anyOf fewer options accept fewer
+	// values; for allOf every added member is another constraint.
+	removed, added := ConstraintTightened, ConstraintLoosened
+	if compType == "allOf" {
+		removed, added = ConstraintLoosened, ConstraintTightened
+	}
 	if len(baseline) > len(proposed) {
-		items = append(items, newDriftItem(ConstraintTightened, location+"/"+compType, len(baseline), len(proposed), fmt.Sprintf("%s option(s) removed", compType)))
+		items = append(items, c.item(removed, location+"/"+compType, len(baseline), len(proposed), fmt.Sprintf("%s option(s) removed", compType)))
 	} else if len(baseline) < len(proposed) {
-		items = append(items, newDriftItem(ConstraintLoosened, location+"/"+compType, len(baseline), len(proposed), fmt.Sprintf("%s option(s) added", compType)))
+		items = append(items, c.item(added, location+"/"+compType, len(baseline), len(proposed), fmt.Sprintf("%s option(s) added", compType)))
 	}
 
 	// Recurse into common indices
@@ -205,7 +303,7 @@ func compareComposition(compType string, baseline, proposed []*Schema, location
 	}
 
 	for i := 0; i < minLen; i++ {
-		items = append(items, CompareSchema(*baseline[i], *proposed[i], fmt.Sprintf("%s/%s/%d", location, compType, i))...)
+		items = append(items, c.compare(*baseline[i], *proposed[i], fmt.Sprintf("%s/%s/%d", location, compType, i))...)
 	}
 
 	return items
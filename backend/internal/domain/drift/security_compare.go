@@ -0,0 +1,239 @@
+package drift
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// compareSecurity compares the security requirements of an operation.
+// Requirements are alternatives (any one satisfies the operation); the schemes
+// inside a requirement must all be satisfied. An empty requirement, or no
+// requirements at all, means the operation can be called anonymously.
+func compareSecurity(location string, baseline, proposed []SecurityRequirement) []DriftItem {
+	var items []DriftItem
+
+	baseReqs, baseAnon := splitAnonymous(baseline)
+	propReqs, propAnon := splitAnonymous(proposed)
+	authRemoved := !baseAnon && propAnon
+
+	switch {
+	case authRemoved:
+		items = append(items, newDriftItem(SecurityAuthRemoved, location, baseline, proposed, "Operation no longer requires authentication"))
+	case baseAnon && !propAnon:
+		items = append(items, newDriftItem(SecurityTightened, location, baseline, proposed, "Operation now requires authentication"))
+	}
+
+	// Pair requirements with identical scheme sets first, then fall back to the
+	// closest overlap so that adding a scheme to a requirement reads as a change
+	// rather than a removal plus an addition.
+	matched := make(map[int]bool)
+	var unmatchedBase []SecurityRequirement
+	for _, base := range baseReqs {
+		if j := findRequirement(propReqs, matched, requirementKey(base)); j >= 0 {
+			matched[j] = true
+			items = append(items, compareScopes(location+":"+requirementKey(base), base, propReqs[j])...)
+			continue
+		}
+		unmatchedBase = append(unmatchedBase, base)
+	}
+
+	for _, base := range unmatchedBase {
+		key := requirementKey(base)
+		j := closestRequirement(propReqs, matched, base)
+		if j < 0 {
+			// Once the operation is anonymous the auth removal already covers
+			// every requirement it dropped.
+			if !authRemoved {
+				items = append(items, newDriftItem(SecurityRequirementRemoved, location, key, nil,
+					fmt.Sprintf("Security requirement '%s' removed", key)))
+			}
+			continue
+		}
+		matched[j] = true
+		prop := propReqs[j]
+		loc := location + ":" + key
+		for _, scheme := range sortedSchemes(prop) {
+			if _, ok := base[scheme]; !ok {
+				items = append(items, newDriftItem(SecurityTightened, loc, nil, scheme,
+					fmt.Sprintf("Security scheme '%s' is now also required", scheme)))
+			}
+		}
+		for _, scheme := range sortedSchemes(base) {
+			if _, ok := prop[scheme]; !ok {
+				items = append(items, newDriftItem(SecurityLoosened, loc, scheme, nil,
+					fmt.Sprintf("Security scheme '%s' is no longer required", scheme)))
+			}
+		}
+		items = append(items, compareScopes(loc, base, prop)...)
+	}
+
+	for j, prop := range propReqs {
+		if !matched[j] {
+			key := requirementKey(prop)
+			items = append(items, newDriftItem(SecurityRequirementAdded, location, nil, key,
+				fmt.Sprintf("Alternative security requirement '%s' added", key)))
+		}
+	}
+
+	return items
+}
+
+// compareScopes compares OAuth scopes for the schemes present in both requirements.
+// Newly required scopes break existing tokens; dropped scopes only loosen access.
+func compareScopes(location string, baseline, proposed SecurityRequirement) []DriftItem {
+	var items []DriftItem
+	for _, scheme := range sortedSchemes(baseline) {
+		propScopes, ok := proposed[scheme]
+		if !ok {
+			continue
+		}
+		baseSet := toSet(baseline[scheme])
+		propSet := toSet(propScopes)
+		for _, scope := range propScopes {
+			if !baseSet[scope] {
+				items = append(items, newDriftItem(SecurityScopeAdded, location+":"+scheme, nil, scope,
+					fmt.Sprintf("Scope '%s' is now required for '%s'", scope, scheme)))
+			}
+		}
+		for _, scope := range baseline[scheme] {
+			if !propSet[scope] {
+				items = append(items, newDriftItem(SecurityScopeRemoved, location+":"+scheme, scope, nil,
+					fmt.Sprintf("Scope '%s' is no longer required for '%s'", scope, scheme)))
+			}
+		}
+	}
+	return items
+}
+
+// compareSecuritySchemes compares the security schemes declared in components.
+func compareSecuritySchemes(baseline, proposed map[string]SecurityScheme) []DriftItem {
+	var items []DriftItem
+	location := "/components/securitySchemes"
+
+	for name, base := range baseline {
+		prop, exists := proposed[name]
+		if !exists {
+			items = append(items, newDriftItem(SecuritySchemeRemoved, location, name, nil, fmt.Sprintf("Security scheme '%s' removed", name)))
+			continue
+		}
+		if base == prop {
+			continue
+		}
+		if schemeStrength(prop) < schemeStrength(base) {
+			items = append(items, newDriftItem(SecuritySchemeDowngraded, location+"/"+name, describeScheme(base), describeScheme(prop),
+				fmt.Sprintf("Security scheme '%s' downgraded from %s to %s", name, describeScheme(base), describeScheme(prop))))
+			continue
+		}
+		items = append(items, newDriftItem(SecuritySchemeChanged, location+"/"+name, describeScheme(base), describeScheme(prop),
+			fmt.Sprintf("Security scheme '%s' changed from %s to %s", name, describeScheme(base), describeScheme(prop))))
+	}
+
+	for name := range proposed {
+		if _, exists := baseline[name]; !exists {
+			items = append(items, newDriftItem(SecuritySchemeAdded, location, nil, name, fmt.Sprintf("New security scheme '%s' added", name)))
+		}
+	}
+
+	return items
+}
+
+// schemeStrength ranks scheme types so that replacing e.g. OAuth2 with an API key
+// is reported as a downgrade rather than a plain change.
+func schemeStrength(s SecurityScheme) int {
+	switch strings.ToLower(s.Type) {
+	case "mutualtls":
+		return 5
+	case "oauth2", "openidconnect":
+		return 4
+	case "http":
+		switch strings.ToLower(s.Scheme) {
+		case "bearer":
+			return 3
+		case "basic":
+			return 1
+		default:
+			return 2
+		}
+	case "apikey":
+		return 2
+	default:
+		return 0
+	}
+}
+
+func describeScheme(s SecurityScheme) string {
+	switch strings.ToLower(s.Type) {
+	case "http":
+		if s.Scheme != "" {
+			return "http " + s.Scheme
+		}
+	case "apikey":
+		if s.In != "" {
+			return fmt.Sprintf("apiKey in %s '%s'", s.In, s.Name)
+		}
+	}
+	return s.Type
+}
+
+func splitAnonymous(reqs []SecurityRequirement) ([]SecurityRequirement, bool) {
+	anonymous := len(reqs) == 0
+	var out []SecurityRequirement
+	for _, r := range reqs {
+		if len(r) == 0 {
+			anonymous = true
+			continue
+		}
+		out = append(out, r)
+	}
+	return out, anonymous
+}
+
+func requirementKey(r SecurityRequirement) string {
+	return strings.Join(sortedSchemes(r), "+")
+}
+
+func findRequirement(reqs []SecurityRequirement, matched map[int]bool, key string) int {
+	for j, r := range reqs {
+		if !matched[j] && requirementKey(r) == key {
+			return j
+		}
+	}
+	return -1
+}
+
+func closestRequirement(reqs []SecurityRequirement, matched map[int]bool, base SecurityRequirement) int {
+	best, bestOverlap := -1, 0
+	for j, r := range reqs {
+		if matched[j] {
+			continue
+		}
+		overlap := 0
+		for scheme := range base {
+			if _, ok := r[scheme]; ok {
+				overlap++
+			}
+		}
+		if overlap > bestOverlap {
+			best, bestOverlap = j, overlap
+		}
+	}
+	return best
+}
+
+func sortedSchemes(r SecurityRequirement) []string {
+	names := make([]string, 0, len(r))
+	for name := range r {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+func toSet(values []string) map[string]bool {
+	set := make(map[string]bool, len(values))
+	for _, v := range values {
+		set[v] = true
+	}
+	return set
+}
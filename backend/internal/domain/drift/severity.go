@@ -10,6 +10,16 @@ const (
 	Critical DriftSeverity = "CRITICAL"
 )
 
+var severityRank = map[DriftSeverity]int{Info: 0, Warning: 1, Breaking: 2, Critical: 3}
+
+// stricter returns the more severe of a and b.
+func stricter(a, b DriftSeverity) DriftSeverity {
+	if severityRank[b] > severityRank[a] {
+		return b
+	}
+	return a
+}
+
 // GetSeverity returns the severity for a given DriftType based on deterministic rules.
 func GetSeverity(driftType DriftType, baseline, proposed any) DriftSeverity {
 	switch driftType {
@@ -17,7 +27,16 @@ func GetSeverity(driftType DriftType, baseline, proposed any) DriftSeverity {
 		MethodRemoved,
 		RequiredFieldRemoved,
 		EnumValueRemoved,
-		ResponseRemoved:
+		ResponseRemoved,
+		SecurityAuthRemoved,
+		SecuritySchemeDowngraded,
+		TypeRemoved,
+		FieldRemoved,
+		ArgumentRemoved,
+		ChannelRemoved,
+		MessageRemoved,
+		CommandRemoved,
+		FlagRemoved:
 		return Critical
 
 	case FieldTypeChanged:
@@ -27,7 +46,20 @@ func GetSeverity(driftType DriftType, baseline, proposed any) DriftSeverity {
 		return Critical
 
 	case ConstraintTightened,
-		RequiredFieldAdded:
+		RequiredFieldAdded,
+		SecurityTightened,
+		SecuritySchemeRemoved,
+		SecuritySchemeChanged,
+		SecurityRequirementRemoved,
+		SecurityScopeAdded,
+		ArgumentMadeNonNull,
+		ChannelRenamed,
+		RequiredHeaderAdded,
+		FlagDefaultChanged,
+		EnvVarRemoved,
+		ExitCodeRemoved,
+		ExitCodeChanged,
+		OutputFormatRemoved:
 		// Nullable -> non-nullable is also constraint tightening in our logic
 		return Breaking
 
@@ -35,10 +67,21 @@ func GetSeverity(driftType DriftType, baseline, proposed any) DriftSeverity {
 		NewPath,
 		NewMethod,
 		ConstraintLoosened,
-		EnumValueAdded:
+		EnumValueAdded,
+		SecurityLoosened,
+		SecurityRequirementAdded,
+		SecurityScopeRemoved,
+		TypeAdded,
+		DeprecationAdded,
+		ChannelAdded,
+		MessageAdded,
+		CommandAdded,
+		ExitCodeAdded:
 		return Warning
 
-	case MetadataChanged:
+	case MetadataChanged,
+		SecuritySchemeAdded,
+		OutputFormatAdded:
 		return Info
 
 	default:
@@ -0,0 +1,28 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/SpecForgeVC/SpecForge/internal/domain/drift"
+	"github.com/google/uuid"
+)
+
+// SpecDriftCheck is a persisted OpenAPI-to-OpenAPI drift check result.
+type SpecDriftCheck struct {
+	ID             uuid.UUID         `json:"id"`
+	ProjectID      uuid.UUID         `json:"project_id"`
+	BaselineSource string            `json:"baseline_source"`
+	ProposedSource string            `json:"proposed_source"`
+	Policy         drift.DriftPolicy `json:"policy"`
+	Report         drift.DriftReport `json:"report"`
+	CreatedBy      uuid.UUID         `json:"created_by,omitempty"`
+	CreatedAt      time.Time         `json:"created_at"`
+}
+
+// ProjectDriftPolicy is the drift policy a project applies to its spec drift checks.
+type ProjectDriftPolicy struct {
+	ProjectID uuid.UUID         `json:"project_id"`
+	Policy    drift.DriftPolicy `json:"policy"`
+	UpdatedBy uuid.UUID         `json:"updated_by,omitempty"`
+	UpdatedAt time.Time         `json:"updated_at"`
+}
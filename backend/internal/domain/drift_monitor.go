@@ -0,0 +1,42 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/SpecForgeVC/SpecForge/internal/domain/drift"
+	"github.com/google/uuid"
+)
+
+// DriftMonitorSchedule configures periodic drift monitoring of a project's
+// contracts. NextRunAt is persisted so schedules survive restarts.
+type DriftMonitorSchedule struct {
+	ProjectID      uuid.UUID  `json:"project_id"`
+	CronExpression string     `json:"cron_expression"`
+	Enabled        bool       `json:"enabled"`
+	NextRunAt      *time.Time `json:"next_run_at,omitempty"`
+	LastRunAt      *time.Time `json:"last_run_at,omitempty"`
+	UpdatedBy      uuid.UUID  `json:"updated_by,omitempty"`
+	UpdatedAt      time.Time  `json:"updated_at"`
+}
+
+// DriftTimelineEntry is the result of one monitored drift check of a contract
+// against the latest snapshot of its roadmap item that captured it.
+type DriftTimelineEntry struct {
+	ID                 uuid.UUID             `json:"id"`
+	ProjectID          uuid.UUID             `json:"project_id"`
+	RoadmapItemID      uuid.UUID             `json:"roadmap_item_id"`
+	ContractID         uuid.UUID             `json:"contract_id"`
+	SnapshotID         uuid.UUID             `json:"snapshot_id"`
+	BreakingChanges    int                   `json:"breaking_changes"`
+	NonBreakingChanges int                   `json:"non_breaking_changes"`
+	NewBreakingChanges int                   `json:"new_breaking_changes"`
+	Changes            []DriftTimelineChange `json:"changes"`
+	CheckedAt          time.Time             `json:"checked_at"`
+}
+
+type DriftTimelineChange struct {
+	Path        string              `json:"path"`
+	Description string              `json:"description"`
+	Severity    drift.DriftSeverity `json:"severity"`
+	Breaking    bool                `json:"breaking"`
+}
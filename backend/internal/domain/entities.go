@@ -3,6 +3,8 @@ package domain
 import (
 	"time"
 
+	"github.com/SpecForgeVC/SpecForge/internal/domain/drift"
+	"github.com/SpecForgeVC/SpecForge/internal/jsonpatch"
 	"github.com/google/uuid"
 )
 
@@ -131,6 +133,12 @@ type RoadmapItem struct {
 	UpdatedAt           time.Time           `json:"updated_at"`
 }
 
+// IsHighRisk reports whether changes to the item need the stricter review
+// quorum.
+func (r RoadmapItem) IsHighRisk() bool {
+	return r.RiskLevel == RiskHigh || r.BreakingChange || r.RegressionSensitive
+}
+
 type ContractType string
 
 const (
@@ -170,14 +178,19 @@ const (
 	ModifySchema    ProposalType = "MODIFY_SCHEMA"
 	AddVariable     ProposalType = "ADD_VARIABLE"
 	RemoveField     ProposalType = "REMOVE_FIELD"
+	JSONPatch       ProposalType = "JSON_PATCH"
 )
 
 type ProposalStatus string
 
 const (
-	Pending  ProposalStatus = "PENDING"
-	Approved ProposalStatus = "APPROVED"
-	Rejected ProposalStatus = "REJECTED"
+	Pending          ProposalStatus = "PENDING"
+	Approved         ProposalStatus = "APPROVED"
+	Rejected         ProposalStatus = "REJECTED"
+	ChangesRequested ProposalStatus = "CHANGES_REQUESTED"
+	// Stale proposals were made against a version of their target that has
+	// since changed; they must be rebased before they can be approved.
+	Stale ProposalStatus = "STALE"
 )
 
 type AiProposal struct {
@@ -189,7 +202,21 @@ type AiProposal struct {
 	ConfidenceScore float64                `json:"confidence_score"`
 	Status          ProposalStatus         `json:"status"`
 	ReviewedBy      uuid.UUID              `json:"reviewed_by,omitempty"`
+	CreatedBy       uuid.UUID              `json:"created_by,omitempty"`
 	CreatedAt       time.Time              `json:"created_at"`
+	// TargetType and TargetID name the entity the proposal changes, and
+	// BaseHash the version of it the proposal was made or last rebased
+	// against. Proposals that create entities do not track a target.
+	TargetType   PatchTargetType        `json:"target_type,omitempty"`
+	TargetID     uuid.UUID              `json:"target_id,omitempty"`
+	BaseHash     string                 `json:"base_hash,omitempty"`
+	BaseDocument map[string]interface{} `json:"-"`
+}
+
+// IsOpen reports whether the proposal is still in review, i.e. pending or
+// waiting for its author to address requested changes.
+func (p AiProposal) IsOpen() bool {
+	return p.Status == Pending || p.Status == ChangesRequested
 }
 
 type AuditLog struct {
@@ -244,23 +271,38 @@ type Webhook struct {
 	CreatedAt time.Time `json:"created_at"`
 }
 
+// DriftReport summarises drift of a contract against a snapshot. BreakingChanges
+// lists the Breaking and Critical findings; Details, when set, carries every
+// classified finding including warnings and informational changes.
 type DriftReport struct {
-	DriftDetected   bool             `json:"drift_detected"`
-	BreakingChanges []BreakingChange `json:"breaking_changes"`
-	RiskScore       float64          `json:"risk_score"`
+	DriftDetected   bool               `json:"drift_detected"`
+	BreakingChanges []BreakingChange   `json:"breaking_changes"`
+	RiskScore       float64            `json:"risk_score"`
+	Details         *drift.DriftReport `json:"details,omitempty"`
 }
 
 type BreakingChange struct {
-	Field string `json:"field"`
-	Issue string `json:"issue"`
+	Field    string              `json:"field"`
+	Issue    string              `json:"issue"`
+	Severity drift.DriftSeverity `json:"severity,omitempty"`
 }
 
+// DriftFix is a proposed remedy for one breaking change. Patch is an RFC 6902
+// JSON Patch against the contract document {"input_schema", "output_schema",
+// "error_schema"}; Valid reports whether it applies cleanly and leaves valid schemas.
 type DriftFix struct {
-	Field           string `json:"field"`
-	Issue           string `json:"issue"`
-	SuggestedChange string `json:"suggested_change"`
-	Explanation     string `json:"explanation"`
+	Field           string          `json:"field"`
+	Issue           string          `json:"issue"`
+	SuggestedChange string          `json:"suggested_change"`
+	Explanation     string          `json:"explanation"`
+	ContractID      uuid.UUID       `json:"contract_id,omitempty"`
+	Patch           jsonpatch.Patch `json:"patch,omitempty"`
+	Confidence      float64         `json:"confidence,omitempty"`
+	Valid           bool            `json:"valid"`
+	ValidationError string          `json:"validation_error,omitempty"`
+	ProposalID      *uuid.UUID      `json:"proposal_id,omitempty"`
 }
+
 type FeatureIntelligence struct {
 	ID                       uuid.UUID `json:"id"`
 	FeatureID                uuid.UUID `json:"feature_id"`
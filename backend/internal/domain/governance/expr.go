@@ -0,0 +1,611 @@
+package governance
+
+import (
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+// Expr is a compiled governance condition. The language is a small,
+// side-effect free subset of C-like expressions over the facts of a feature:
+//
+//	literals     42, 0.5, "text", 'text', true, false, null, ["LOW", "MEDIUM"]
+//	facts        score.overall, drift.breaking, feature.risk_level
+//	arithmetic   + - * /   (+ also concatenates strings)
+//	comparison   == != < <= > >=, and `in` for list membership
+//	logic        && || !   (or the keywords and, or, not)
+//
+// Logical operators short-circuit, so `!score.available || score.overall >= 50`
+// only reads the score when one is available.
+type Expr struct {
+	src  string
+	root node
+}
+
+// Compile parses src into an expression.
+func Compile(src string) (*Expr, error) {
+	p := &parser{lex: lexer{src: src}}
+	p.next()
+	root, err := p.parseOr()
+	if err != nil {
+		return nil, err
+	}
+	if p.tok.kind != tokEOF {
+		return nil, p.errorf("unexpected %s", p.tok)
+	}
+	return &Expr{src: src, root: root}, nil
+}
+
+func (e *Expr) String() string { return e.src }
+
+// Eval evaluates the expression against facts. Numbers are returned as float64.
+func (e *Expr) Eval(facts Facts) (any, error) {
+	return e.root.eval(facts)
+}
+
+// EvalBool evaluates the expression and requires a boolean result.
+func (e *Expr) EvalBool(facts Facts) (bool, error) {
+	v, err := e.Eval(facts)
+	if err != nil {
+		return false, err
+	}
+	b, ok := v.(bool)
+	if !ok {
+		return false, fmt.Errorf("condition evaluated to %s, not a boolean", typeName(v))
+	}
+	return b, nil
+}
+
+// Facts returns the fact names the expression reads, in order of appearance.
+func (e *Expr) Facts() []string {
+	var names []string
+	seen := make(map[string]bool)
+	walk(e.root, func(n node) {
+		if id, ok := n.(identNode); ok && !seen[string(id)] {
+			seen[string(id)] = true
+			names = append(names, string(id))
+		}
+	})
+	return names
+}
+
+// --- AST -----------------------------------------------------------------
+
+type node interface {
+	eval(facts Facts) (any, error)
+}
+
+type literalNode struct{ value any }
+
+type identNode string
+
+type listNode []node
+
+type unaryNode struct {
+	op string
+	x  node
+}
+
+type binaryNode struct {
+	op   string
+	l, r node
+}
+
+func walk(n node, fn func(node)) {
+	fn(n)
+	switch n := n.(type) {
+	case listNode:
+		for _, x := range n {
+			walk(x, fn)
+		}
+	case unaryNode:
+		walk(n.x, fn)
+	case binaryNode:
+		walk(n.l, fn)
+		walk(n.r, fn)
+	}
+}
+
+func (n literalNode) eval(Facts) (any, error) { return n.value, nil }
+
+func (n identNode) eval(facts Facts) (any, error) {
+	v, ok := facts[string(n)]
+	if !ok {
+		return nil, fmt.Errorf("unknown fact %q", string(n))
+	}
+	return normalize(v), nil
+}
+
+func (n listNode) eval(facts Facts) (any, error) {
+	out := make([]any, len(n))
+	for i, x := range n {
+		v, err := x.eval(facts)
+		if err != nil {
+			return nil, err
+		}
+		out[i] = v
+	}
+	return out, nil
+}
+
+func (n unaryNode) eval(facts Facts) (any, error) {
+	v, err := n.x.eval(facts)
+	if err != nil {
+		return nil, err
+	}
+	switch n.op {
+	case "!":
+		b, ok := v.(bool)
+		if !ok {
+			return nil, fmt.Errorf("operator ! needs a boolean, got %s", typeName(v))
+		}
+		return !b, nil
+	case "-":
+		f, ok := v.(float64)
+		if !ok {
+			return nil, fmt.Errorf("operator - needs a number, got %s", typeName(v))
+		}
+		return -f, nil
+	}
+	return nil, fmt.Errorf("unknown operator %s", n.op)
+}
+
+func (n binaryNode) eval(facts Facts) (any, error) {
+	l, err := n.l.eval(facts)
+	if err != nil {
+		return nil, err
+	}
+
+	// Logical operators short-circuit.
+	if n.op == "&&" || n.op == "||" {
+		lb, ok := l.(bool)
+		if !ok {
+			return nil, fmt.Errorf("operator %s needs booleans, got %s", n.op, typeName(l))
+		}
+		if (n.op == "&&" && !lb) || (n.op == "||" && lb) {
+			return lb, nil
+		}
+		r, err := n.r.eval(facts)
+		if err != nil {
+			return nil, err
+		}
+		rb, ok := r.(bool)
+		if !ok {
+			return nil, fmt.Errorf("operator %s needs booleans, got %s", n.op, typeName(r))
+		}
+		return rb, nil
+	}
+
+	r, err := n.r.eval(facts)
+	if err != nil {
+		return nil, err
+	}
+	switch n.op {
+	case "==":
+		return equal(l, r), nil
+	case "!=":
+		return !equal(l, r), nil
+	case "in":
+		list, ok := r.([]any)
+		if !ok {
+			return nil, fmt.Errorf("operator in needs a list, got %s", typeName(r))
+		}
+		for _, item := range list {
+			if equal(l, item) {
+				return true, nil
+			}
+		}
+		return false, nil
+	case "<", "<=", ">", ">=":
+		return compare(n.op, l, r)
+	case "+":
+		if ls, ok := l.(string); ok {
+			if rs, ok := r.(string); ok {
+				return ls + rs, nil
+			}
+		}
+		fallthrough
+	case "-", "*", "/":
+		lf, lok := l.(float64)
+		rf, rok := r.(float64)
+		if !lok || !rok {
+			return nil, fmt.Errorf("operator %s needs numbers, got %s and %s", n.op, typeName(l), typeName(r))
+		}
+		switch n.op {
+		case "+":
+			return lf + rf, nil
+		case "-":
+			return lf - rf, nil
+		case "*":
+			return lf * rf, nil
+		default:
+			if rf == 0 {
+				return nil, fmt.Errorf("division by zero")
+			}
+			return lf / rf, nil
+		}
+	}
+	return nil, fmt.Errorf("unknown operator %s", n.op)
+}
+
+func compare(op string, l, r any) (bool, error) {
+	var c int
+	switch lv := l.(type) {
+	case float64:
+		rv, ok := r.(float64)
+		if !ok {
+			return false, fmt.Errorf("cannot compare number with %s", typeName(r))
+		}
+		switch {
+		case lv < rv:
+			c = -1
+		case lv > rv:
+			c = 1
+		}
+	case string:
+		rv, ok := r.(string)
+		if !ok {
+			return false, fmt.Errorf("cannot compare string with %s", typeName(r))
+		}
+		c = strings.Compare(lv, rv)
+	default:
+		return false, fmt.Errorf("operator %s needs numbers or strings, got %s", op, typeName(l))
+	}
+	switch op {
+	case "<":
+		return c < 0, nil
+	case "<=":
+		return c <= 0, nil
+	case ">":
+		return c > 0, nil
+	default:
+		return c >= 0, nil
+	}
+}
+
+func equal(l, r any) bool {
+	switch lv := l.(type) {
+	case []any:
+		rv, ok := r.([]any)
+		if !ok || len(lv) != len(rv) {
+			return false
+		}
+		for i := range lv {
+			if !equal(lv[i], rv[i]) {
+				return false
+			}
+		}
+		return true
+	case nil, bool, float64, string:
+		return l == r
+	}
+	return false
+}
+
+// normalize maps fact values onto the language's types: integers become
+// float64, named string types (such as enums) plain strings and string
+// slices lists.
+func normalize(v any) any {
+	switch v := v.(type) {
+	case nil, bool, float64, string, []any:
+		return v
+	case []string:
+		out := make([]any, len(v))
+		for i, s := range v {
+			out[i] = s
+		}
+		return out
+	}
+	rv := reflect.ValueOf(v)
+	switch rv.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return float64(rv.Int())
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return float64(rv.Uint())
+	case reflect.Float32:
+		return rv.Float()
+	case reflect.String:
+		return rv.String()
+	case reflect.Bool:
+		return rv.Bool()
+	}
+	return v
+}
+
+func typeName(v any) string {
+	switch v.(type) {
+	case nil:
+		return "null"
+	case bool:
+		return "boolean"
+	case float64:
+		return "number"
+	case string:
+		return "string"
+	case []any:
+		return "list"
+	}
+	return fmt.Sprintf("%T", v)
+}
+
+// --- Lexer ---------------------------------------------------------------
+
+type tokKind int
+
+const (
+	tokEOF tokKind = iota
+	tokNumber
+	tokString
+	tokIdent
+	tokOp
+)
+
+type token struct {
+	kind tokKind
+	text string
+	pos  int
+	num  float64
+}
+
+func (t token) String() string {
+	switch t.kind {
+	case tokEOF:
+		return "end of expression"
+	case tokString:
+		return strconv.Quote(t.text)
+	}
+	return fmt.Sprintf("%q", t.text)
+}
+
+type lexer struct {
+	src string
+	pos int
+}
+
+var twoCharOps = []string{"&&", "||", "==", "!=", "<=", ">="}
+
+func (l *lexer) next() (token, error) {
+	for l.pos < len(l.src) && unicode.IsSpace(rune(l.src[l.pos])) {
+		l.pos++
+	}
+	start := l.pos
+	if l.pos >= len(l.src) {
+		return token{kind: tokEOF, pos: start}, nil
+	}
+	ch := l.src[l.pos]
+
+	switch {
+	case ch >= '0' && ch <= '9':
+		for l.pos < len(l.src) && (isDigit(l.src[l.pos]) || l.src[l.pos] == '.') {
+			l.pos++
+		}
+		text := l.src[start:l.pos]
+		f, err := strconv.ParseFloat(text, 64)
+		if err != nil {
+			return token{}, fmt.Errorf("invalid number %q at offset %d", text, start)
+		}
+		return token{kind: tokNumber, text: text, pos: start, num: f}, nil
+
+	case ch == '"' || ch == '\'':
+		l.pos++
+		var sb strings.Builder
+		for l.pos < len(l.src) && l.src[l.pos] != ch {
+			if l.src[l.pos] == '\\' && l.pos+1 < len(l.src) {
+				l.pos++
+			}
+			sb.WriteByte(l.src[l.pos])
+			l.pos++
+		}
+		if l.pos >= len(l.src) {
+			return token{}, fmt.Errorf("unterminated string at offset %d", start)
+		}
+		l.pos++
+		return token{kind: tokString, text: sb.String(), pos: start}, nil
+
+	case isIdentStart(ch):
+		for l.pos < len(l.src) && (isIdentStart(l.src[l.pos]) || isDigit(l.src[l.pos]) || l.src[l.pos] == '.') {
+			l.pos++
+		}
+		return token{kind: tokIdent, text: l.src[start:l.pos], pos: start}, nil
+	}
+
+	for _, op := range twoCharOps {
+		if strings.HasPrefix(l.src[l.pos:], op) {
+			l.pos += 2
+			return token{kind: tokOp, text: op, pos: start}, nil
+		}
+	}
+	if strings.ContainsRune("!<>+-*/()[],", rune(ch)) {
+		l.pos++
+		return token{kind: tokOp, text: string(ch), pos: start}, nil
+	}
+	return token{}, fmt.Errorf("unexpected character %q at offset %d", ch, start)
+}
+
+func isDigit(ch byte) bool { return ch >= '0' && ch <= '9' }
+
+func isIdentStart(ch byte) bool {
+	return ch == '_' || (ch >= 'a' && ch <= 'z') || (ch >= 'A' && ch <= 'Z')
+}
+
+// --- Parser --------------------------------------------------------------
+
+type parser struct {
+	lex lexer
+	tok token
+	err error
+}
+
+func (p *parser) next() {
+	if p.err != nil {
+		return
+	}
+	p.tok, p.err = p.lex.next()
+	if p.err != nil {
+		p.tok = token{kind: tokEOF, pos: p.lex.pos}
+	}
+}
+
+func (p *parser) errorf(format string, args ...any) error {
+	if p.err != nil {
+		return p.err
+	}
+	return fmt.Errorf("%s at offset %d", fmt.Sprintf(format, args...), p.tok.pos)
+}
+
+// is reports whether the current token is the operator or keyword op.
+func (p *parser) is(ops ...string) bool {
+	if p.tok.kind != tokOp && p.tok.kind != tokIdent {
+		return false
+	}
+	for _, op := range ops {
+		if p.tok.text == op {
+			return true
+		}
+	}
+	return false
+}
+
+var keywordOps = map[string]string{"and": "&&", "or": "||", "not": "!"}
+
+func (p *parser) op() string {
+	if op, ok := keywordOps[p.tok.text]; ok {
+		return op
+	}
+	return p.tok.text
+}
+
+func (p *parser) parseOr() (node, error) {
+	return p.parseBinary(p.parseAnd, "||", "or")
+}
+
+func (p *parser) parseAnd() (node, error) {
+	return p.parseBinary(p.parseNot, "&&", "and")
+}
+
+func (p *parser) parseBinary(operand func() (node, error), ops ...string) (node, error) {
+	l, err := operand()
+	if err != nil {
+		return nil, err
+	}
+	for p.is(ops...) {
+		op := p.op()
+		p.next()
+		r, err := operand()
+		if err != nil {
+			return nil, err
+		}
+		l = binaryNode{op: op, l: l, r: r}
+	}
+	return l, nil
+}
+
+func (p *parser) parseNot() (node, error) {
+	if p.is("!", "not") {
+		p.next()
+		x, err := p.parseNot()
+		if err != nil {
+			return nil, err
+		}
+		return unaryNode{op: "!", x: x}, nil
+	}
+	return p.parseComparison()
+}
+
+func (p *parser) parseComparison() (node, error) {
+	l, err := p.parseAdditive()
+	if err != nil {
+		return nil, err
+	}
+	if p.is("==", "!=", "<", "<=", ">", ">=", "in") {
+		op := p.tok.text
+		p.next()
+		r, err := p.parseAdditive()
+		if err != nil {
+			return nil, err
+		}
+		return binaryNode{op: op, l: l, r: r}, nil
+	}
+	return l, nil
+}
+
+func (p *parser) parseAdditive() (node, error) {
+	return p.parseBinary(p.parseMultiplicative, "+", "-")
+}
+
+func (p *parser) parseMultiplicative() (node, error) {
+	return p.parseBinary(p.parseUnary, "*", "/")
+}
+
+func (p *parser) parseUnary() (node, error) {
+	if p.is("-") {
+		p.next()
+		x, err := p.parseUnary()
+		if err != nil {
+			return nil, err
+		}
+		return unaryNode{op: "-", x: x}, nil
+	}
+	return p.parsePrimary()
+}
+
+func (p *parser) parsePrimary() (node, error) {
+	tok := p.tok
+	switch tok.kind {
+	case tokNumber:
+		p.next()
+		return literalNode{value: tok.num}, p.err
+	case tokString:
+		p.next()
+		return literalNode{value: tok.text}, p.err
+	case tokIdent:
+		switch tok.text {
+		case "true", "false":
+			p.next()
+			return literalNode{value: tok.text == "true"}, p.err
+		case "null":
+			p.next()
+			return literalNode{value: nil}, p.err
+		case "and", "or", "not", "in":
+			return nil, p.errorf("unexpected %s", tok)
+		}
+		p.next()
+		return identNode(tok.text), p.err
+	case tokOp:
+		switch tok.text {
+		case "(":
+			p.next()
+			x, err := p.parseOr()
+			if err != nil {
+				return nil, err
+			}
+			if !p.is(")") {
+				return nil, p.errorf("expected ')', got %s", p.tok)
+			}
+			p.next()
+			return x, p.err
+		case "[":
+			p.next()
+			var list listNode
+			for !p.is("]") {
+				x, err := p.parseOr()
+				if err != nil {
+					return nil, err
+				}
+				list = append(list, x)
+				if !p.is(",") {
+					break
+				}
+				p.next()
+			}
+			if !p.is("]") {
+				return nil, p.errorf("expected ']', got %s", p.tok)
+			}
+			p.next()
+			return list, p.err
+		}
+	}
+	return nil, p.errorf("unexpected %s", tok)
+}
@@ -0,0 +1,191 @@
+package governance
+
+import (
+	"strings"
+	"testing"
+)
+
+type riskLevel string
+
+func TestExpr_Eval(t *testing.T) {
+	facts := Facts{
+		"score.available":    true,
+		"score.overall":      72,
+		"drift.breaking":     int32(2),
+		"feature.risk_level": riskLevel("HIGH"),
+		"feature.status":     "IN_PROGRESS",
+	}
+	cases := []struct {
+		src  string
+		want any
+	}{
+		{"score.overall >= 50", true},
+		{"!score.available || score.overall >= 80", false},
+		{"score.overall - drift.breaking * 10 > 50", true},
+		{"(score.overall + 8) / 2", 40.0},
+		{"feature.risk_level in ['HIGH', \"CRITICAL\"] and drift.breaking == 2", true},
+		{"not (feature.status == 'DRAFT')", true},
+		{"feature.status + '!'", "IN_PROGRESS!"},
+		{"-drift.breaking < 0", true},
+		{"null == null", true},
+		// Short-circuit: the unknown fact on the right is never read.
+		{"false && missing.fact > 1", false},
+	}
+	for _, tc := range cases {
+		expr, err := Compile(tc.src)
+		if err != nil {
+			t.Errorf("%s: compile failed: %v", tc.src, err)
+			continue
+		}
+		got, err := expr.Eval(facts)
+		if err != nil {
+			t.Errorf("%s: eval failed: %v", tc.src, err)
+			continue
+		}
+		if got != tc.want {
+			t.Errorf("%s: got %v, want %v", tc.src, got, tc.want)
+		}
+	}
+}
+
+func TestExpr_Errors(t *testing.T) {
+	for _, src := range []string{"", "score.overall >=", "(a", "a == 'x", "a # b", "[1, 2", "a and"} {
+		if _, err := Compile(src); err == nil {
+			t.Errorf("expected %q to fail to compile", src)
+		}
+	}
+
+	facts := Facts{"n": 1, "s": "x"}
+	for _, src := range []string{"n + s", "n < s", "!n", "n / 0", "s in s", "unknown > 1", "n && true"} {
+		expr, err := Compile(src)
+		if err != nil {
+			t.Fatalf("%s: compile failed: %v", src, err)
+		}
+		if _, err := expr.Eval(facts); err == nil {
+			t.Errorf("expected %q to fail to evaluate", src)
+		}
+	}
+}
+
+func TestPolicy_Evaluate(t *testing.T) {
+	policy := Policy{Rules: []Rule{
+		{Name: "min-score", Gate: GateBuild, Condition: "score.overall >= 60", Severity: SeverityBlock, Message: "Score {score.overall} is below 60"},
+		{Name: "testable", Gate: GateBuild, Condition: "requirements.untestable == 0", Severity: SeverityWarn, Message: "{requirements.untestable} requirement(s) are not testable"},
+		{Name: "no-drift", Gate: GateDeploy, Condition: "drift.breaking == 0", Severity: SeverityBlock},
+		{Name: "broken", Gate: GateBuild, Condition: "feature.risk_level > 3", Severity: SeverityWarn},
+	}}
+	if err := policy.Validate(); err != nil {
+		t.Fatalf("Validate failed: %v", err)
+	}
+
+	facts := Facts{"score.overall": 55, "requirements.untestable": 2, "drift.breaking": 0, "feature.risk_level": "LOW"}
+	build := policy.Evaluate(GateBuild, facts)
+	if build.Allowed || len(build.Findings) != 3 {
+		t.Fatalf("expected build to be blocked with 3 findings, got %+v", build)
+	}
+	reasons := build.Reasons()
+	if reasons[0] != "Score 55 is below 60" || reasons[1] != "Warning: 2 requirement(s) are not testable" {
+		t.Errorf("unexpected reasons %q", reasons)
+	}
+	if !strings.Contains(reasons[2], `"broken" could not be evaluated`) {
+		t.Errorf("expected an evaluation error to surface as a finding, got %q", reasons[2])
+	}
+
+	deploy := policy.Evaluate(GateDeploy, facts)
+	if !deploy.Allowed || len(deploy.Findings) != 0 {
+		t.Errorf("expected deploy to pass, got %+v", deploy)
+	}
+
+	if got := policy.Facts(GateDeploy); len(got) != 1 || got[0] != "drift.breaking" {
+		t.Errorf("expected the deploy gate to read only drift.breaking, got %v", got)
+	}
+}
+
+func TestResult_Waive(t *testing.T) {
+	policy := Policy{Rules: []Rule{
+		{Name: "min-score", Gate: GateBuild, Condition: "score.overall >= 60", Severity: SeverityBlock, Message: "Score {score.overall} is below 60"},
+		{Name: "no-drift", Gate: GateBuild, Condition: "drift.breaking == 0", Severity: SeverityBlock, Message: "Breaking drift"},
+	}}
+	result := policy.Evaluate(GateBuild, Facts{"score.overall": 55, "drift.breaking": 1})
+
+	partly := result.Waive(map[string]string{"min-score": "w1"})
+	if partly.Allowed {
+		t.Errorf("expected the unwaived no-drift finding to keep blocking")
+	}
+	if result.Findings[0].Waived {
+		t.Errorf("Waive must not modify the original result")
+	}
+
+	waived := result.Waive(map[string]string{"min-score": "w1", "no-drift": "w2"})
+	if !waived.Allowed {
+		t.Fatalf("expected every blocking finding to be waived, got %+v", waived)
+	}
+	if got := waived.Reasons(); got[0] != "Waived (w1): Score 55 is below 60" || got[1] != "Waived (w2): Breaking drift" {
+		t.Errorf("unexpected reasons %q", got)
+	}
+	if !policy.HasRule(GateBuild, "no-drift") || policy.HasRule(GateDeploy, "no-drift") {
+		t.Errorf("HasRule must match the rule name within its gate")
+	}
+}
+
+func TestPolicy_Validate(t *testing.T) {
+	if err := DefaultPolicy.Validate(); err != nil {
+		t.Fatalf("default policy is invalid: %v", err)
+	}
+	cases := map[string]Rule{
+		"missing name":     {Gate: GateBuild, Condition: "true", Severity: SeverityBlock},
+		"unknown gate":     {Name: "r", Gate: "SHIP", Condition: "true", Severity: SeverityBlock},
+		"unknown severity": {Name: "r", Gate: GateBuild, Condition: "true", Severity: "FATAL"},
+		"syntax error":     {Name: "r", Gate: GateBuild, Condition: "score.overall >", Severity: SeverityBlock},
+		"unknown fact":     {Name: "r", Gate: GateBuild, Condition: "score.vibes > 1", Severity: SeverityBlock},
+		"unknown in msg":   {Name: "r", Gate: GateBuild, Condition: "true", Severity: SeverityBlock, Message: "{score.vibes}"},
+	}
+	for name, rule := range cases {
+		if err := (Policy{Rules: []Rule{rule}}).Validate(); err == nil {
+			t.Errorf("%s: expected validation to fail", name)
+		}
+	}
+	dup := Rule{Name: "r", Gate: GateBuild, Condition: "true", Severity: SeverityWarn}
+	if err := (Policy{Rules: []Rule{dup, dup}}).Validate(); err == nil {
+		t.Errorf("expected duplicate rule names to be rejected")
+	}
+}
+
+func TestQuorum_Evaluate(t *testing.T) {
+	q := DefaultReviewPolicy.HighRisk
+	cases := []struct {
+		approvers []string
+		met       bool
+		missing   int
+	}{
+		{nil, false, 2},
+		{[]string{"REVIEWER"}, false, 2},
+		{[]string{"REVIEWER", "ENGINEER"}, false, 1},
+		{[]string{"REVIEWER", "ADMIN"}, true, 0},
+		{[]string{"OWNER", "REVIEWER"}, true, 0},
+		{[]string{"ADMIN"}, false, 1},
+	}
+	for _, tc := range cases {
+		met, missing := q.Evaluate(tc.approvers)
+		if met != tc.met || len(missing) != tc.missing {
+			t.Errorf("%v: expected met=%v with %d missing, got met=%v missing=%v", tc.approvers, tc.met, tc.missing, met, missing)
+		}
+	}
+
+	// An OWNER only stands in for ADMIN when no ADMIN approved.
+	both := Quorum{MinApprovals: 2, RequiredRoles: []string{"ADMIN", "OWNER"}}
+	if met, missing := both.Evaluate([]string{"OWNER", "ADMIN"}); !met {
+		t.Errorf("expected OWNER and ADMIN approvals to meet the quorum, missing %v", missing)
+	}
+
+	invalid := []ReviewPolicy{
+		{Standard: Quorum{MinApprovals: 0}, HighRisk: Quorum{MinApprovals: 1}},
+		{Standard: Quorum{MinApprovals: 1}, HighRisk: Quorum{MinApprovals: 1, RequiredRoles: []string{"ADMIN", "OWNER"}}},
+		{Standard: Quorum{MinApprovals: 1}, HighRisk: Quorum{MinApprovals: 2, RequiredRoles: []string{"CTO"}}},
+	}
+	for _, r := range invalid {
+		if err := (Policy{Review: &r}).Validate(); err == nil {
+			t.Errorf("expected review policy %+v to be rejected", r)
+		}
+	}
+}
@@ -0,0 +1,302 @@
+// Package governance evaluates declarative, per-project governance policies
+// that gate building and deploying roadmap features.
+package governance
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// Gate is the lifecycle step a rule guards.
+type Gate string
+
+const (
+	GateBuild  Gate = "BUILD"
+	GateDeploy Gate = "DEPLOY"
+)
+
+// Severity decides what a failing rule does: BLOCK denies the gate, WARN
+// only reports.
+type Severity string
+
+const (
+	SeverityBlock Severity = "BLOCK"
+	SeverityWarn  Severity = "WARN"
+)
+
+// Rule is a named condition that must hold for a feature to pass a gate.
+// Message is shown when it does not; {fact.name} placeholders are replaced
+// with the fact's value, e.g. "Overall score {score.overall} is below 50".
+type Rule struct {
+	Name        string   `json:"name"`
+	Description string   `json:"description,omitempty"`
+	Gate        Gate     `json:"gate"`
+	Condition   string   `json:"condition"`
+	Severity    Severity `json:"severity"`
+	Message     string   `json:"message,omitempty"`
+}
+
+// Policy is the ordered rule set of a project, plus the review quorums AI
+// proposals must reach.
+type Policy struct {
+	Rules  []Rule        `json:"rules"`
+	Review *ReviewPolicy `json:"review,omitempty"`
+}
+
+// Facts are the values conditions are evaluated against, keyed by fact name.
+type Facts map[string]any
+
+// KnownFacts documents every fact a condition may read. The service computes
+// only the fact groups (the part before the dot) a policy references.
+var KnownFacts = map[string]string{
+	"score.available":            "whether feature intelligence has been calculated",
+	"score.overall":              "overall intelligence score, 0-100",
+	"score.completeness":         "spec completeness score, 0-100",
+	"score.contract_integrity":   "contract integrity score, 0-100",
+	"score.variable_coverage":    "variable coverage score, 0-100",
+	"score.dependency_stability": "dependency stability score, 0-100",
+	"score.drift_risk":           "drift risk score, 0-100 (100 means no drift)",
+	"score.test_coverage":        "test coverage score, 0-100",
+	"score.llm_confidence":       "LLM confidence score, 0-100",
+	"proposals.pending":          "AI proposals awaiting review",
+	"drift.breaking":             "breaking and critical contract changes since the last snapshot",
+	"drift.non_breaking":         "additive contract changes since the last snapshot",
+	"alignment.available":        "whether the project has an alignment report",
+	"alignment.score":            "project alignment score, 0-100",
+	"alignment.conflicts":        "alignment conflicts involving the feature",
+	"alignment.critical":         "critical or error alignment conflicts involving the feature",
+	"requirements.total":         "requirements of the feature",
+	"requirements.testable":      "requirements marked testable",
+	"requirements.untestable":    "requirements not marked testable",
+	"feature.type":               "roadmap item type",
+	"feature.status":             "roadmap item status",
+	"feature.priority":           "roadmap item priority",
+	"feature.risk_level":         "LOW, MEDIUM or HIGH",
+	"feature.breaking_change":    "whether the item is flagged as a breaking change",
+	"contracts.count":            "contracts defined for the feature",
+}
+
+// DefaultPolicy is applied to projects without a stored policy.
+var DefaultPolicy = Policy{Rules: []Rule{
+	{
+		Name:      "min-overall-score",
+		Gate:      GateBuild,
+		Condition: "!score.available || score.overall >= 50",
+		Severity:  SeverityBlock,
+		Message:   "Overall Intelligence Score is too low ({score.overall} < 50)",
+	},
+	{
+		Name:      "no-pending-proposals",
+		Gate:      GateBuild,
+		Condition: "proposals.pending == 0",
+		Severity:  SeverityBlock,
+		Message:   "Feature has {proposals.pending} pending AI proposal(s) awaiting review before it can be built",
+	},
+	{
+		Name:      "min-overall-score",
+		Gate:      GateDeploy,
+		Condition: "!score.available || score.overall >= 80",
+		Severity:  SeverityBlock,
+		Message:   "Overall Intelligence Score is too low for deployment ({score.overall} < 80)",
+	},
+	{
+		Name:      "complete-spec",
+		Gate:      GateDeploy,
+		Condition: "!score.available || score.completeness >= 100",
+		Severity:  SeverityBlock,
+		Message:   "Feature Spec must be 100% complete before deployment",
+	},
+	{
+		Name:      "no-pending-proposals",
+		Gate:      GateDeploy,
+		Condition: "proposals.pending == 0",
+		Severity:  SeverityBlock,
+		Message:   "All pending AI proposals must be reviewed before deployment",
+	},
+}}
+
+var placeholderPattern = regexp.MustCompile(`\{([a-z_]+\.[a-z_]+)\}`)
+
+// Validate checks that rules are named, target a known gate and severity, and
+// that their conditions compile and only read known facts.
+func (p Policy) Validate() error {
+	seen := make(map[string]bool)
+	for i, r := range p.Rules {
+		if strings.TrimSpace(r.Name) == "" {
+			return fmt.Errorf("rule %d: name is required", i)
+		}
+		switch r.Gate {
+		case GateBuild, GateDeploy:
+		default:
+			return fmt.Errorf("rule %q: unknown gate %q", r.Name, r.Gate)
+		}
+		key := string(r.Gate) + "/" + r.Name
+		if seen[key] {
+			return fmt.Errorf("rule %q: duplicate name for gate %s", r.Name, r.Gate)
+		}
+		seen[key] = true
+		switch r.Severity {
+		case SeverityBlock, SeverityWarn:
+		default:
+			return fmt.Errorf("rule %q: unknown severity %q", r.Name, r.Severity)
+		}
+		expr, err := Compile(r.Condition)
+		if err != nil {
+			return fmt.Errorf("rule %q: condition: %w", r.Name, err)
+		}
+		for _, name := range append(expr.Facts(), messageFacts(r.Message)...) {
+			if _, ok := KnownFacts[name]; !ok {
+				return fmt.Errorf("rule %q: unknown fact %q", r.Name, name)
+			}
+		}
+	}
+	if p.Review != nil {
+		if err := p.Review.Validate(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Facts returns the facts read by the gate's rules and messages.
+func (p Policy) Facts(gate Gate) []string {
+	var names []string
+	seen := make(map[string]bool)
+	for _, r := range p.Rules {
+		if r.Gate != gate {
+			continue
+		}
+		var refs []string
+		if expr, err := Compile(r.Condition); err == nil {
+			refs = expr.Facts()
+		}
+		for _, name := range append(refs, messageFacts(r.Message)...) {
+			if !seen[name] {
+				seen[name] = true
+				names = append(names, name)
+			}
+		}
+	}
+	return names
+}
+
+// Finding is a rule that did not hold. A waived finding is covered by an
+// approved exception, named by Waiver, and no longer blocks the gate.
+type Finding struct {
+	Rule     string   `json:"rule"`
+	Severity Severity `json:"severity"`
+	Message  string   `json:"message"`
+	Waived   bool     `json:"waived,omitempty"`
+	Waiver   string   `json:"waiver,omitempty"`
+}
+
+// Result is the outcome of evaluating one gate.
+type Result struct {
+	Gate     Gate      `json:"gate"`
+	Allowed  bool      `json:"allowed"`
+	Findings []Finding `json:"findings"`
+}
+
+// Reasons renders the findings as the human-readable messages governance
+// checks return; warnings are prefixed so they read as advisory.
+func (r Result) Reasons() []string {
+	var reasons []string
+	for _, f := range r.Findings {
+		if f.Waived {
+			reasons = append(reasons, fmt.Sprintf("Waived (%s): %s", f.Waiver, f.Message))
+			continue
+		}
+		if f.Severity == SeverityWarn {
+			reasons = append(reasons, "Warning: "+f.Message)
+			continue
+		}
+		reasons = append(reasons, f.Message)
+	}
+	return reasons
+}
+
+// Waive marks the findings of the rules in waivers, which maps rule names
+// to the waiver that excepts them, as waived and re-decides the gate from the
+// findings that remain.
+func (r Result) Waive(waivers map[string]string) Result {
+	out := Result{Gate: r.Gate, Allowed: true, Findings: make([]Finding, len(r.Findings))}
+	for i, f := range r.Findings {
+		if waiver, ok := waivers[f.Rule]; ok {
+			f.Waived, f.Waiver = true, waiver
+		}
+		out.Findings[i] = f
+		if f.Severity == SeverityBlock && !f.Waived {
+			out.Allowed = false
+		}
+	}
+	return out
+}
+
+// HasRule reports whether the policy has a rule of that name for the gate.
+func (p Policy) HasRule(gate Gate, name string) bool {
+	for _, r := range p.Rules {
+		if r.Gate == gate && r.Name == name {
+			return true
+		}
+	}
+	return false
+}
+
+// Evaluate runs the gate's rules in order. A rule whose condition cannot be
+// evaluated fails with the evaluation error, so a broken rule never passes
+// silently.
+func (p Policy) Evaluate(gate Gate, facts Facts) Result {
+	result := Result{Gate: gate, Allowed: true, Findings: []Finding{}}
+	for _, r := range p.Rules {
+		if r.Gate != gate {
+			continue
+		}
+		var message string
+		expr, err := Compile(r.Condition)
+		if err == nil {
+			var ok bool
+			if ok, err = expr.EvalBool(facts); err == nil && ok {
+				continue
+			}
+		}
+		if err != nil {
+			message = fmt.Sprintf("Rule %q could not be evaluated: %v", r.Name, err)
+		} else if r.Message != "" {
+			message = renderMessage(r.Message, facts)
+		} else {
+			message = fmt.Sprintf("Rule %q failed: %s", r.Name, r.Condition)
+		}
+		result.Findings = append(result.Findings, Finding{Rule: r.Name, Severity: r.Severity, Message: message})
+		if r.Severity == SeverityBlock {
+			result.Allowed = false
+		}
+	}
+	return result
+}
+
+func messageFacts(message string) []string {
+	var names []string
+	for _, m := range placeholderPattern.FindAllStringSubmatch(message, -1) {
+		names = append(names, m[1])
+	}
+	return names
+}
+
+func renderMessage(message string, facts Facts) string {
+	return placeholderPattern.ReplaceAllStringFunc(message, func(m string) string {
+		v, ok := facts[m[1:len(m)-1]]
+		if !ok {
+			return m
+		}
+		switch v := normalize(v).(type) {
+		case float64:
+			return strconv.FormatFloat(v, 'f', -1, 64)
+		case nil:
+			return "null"
+		default:
+			return fmt.Sprint(v)
+		}
+	})
+}
@@ -0,0 +1,95 @@
+package governance
+
+import "fmt"
+
+// reviewerRoles mirrors the workspace roles that may review proposals.
+var reviewerRoles = map[string]bool{"OWNER": true, "ADMIN": true, "REVIEWER": true, "ENGINEER": true, "AI_AGENT": true}
+
+// ReviewPolicy sets how many approvals an AI proposal needs before it is
+// applied. High-risk roadmap items (HIGH risk level, breaking change or
+// regression sensitive) use the HighRisk quorum, all others the Standard one.
+type ReviewPolicy struct {
+	Standard Quorum `json:"standard"`
+	HighRisk Quorum `json:"high_risk"`
+}
+
+// Quorum is met once MinApprovals distinct reviewers have approved and every
+// role in RequiredRoles is among them. An OWNER approval satisfies ADMIN.
+type Quorum struct {
+	MinApprovals  int      `json:"min_approvals"`
+	RequiredRoles []string `json:"required_roles,omitempty"`
+}
+
+// DefaultReviewPolicy keeps single-reviewer approval for routine items and
+// asks for two approvals including an ADMIN on high-risk ones.
+var DefaultReviewPolicy = ReviewPolicy{
+	Standard: Quorum{MinApprovals: 1},
+	HighRisk: Quorum{MinApprovals: 2, RequiredRoles: []string{"ADMIN"}},
+}
+
+// ReviewPolicy returns the policy's review quorums, or the defaults if the
+// policy does not set them.
+func (p Policy) ReviewPolicy() ReviewPolicy {
+	if p.Review == nil {
+		return DefaultReviewPolicy
+	}
+	return *p.Review
+}
+
+// Validate checks that each quorum needs at least one approval, names known
+// roles and does not require more roles than approvals.
+func (r ReviewPolicy) Validate() error {
+	for _, named := range []struct {
+		name string
+		q    Quorum
+	}{{"standard", r.Standard}, {"high_risk", r.HighRisk}} {
+		name, q := named.name, named.q
+		if q.MinApprovals < 1 {
+			return fmt.Errorf("review %s: min_approvals must be at least 1", name)
+		}
+		if len(q.RequiredRoles) > q.MinApprovals {
+			return fmt.Errorf("review %s: %d required roles cannot be met by %d approvals", name, len(q.RequiredRoles), q.MinApprovals)
+		}
+		for _, role := range q.RequiredRoles {
+			if !reviewerRoles[role] {
+				return fmt.Errorf("review %s: unknown role %q", name, role)
+			}
+		}
+	}
+	return nil
+}
+
+// Evaluate reports whether the approvals, given as the approving reviewers'
+// roles, meet the quorum, and what is still missing otherwise.
+func (q Quorum) Evaluate(approverRoles []string) (bool, []string) {
+	var missing []string
+	if len(approverRoles) < q.MinApprovals {
+		missing = append(missing, fmt.Sprintf("%d of %d required approvals", len(approverRoles), q.MinApprovals))
+	}
+
+	// Each required role consumes a distinct approver. Exact matches are
+	// assigned first so an OWNER is only spent on ADMIN when no ADMIN approved.
+	used := make([]bool, len(approverRoles))
+	take := func(want string) bool {
+		for i, approver := range approverRoles {
+			if !used[i] && approver == want {
+				used[i] = true
+				return true
+			}
+		}
+		return false
+	}
+	var unmet []string
+	for _, role := range q.RequiredRoles {
+		if !take(role) {
+			unmet = append(unmet, role)
+		}
+	}
+	for _, role := range unmet {
+		if role == "ADMIN" && take("OWNER") {
+			continue
+		}
+		missing = append(missing, fmt.Sprintf("an approval from role %s", role))
+	}
+	return len(missing) == 0, missing
+}
@@ -0,0 +1,29 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/SpecForgeVC/SpecForge/internal/domain/governance"
+	"github.com/google/uuid"
+)
+
+// ProjectGovernancePolicy is one version of a project's governance policy.
+// Versions are append-only; the newest one is in force. Version 0 denotes the
+// built-in default policy of a project that has not stored one.
+type ProjectGovernancePolicy struct {
+	ProjectID uuid.UUID         `json:"project_id"`
+	Version   int               `json:"version"`
+	Policy    governance.Policy `json:"policy"`
+	CreatedBy uuid.UUID         `json:"created_by,omitempty"`
+	CreatedAt time.Time         `json:"created_at"`
+}
+
+// GovernanceEvaluation is the outcome of a governance gate for a feature,
+// together with the facts, policy version and waivers it was decided on.
+type GovernanceEvaluation struct {
+	FeatureID     uuid.UUID          `json:"feature_id"`
+	PolicyVersion int                `json:"policy_version"`
+	Result        governance.Result  `json:"result"`
+	Facts         governance.Facts   `json:"facts"`
+	Waivers       []GovernanceWaiver `json:"waivers,omitempty"`
+}
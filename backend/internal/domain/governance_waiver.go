@@ -0,0 +1,54 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/SpecForgeVC/SpecForge/internal/domain/governance"
+	"github.com/google/uuid"
+)
+
+// WaiverStatus is where a governance waiver is in its lifecycle. EXPIRED is
+// never stored: it is how a pending or approved waiver reads once its expiry
+// has passed.
+type WaiverStatus string
+
+const (
+	WaiverPending  WaiverStatus = "PENDING"
+	WaiverApproved WaiverStatus = "APPROVED"
+	WaiverRejected WaiverStatus = "REJECTED"
+	WaiverRevoked  WaiverStatus = "REVOKED"
+	WaiverExpired  WaiverStatus = "EXPIRED"
+)
+
+// GovernanceWaiver is a sanctioned exception to one governance rule for one
+// roadmap item. While approved and unexpired, a failing finding of that rule
+// is reported as waived and does not block the gate.
+type GovernanceWaiver struct {
+	ID              uuid.UUID       `json:"id"`
+	ProjectID       uuid.UUID       `json:"project_id"`
+	RoadmapItemID   uuid.UUID       `json:"roadmap_item_id"`
+	Gate            governance.Gate `json:"gate"`
+	Rule            string          `json:"rule"`
+	Justification   string          `json:"justification"`
+	Status          WaiverStatus    `json:"status"`
+	RequestedBy     uuid.UUID       `json:"requested_by"`
+	DecidedBy       uuid.UUID       `json:"decided_by,omitempty"`
+	DecisionComment string          `json:"decision_comment,omitempty"`
+	DecidedAt       *time.Time      `json:"decided_at,omitempty"`
+	ExpiresAt       time.Time       `json:"expires_at"`
+	CreatedAt       time.Time       `json:"created_at"`
+}
+
+// StatusAt returns the waiver's status as of now, reporting pending and
+// approved waivers past their expiry as EXPIRED.
+func (w GovernanceWaiver) StatusAt(now time.Time) WaiverStatus {
+	if (w.Status == WaiverPending || w.Status == WaiverApproved) && !now.Before(w.ExpiresAt) {
+		return WaiverExpired
+	}
+	return w.Status
+}
+
+// ActiveAt reports whether the waiver excepts its rule at now.
+func (w GovernanceWaiver) ActiveAt(now time.Time) bool {
+	return w.StatusAt(now) == WaiverApproved
+}
@@ -0,0 +1,60 @@
+package domain
+
+import "github.com/google/uuid"
+
+// OpenAPIImportInput imports an OpenAPI 3.x document into a project's
+// contracts. Operations go to RoadmapItemID, or with GroupByTag to one roadmap
+// item per first tag; untagged operations then fall back to RoadmapItemID, or
+// to an item named after the document's title.
+type OpenAPIImportInput struct {
+	ProjectID     uuid.UUID
+	RoadmapItemID uuid.UUID
+	GroupByTag    bool
+	Document      []byte
+	UserID        uuid.UUID
+}
+
+// OpenAPIImportStatus is what an import did with one operation.
+type OpenAPIImportStatus string
+
+const (
+	ImportCreated  OpenAPIImportStatus = "CREATED"
+	ImportUpdated  OpenAPIImportStatus = "UPDATED"
+	ImportSkipped  OpenAPIImportStatus = "SKIPPED"
+	ImportConflict OpenAPIImportStatus = "CONFLICT"
+)
+
+// OpenAPIImportOperation reports the contract and variables of one
+// operation. Reason explains skips and conflicts.
+type OpenAPIImportOperation struct {
+	Method           string              `json:"method"`
+	Path             string              `json:"path"`
+	OperationID      string              `json:"operation_id,omitempty"`
+	Status           OpenAPIImportStatus `json:"status"`
+	Reason           string              `json:"reason,omitempty"`
+	ContractID       *uuid.UUID          `json:"contract_id,omitempty"`
+	RoadmapItemID    *uuid.UUID          `json:"roadmap_item_id,omitempty"`
+	VariablesCreated int                 `json:"variables_created"`
+	VariablesUpdated int                 `json:"variables_updated"`
+}
+
+// OpenAPIImportRoadmapItem is a roadmap item operations were imported into.
+type OpenAPIImportRoadmapItem struct {
+	ID      uuid.UUID `json:"id"`
+	Title   string    `json:"title"`
+	Tag     string    `json:"tag,omitempty"`
+	Created bool      `json:"created"`
+}
+
+// OpenAPIImportResult summarizes an import.
+type OpenAPIImportResult struct {
+	ProjectID    uuid.UUID                  `json:"project_id"`
+	Title        string                     `json:"title"`
+	Version      string                     `json:"version"`
+	Created      int                        `json:"created"`
+	Updated      int                        `json:"updated"`
+	Skipped      int                        `json:"skipped"`
+	Conflicts    int                        `json:"conflicts"`
+	RoadmapItems []OpenAPIImportRoadmapItem `json:"roadmap_items"`
+	Operations   []OpenAPIImportOperation   `json:"operations"`
+}
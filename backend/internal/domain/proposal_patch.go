@@ -0,0 +1,193 @@
+package domain
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/SpecForgeVC/SpecForge/internal/jsonpatch"
+	"github.com/google/uuid"
+)
+
+// PatchTargetType names the kinds of entity a JSON_PATCH proposal can change.
+type PatchTargetType string
+
+const (
+	PatchTargetRoadmapItem    PatchTargetType = "ROADMAP_ITEM"
+	PatchTargetContract       PatchTargetType = "CONTRACT"
+	PatchTargetRequirement    PatchTargetType = "REQUIREMENT"
+	PatchTargetVariable       PatchTargetType = "VARIABLE"
+	PatchTargetValidationRule PatchTargetType = "VALIDATION_RULE"
+	PatchTargetUIRoadmapItem  PatchTargetType = "UI_ROADMAP_ITEM"
+)
+
+// PatchProposalDiff is the diff of a JSON_PATCH proposal:
+//
+//	{"target_type": "CONTRACT", "target_id": "<uuid>", "patch": [<RFC 6902 operations>]}
+//
+// Paths address the entity's JSON representation without its identity and
+// ownership fields (id, project and parent ids, timestamps), which cannot be
+// patched.
+type PatchProposalDiff struct {
+	TargetType PatchTargetType
+	TargetID   uuid.UUID
+	Patch      jsonpatch.Patch
+}
+
+// ParsePatchProposalDiff reads a JSON_PATCH proposal diff.
+func ParsePatchProposalDiff(diff map[string]interface{}) (*PatchProposalDiff, error) {
+	targetType, _ := diff["target_type"].(string)
+	if targetType == "" {
+		return nil, fmt.Errorf("target_type is required")
+	}
+	rawID, _ := diff["target_id"].(string)
+	targetID, err := uuid.Parse(rawID)
+	if err != nil {
+		return nil, fmt.Errorf("target_id must be a UUID")
+	}
+	rawPatch, ok := diff["patch"]
+	if !ok {
+		return nil, fmt.Errorf("patch is required")
+	}
+	patch, err := jsonpatch.Decode(rawPatch)
+	if err != nil {
+		return nil, err
+	}
+	return &PatchProposalDiff{TargetType: PatchTargetType(targetType), TargetID: targetID, Patch: patch}, nil
+}
+
+// PatchPreview shows reviewers what a JSON_PATCH proposal would do to its
+// target as it stands now.
+type PatchPreview struct {
+	ProposalID uuid.UUID              `json:"proposal_id"`
+	TargetType PatchTargetType        `json:"target_type"`
+	TargetID   uuid.UUID              `json:"target_id"`
+	Before     map[string]interface{} `json:"before"`
+	After      map[string]interface{} `json:"after"`
+	Changes    []jsonpatch.Change     `json:"changes"`
+}
+
+// ProposalTarget is the entity a proposal changes and the JSON Pointers,
+// into that entity's document, of the values it changes.
+type ProposalTarget struct {
+	Type  PatchTargetType
+	ID    uuid.UUID
+	Paths []string
+}
+
+// TargetOf returns what the proposal changes, or nil for proposals such as
+// ADD_VARIABLE that create entities rather than change one.
+func TargetOf(p AiProposal) (*ProposalTarget, error) {
+	switch p.ProposalType {
+	case JSONPatch:
+		d, err := ParsePatchProposalDiff(p.Diff)
+		if err != nil {
+			return nil, err
+		}
+		return &ProposalTarget{Type: d.TargetType, ID: d.TargetID, Paths: patchPaths(d.Patch)}, nil
+
+	case EditDescription:
+		t := &ProposalTarget{Type: PatchTargetRoadmapItem, ID: p.RoadmapItemID}
+		for _, field := range []string{"description", "business_context", "technical_context"} {
+			if v, ok := p.Diff[field].(string); ok && v != "" {
+				t.Paths = append(t.Paths, "/"+field)
+			}
+		}
+		return t, nil
+
+	case ModifySchema, RemoveField:
+		rawID, _ := p.Diff["contract_id"].(string)
+		contractID, err := uuid.Parse(rawID)
+		if err != nil {
+			return nil, fmt.Errorf("contract_id must be a UUID")
+		}
+		t := &ProposalTarget{Type: PatchTargetContract, ID: contractID}
+		if p.ProposalType == RemoveField {
+			field, _ := p.Diff["field"].(string)
+			section := "input_schema"
+			if p.Diff["schema"] == "output" {
+				section = "output_schema"
+			}
+			t.Paths = []string{"/" + section + "/" + escapePointer(field), "/" + section + "/properties/" + escapePointer(field)}
+			return t, nil
+		}
+		if rawPatch, ok := p.Diff["json_patch"]; ok {
+			patch, err := jsonpatch.Decode(rawPatch)
+			if err != nil {
+				return nil, err
+			}
+			t.Paths = patchPaths(patch)
+			return t, nil
+		}
+		for _, section := range []string{"input_schema", "output_schema"} {
+			if changes, ok := p.Diff[section].(map[string]interface{}); ok {
+				for k := range changes {
+					t.Paths = append(t.Paths, "/"+section+"/"+escapePointer(k))
+				}
+			}
+		}
+		return t, nil
+	}
+	return nil, nil
+}
+
+// patchPaths lists the locations a patch reads or writes. Appends ("/-")
+// are attributed to the whole array.
+func patchPaths(patch jsonpatch.Patch) []string {
+	var paths []string
+	for _, op := range patch {
+		paths = append(paths, strings.TrimSuffix(op.Path, "/-"))
+		if op.Op == jsonpatch.OpMove || op.Op == jsonpatch.OpCopy {
+			paths = append(paths, op.From)
+		}
+	}
+	return paths
+}
+
+func escapePointer(token string) string {
+	return strings.ReplaceAll(strings.ReplaceAll(token, "~", "~0"), "/", "~1")
+}
+
+// DocumentHash identifies a version of a target document. encoding/json
+// sorts map keys, so equal documents hash equally.
+func DocumentHash(doc map[string]interface{}) (string, error) {
+	data, err := json.Marshal(doc)
+	if err != nil {
+		return "", err
+	}
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:]), nil
+}
+
+// RebaseConflict is a value the proposal changes that was also changed on
+// the target since the proposal's base version.
+type RebaseConflict struct {
+	Path     string           `json:"path"`
+	Upstream jsonpatch.Change `json:"upstream"`
+}
+
+// RebaseConflicts compares the proposal's paths with what changed between
+// base and current. Paths conflict when one contains the other.
+func RebaseConflicts(paths []string, base, current map[string]interface{}) []RebaseConflict {
+	var conflicts []RebaseConflict
+	for _, change := range jsonpatch.Diff(base, current) {
+		for _, path := range paths {
+			if strings.HasPrefix(change.Path+"/", path+"/") || strings.HasPrefix(path+"/", change.Path+"/") {
+				conflicts = append(conflicts, RebaseConflict{Path: path, Upstream: change})
+				break
+			}
+		}
+	}
+	return conflicts
+}
+
+// RebaseResult reports a rebase. When Rebased is false the proposal was left
+// unchanged and Conflicts lists what has to be resolved by hand; without
+// conflicts the proposal was already based on the current version.
+type RebaseResult struct {
+	Proposal  *AiProposal      `json:"proposal"`
+	Rebased   bool             `json:"rebased"`
+	Conflicts []RebaseConflict `json:"conflicts,omitempty"`
+}
@@ -0,0 +1,43 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/SpecForgeVC/SpecForge/internal/domain/governance"
+	"github.com/google/uuid"
+)
+
+// ReviewDecision is a reviewer's vote on an AI proposal.
+type ReviewDecision string
+
+const (
+	ReviewApprove        ReviewDecision = "APPROVE"
+	ReviewRequestChanges ReviewDecision = "REQUEST_CHANGES"
+	ReviewReject         ReviewDecision = "REJECT"
+)
+
+// ProposalReview is one reviewer's vote. A reviewer's newer vote replaces
+// their earlier one; votes cast before a proposal is resubmitted are
+// superseded and no longer count towards the quorum.
+type ProposalReview struct {
+	ID         uuid.UUID      `json:"id"`
+	ProposalID uuid.UUID      `json:"proposal_id"`
+	ReviewerID uuid.UUID      `json:"reviewer_id"`
+	Role       Role           `json:"role"`
+	Decision   ReviewDecision `json:"decision"`
+	Comment    string         `json:"comment,omitempty"`
+	Superseded bool           `json:"superseded"`
+	CreatedAt  time.Time      `json:"created_at"`
+}
+
+// ProposalReviewState is a proposal together with its votes and how far it
+// is from the quorum its roadmap item requires.
+type ProposalReviewState struct {
+	Proposal   AiProposal        `json:"proposal"`
+	HighRisk   bool              `json:"high_risk"`
+	Quorum     governance.Quorum `json:"quorum"`
+	QuorumMet  bool              `json:"quorum_met"`
+	Missing    []string          `json:"missing,omitempty"`
+	Reviews    []ProposalReview  `json:"reviews"`
+	ApprovedBy []uuid.UUID       `json:"approved_by"`
+}
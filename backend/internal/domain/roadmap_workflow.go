@@ -0,0 +1,78 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/SpecForgeVC/SpecForge/internal/domain/governance"
+	"github.com/google/uuid"
+)
+
+// StatusTransitionRule is one edge of the roadmap item workflow. Roles lists
+// who may take it, Gate the governance gate that must pass first, and
+// RequiresReason whether the mover has to explain it (all back-edges do).
+type StatusTransitionRule struct {
+	From           RoadmapItemStatus `json:"from"`
+	To             RoadmapItemStatus `json:"to"`
+	Roles          []Role            `json:"roles"`
+	Gate           governance.Gate   `json:"gate,omitempty"`
+	RequiresReason bool              `json:"requires_reason"`
+}
+
+// RoadmapWorkflow is the enforced lifecycle
+// DRAFT → IN_REVIEW → APPROVED → IN_PROGRESS → COMPLETE, plus the back-edges
+// for requested changes, re-review, pausing work and reopening.
+var RoadmapWorkflow = []StatusTransitionRule{
+	{From: StatusDraft, To: StatusInReview, Roles: []Role{RoleOwner, RoleAdmin, RoleEngineer, RoleReviewer}},
+	{From: StatusInReview, To: StatusApproved, Roles: []Role{RoleOwner, RoleAdmin, RoleReviewer}},
+	{From: StatusInReview, To: StatusDraft, Roles: []Role{RoleOwner, RoleAdmin, RoleEngineer, RoleReviewer}, RequiresReason: true},
+	{From: StatusApproved, To: StatusInProgress, Roles: []Role{RoleOwner, RoleAdmin, RoleEngineer}, Gate: governance.GateBuild},
+	{From: StatusApproved, To: StatusInReview, Roles: []Role{RoleOwner, RoleAdmin, RoleReviewer}, RequiresReason: true},
+	{From: StatusInProgress, To: StatusComplete, Roles: []Role{RoleOwner, RoleAdmin, RoleEngineer}, Gate: governance.GateDeploy},
+	{From: StatusInProgress, To: StatusApproved, Roles: []Role{RoleOwner, RoleAdmin, RoleEngineer}, RequiresReason: true},
+	{From: StatusComplete, To: StatusInProgress, Roles: []Role{RoleOwner, RoleAdmin}, RequiresReason: true},
+}
+
+// FindStatusTransition returns the workflow edge from one status to another,
+// or nil if the workflow does not allow that move.
+func FindStatusTransition(from, to RoadmapItemStatus) *StatusTransitionRule {
+	for i := range RoadmapWorkflow {
+		if RoadmapWorkflow[i].From == from && RoadmapWorkflow[i].To == to {
+			return &RoadmapWorkflow[i]
+		}
+	}
+	return nil
+}
+
+// NextStatuses returns the workflow edges leaving the given status.
+func NextStatuses(from RoadmapItemStatus) []StatusTransitionRule {
+	var next []StatusTransitionRule
+	for _, r := range RoadmapWorkflow {
+		if r.From == from {
+			next = append(next, r)
+		}
+	}
+	return next
+}
+
+// Allows reports whether the role may take the transition.
+func (r StatusTransitionRule) Allows(role Role) bool {
+	for _, allowed := range r.Roles {
+		if allowed == role {
+			return true
+		}
+	}
+	return false
+}
+
+// RoadmapStatusTransition records who moved a roadmap item between statuses
+// and why.
+type RoadmapStatusTransition struct {
+	ID             uuid.UUID         `json:"id"`
+	RoadmapItemID  uuid.UUID         `json:"roadmap_item_id"`
+	FromStatus     RoadmapItemStatus `json:"from_status"`
+	ToStatus       RoadmapItemStatus `json:"to_status"`
+	Reason         string            `json:"reason,omitempty"`
+	TransitionedBy uuid.UUID         `json:"transitioned_by"`
+	Role           Role              `json:"role"`
+	CreatedAt      time.Time         `json:"created_at"`
+}
@@ -0,0 +1,54 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// TrafficDriftCheck is a persisted comparison of recorded HTTP traffic (HAR or
+// JSONL) against a project's REST contracts.
+type TrafficDriftCheck struct {
+	ID        uuid.UUID          `json:"id"`
+	ProjectID uuid.UUID          `json:"project_id"`
+	Source    string             `json:"source"` // "har" or "jsonl"
+	Report    TrafficDriftReport `json:"report"`
+	CreatedBy uuid.UUID          `json:"created_by,omitempty"`
+	CreatedAt time.Time          `json:"created_at"`
+}
+
+// TrafficDriftReport aggregates findings across all exchanges; identical findings
+// are reported once with an occurrence count.
+type TrafficDriftReport struct {
+	TotalExchanges        int                    `json:"total_exchanges"`
+	MatchedExchanges      int                    `json:"matched_exchanges"`
+	UndocumentedEndpoints []UndocumentedEndpoint `json:"undocumented_endpoints"`
+	UndocumentedFields    []TrafficFinding       `json:"undocumented_fields"`
+	SchemaViolations      []TrafficFinding       `json:"schema_violations"`
+}
+
+// HasFindings reports whether the observed traffic deviates from the contracts.
+func (r TrafficDriftReport) HasFindings() bool {
+	return len(r.UndocumentedEndpoints) > 0 || len(r.UndocumentedFields) > 0 || len(r.SchemaViolations) > 0
+}
+
+// UndocumentedEndpoint is an observed method/path no contract describes. Numeric
+// and UUID path segments are collapsed to {id}.
+type UndocumentedEndpoint struct {
+	Method      string `json:"method"`
+	Path        string `json:"path"`
+	Occurrences int    `json:"occurrences"`
+}
+
+// TrafficFinding is a field-level deviation on a matched exchange. Path is the
+// contract's path template; Status is 0 for request bodies.
+type TrafficFinding struct {
+	ContractID  uuid.UUID `json:"contract_id"`
+	Method      string    `json:"method"`
+	Path        string    `json:"path"`
+	Direction   string    `json:"direction"` // "request" or "response"
+	Status      int       `json:"status,omitempty"`
+	Field       string    `json:"field"`
+	Message     string    `json:"message"`
+	Occurrences int       `json:"occurrences"`
+}
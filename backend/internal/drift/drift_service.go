@@ -4,14 +4,22 @@ import (
 	"context"
 	"encoding/json"
 	"sort"
+	"time"
 
 	"github.com/SpecForgeVC/SpecForge/internal/domain"
+	specdrift "github.com/SpecForgeVC/SpecForge/internal/domain/drift"
 	"github.com/google/uuid"
 )
 
 type ContractRepo interface {
 	Get(ctx context.Context, id uuid.UUID) (*domain.ContractDefinition, error)
 	List(ctx context.Context, roadmapItemID uuid.UUID) ([]domain.ContractDefinition, error)
+	ListByProject(ctx context.Context, projectID uuid.UUID) ([]domain.ContractDefinition, error)
+}
+
+// RoadmapItemRepo resolves the project a roadmap item belongs to.
+type RoadmapItemRepo interface {
+	Get(ctx context.Context, id uuid.UUID) (*domain.RoadmapItem, error)
 }
 
 type SnapshotRepo interface {
@@ -19,6 +27,42 @@ type SnapshotRepo interface {
 	List(ctx context.Context, roadmapItemID uuid.UUID) ([]domain.VersionSnapshot, error)
 }
 
+type SpecDriftCheckRepo interface {
+	Create(ctx context.Context, check *domain.SpecDriftCheck) error
+	Get(ctx context.Context, id uuid.UUID) (*domain.SpecDriftCheck, error)
+	ListByProject(ctx context.Context, projectID uuid.UUID) ([]domain.SpecDriftCheck, error)
+}
+
+type TrafficDriftCheckRepo interface {
+	Create(ctx context.Context, check *domain.TrafficDriftCheck) error
+	Get(ctx context.Context, id uuid.UUID) (*domain.TrafficDriftCheck, error)
+	ListByProject(ctx context.Context, projectID uuid.UUID) ([]domain.TrafficDriftCheck, error)
+}
+
+// DriftMonitorRepo persists monitoring schedules and the drift timeline.
+// ClaimDue atomically leases one due schedule to owner, skipping schedules leased
+// by other instances; it returns nil when nothing is due. GetSchedule and
+// LatestTimelineEntry return nil without error when nothing is stored.
+type DriftMonitorRepo interface {
+	GetSchedule(ctx context.Context, projectID uuid.UUID) (*domain.DriftMonitorSchedule, error)
+	UpsertSchedule(ctx context.Context, s *domain.DriftMonitorSchedule) error
+	ClaimDue(ctx context.Context, owner string, now time.Time, lease time.Duration) (*domain.DriftMonitorSchedule, error)
+	// Renew extends owner's lease on the schedule to until. It returns
+	// ErrLeaseLost when owner no longer holds the lease.
+	Renew(ctx context.Context, projectID uuid.UUID, owner string, until time.Time) error
+	Release(ctx context.Context, projectID uuid.UUID, owner string, lastRun, nextRun time.Time) error
+	AppendTimelineEntry(ctx context.Context, e *domain.DriftTimelineEntry) error
+	LatestTimelineEntry(ctx context.Context, contractID uuid.UUID) (*domain.DriftTimelineEntry, error)
+	ListTimeline(ctx context.Context, projectID uuid.UUID, contractID uuid.UUID, limit int) ([]domain.DriftTimelineEntry, error)
+}
+
+// DriftPolicyRepo stores one drift policy per project.
+// Get returns nil without error when the project has no policy yet.
+type DriftPolicyRepo interface {
+	Get(ctx context.Context, projectID uuid.UUID) (*domain.ProjectDriftPolicy, error)
+	Upsert(ctx context.Context, p *domain.ProjectDriftPolicy) error
+}
+
 type AuditLogger interface {
 	Log(ctx context.Context, entityType string, entityID uuid.UUID, action string, userID uuid.UUID, oldData, newData map[string]interface{}) error
 	ListDriftEvents(ctx context.Context) ([]domain.AuditLog, error)
@@ -27,21 +71,48 @@ type AuditLogger interface {
 type DriftService interface {
 	RunDriftCheck(ctx context.Context, contractID uuid.UUID, againstVersionID uuid.UUID) (*domain.DriftReport, error)
 	GetFeatureDriftScore(ctx context.Context, featureID uuid.UUID) (int, error)
+	CountFeatureDrift(ctx context.Context, featureID uuid.UUID) (breaking, nonBreaking int, err error)
 	GetDriftHistory(ctx context.Context) ([]domain.AuditLog, error)
-	GenerateDriftFixes(ctx context.Context, report *domain.DriftReport, roadmapItemID uuid.UUID) ([]domain.DriftFix, error)
+	GenerateDriftFixes(ctx context.Context, input DriftFixInput) ([]domain.DriftFix, error)
+	RunSpecDriftCheck(ctx context.Context, input SpecDriftInput) (*domain.SpecDriftCheck, error)
+	GetSpecDriftCheck(ctx context.Context, id uuid.UUID) (*domain.SpecDriftCheck, error)
+	ListSpecDriftChecks(ctx context.Context, projectID uuid.UUID) ([]domain.SpecDriftCheck, error)
+	GetDriftPolicy(ctx context.Context, projectID uuid.UUID) (*domain.ProjectDriftPolicy, error)
+	UpdateDriftPolicy(ctx context.Context, projectID uuid.UUID, policy specdrift.DriftPolicy, userID uuid.UUID) (*domain.ProjectDriftPolicy, error)
+	RunTrafficDriftCheck(ctx context.Context, input TrafficDriftInput) (*domain.TrafficDriftCheck, error)
+	GetTrafficDriftCheck(ctx context.Context, id uuid.UUID) (*domain.TrafficDriftCheck, error)
+	ListTrafficDriftChecks(ctx context.Context, projectID uuid.UUID) ([]domain.TrafficDriftCheck, error)
+	GetDriftMonitorSchedule(ctx context.Context, projectID uuid.UUID) (*domain.DriftMonitorSchedule, error)
+	UpdateDriftMonitorSchedule(ctx context.Context, projectID uuid.UUID, cronExpr string, enabled bool, userID uuid.UUID) (*domain.DriftMonitorSchedule, error)
+	RunDriftMonitor(ctx context.Context, projectID uuid.UUID) ([]domain.DriftTimelineEntry, error)
+	ListDriftTimeline(ctx context.Context, projectID uuid.UUID, contractID uuid.UUID, limit int) ([]domain.DriftTimelineEntry, error)
 }
 
 type driftService struct {
 	contractRepo ContractRepo
+	roadmapRepo  RoadmapItemRepo
 	snapshotRepo SnapshotRepo
+	checkRepo    SpecDriftCheckRepo
+	policyRepo   DriftPolicyRepo
+	trafficRepo  TrafficDriftCheckRepo
+	monitorRepo  DriftMonitorRepo
+	llm          LLMClientProvider
+	proposals    ProposalCreator
 	diffEngine   DiffEngine
 	auditLog     AuditLogger
 }
 
-func NewDriftService(cRepo ContractRepo, sRepo SnapshotRepo, de DiffEngine, al AuditLogger) DriftService {
+func NewDriftService(cRepo ContractRepo, rmRepo RoadmapItemRepo, sRepo SnapshotRepo, checkRepo SpecDriftCheckRepo, policyRepo DriftPolicyRepo, trafficRepo TrafficDriftCheckRepo, monitorRepo DriftMonitorRepo, llm LLMClientProvider, proposals ProposalCreator, de DiffEngine, al AuditLogger) DriftService {
 	return &driftService{
 		contractRepo: cRepo,
+		roadmapRepo:  rmRepo,
 		snapshotRepo: sRepo,
+		checkRepo:    checkRepo,
+		policyRepo:   policyRepo,
+		trafficRepo:  trafficRepo,
+		monitorRepo:  monitorRepo,
+		llm:          llm,
+		proposals:    proposals,
 		diffEngine:   de,
 		auditLog:     al,
 	}
@@ -64,25 +135,30 @@ func (s *driftService) RunDriftCheck(ctx context.Context, contractID uuid.UUID,
 		RiskScore:       0.0,
 	}
 
-	diffs, err := s.diffEngine.Compare(snapshot.SnapshotData, map[string]interface{}{
-		"input":  contract.InputSchema,
-		"output": contract.OutputSchema,
-	})
+	baseline := snapshot.SnapshotData
+	if states := snapshotContractStates(snapshot); states != nil {
+		if state, ok := states[contractID.String()]; ok {
+			baseline = state
+		}
+	}
+	details, err := s.diffEngine.Compare(baseline, contractState(*contract))
 	if err != nil {
 		return nil, err
 	}
 
-	if len(diffs) > 0 {
+	if len(details.Items) > 0 {
 		report.DriftDetected = true
-		for _, d := range diffs {
+		report.Details = &details
+		report.RiskScore = riskScore(details)
+		for _, item := range details.Items {
+			if !isBreaking(item) {
+				continue
+			}
 			report.BreakingChanges = append(report.BreakingChanges, domain.BreakingChange{
-				Field: d.Path,
-				Issue: d.Description,
+				Field:    item.Location,
+				Issue:    item.Description,
+				Severity: item.Severity,
 			})
-			report.RiskScore += float64(d.RiskScore) * 0.1
-		}
-		if report.RiskScore > 1.0 {
-			report.RiskScore = 1.0
 		}
 		s.auditLog.Log(ctx, "CONTRACT", contractID, "DRIFT_DETECTED", uuid.Nil,
 			map[string]interface{}{"version": "current"},
@@ -94,70 +170,18 @@ func (s *driftService) RunDriftCheck(ctx context.Context, contractID uuid.UUID,
 }
 
 func (s *driftService) GetFeatureDriftScore(ctx context.Context, featureID uuid.UUID) (int, error) {
-	// 1. Get all snapshots, sort newest first
-	snapshots, err := s.snapshotRepo.List(ctx, featureID)
-	if err != nil {
-		return 0, err
-	}
-	if len(snapshots) == 0 {
-		return 100, nil // Fresh feature — no drift baseline yet
-	}
-	sort.Slice(snapshots, func(i, j int) bool {
-		return snapshots[i].CreatedAt.After(snapshots[j].CreatedAt)
-	})
-	latest := snapshots[0]
-
-	// 2. Get current contracts for this feature
-	contracts, err := s.contractRepo.List(ctx, featureID)
+	totalBreaking, totalNonBreaking, err := s.CountFeatureDrift(ctx, featureID)
 	if err != nil {
 		return 0, err
 	}
-	if len(contracts) == 0 {
-		return 100, nil // No contracts defined yet
-	}
 
-	// 3. Extract snapshotted contract state
-	snapshotContracts := latest.SnapshotData["contracts"]
-	if snapshotContracts == nil {
-		return 100, nil // Snapshot predates contract tracking
-	}
-	snapshotJSON, _ := json.Marshal(snapshotContracts)
-	var snapshotMap map[string]interface{}
-	if err := json.Unmarshal(snapshotJSON, &snapshotMap); err != nil {
-		return 100, nil
-	}
-
-	// 4. Compare each current contract against its snapshot state
-	totalBreaking, totalNonBreaking := 0, 0
-	for _, c := range contracts {
-		snapshotState, ok := snapshotMap[c.ID.String()]
-		if !ok {
-			continue // New contract added since snapshot — not drift
-		}
-		snapshotStateMap, _ := snapshotState.(map[string]interface{})
-		diffs, err := s.diffEngine.Compare(snapshotStateMap, map[string]interface{}{
-			"input":  c.InputSchema,
-			"output": c.OutputSchema,
-		})
-		if err != nil {
-			continue
-		}
-		for _, d := range diffs {
-			if d.RiskScore >= 3 {
-				totalBreaking++
-			} else {
-				totalNonBreaking++
-			}
-		}
-	}
-
-	// 5. Score: start 100, deduct per change
+	// Score: start 100, deduct per change
 	score := 100 - (totalBreaking * 15) - (totalNonBreaking * 5)
 	if score < 0 {
 		score = 0
 	}
 
-	// 6. Audit log if drift detected
+	// Audit log if drift detected
 	if score < 100 {
 		s.auditLog.Log(ctx, "FEATURE", featureID, "DRIFT_SCORE_CALCULATED", uuid.Nil,
 			nil,
@@ -172,30 +196,79 @@ func (s *driftService) GetFeatureDriftScore(ctx context.Context, featureID uuid.
 	return score, nil
 }
 
-func (s *driftService) GetDriftHistory(ctx context.Context) ([]domain.AuditLog, error) {
-	return s.auditLog.ListDriftEvents(ctx)
-}
+// CountFeatureDrift compares the feature's contracts against the newest snapshot
+// that captured them. Breaking counts breaking and critical changes,
+// nonBreaking the additive (warning) ones.
+func (s *driftService) CountFeatureDrift(ctx context.Context, featureID uuid.UUID) (breaking, nonBreaking int, err error) {
+	// 1. Find the newest snapshot that captured contract state
+	snapshots, err := s.snapshotRepo.List(ctx, featureID)
+	if err != nil {
+		return 0, 0, err
+	}
+	_, snapshotMap := latestContractSnapshot(snapshots)
+	if snapshotMap == nil {
+		return 0, 0, nil // Fresh feature or no snapshot with contracts — no drift baseline yet
+	}
 
-func (s *driftService) GenerateDriftFixes(ctx context.Context, report *domain.DriftReport, roadmapItemID uuid.UUID) ([]domain.DriftFix, error) {
-	if report == nil || len(report.BreakingChanges) == 0 {
-		return []domain.DriftFix{}, nil
+	// 2. Get current contracts for this feature
+	contracts, err := s.contractRepo.List(ctx, featureID)
+	if err != nil {
+		return 0, 0, err
 	}
 
-	fixes := make([]domain.DriftFix, 0, len(report.BreakingChanges))
-	for _, bc := range report.BreakingChanges {
-		fix := domain.DriftFix{
-			Field:           bc.Field,
-			Issue:           bc.Issue,
-			SuggestedChange: "Revert field '" + bc.Field + "' to match the approved contract specification.",
-			Explanation:     "The field '" + bc.Field + "' has drifted from the approved spec. Issue: " + bc.Issue + ". Reverting this change will restore contract compliance and prevent downstream failures.",
+	// 3. Compare each current contract against its snapshot state
+	for _, c := range contracts {
+		snapshotState, ok := snapshotMap[c.ID.String()]
+		if !ok {
+			continue // New contract added since snapshot — not drift
+		}
+		details, err := s.diffEngine.Compare(snapshotState, contractState(c))
+		if err != nil {
+			continue
+		}
+		breaking += details.CriticalChanges + details.BreakingChanges
+		nonBreaking += details.Warnings
+	}
+	return breaking, nonBreaking, nil
+}
+
+// latestContractSnapshot returns the newest snapshot whose data captured contract
+// state under "contracts" (contract ID -> {"input", "output"}), with that map decoded.
+func latestContractSnapshot(snapshots []domain.VersionSnapshot) (*domain.VersionSnapshot, map[string]map[string]interface{}) {
+	sort.Slice(snapshots, func(i, j int) bool {
+		return snapshots[i].CreatedAt.After(snapshots[j].CreatedAt)
+	})
+	for i := range snapshots {
+		if states := snapshotContractStates(&snapshots[i]); states != nil {
+			return &snapshots[i], states
 		}
-		fixes = append(fixes, fix)
 	}
+	return nil, nil
+}
 
-	s.auditLog.Log(ctx, "ROADMAP_ITEM", roadmapItemID, "DRIFT_FIXES_GENERATED", uuid.Nil,
-		nil,
-		map[string]interface{}{"fix_count": len(fixes), "risk_score": report.RiskScore},
-	)
+// snapshotContractStates returns the contract states captured by a snapshot,
+// keyed by contract ID, or nil if the snapshot predates contract tracking.
+func snapshotContractStates(snapshot *domain.VersionSnapshot) map[string]map[string]interface{} {
+	raw := snapshot.SnapshotData["contracts"]
+	if raw == nil {
+		return nil
+	}
+	data, _ := json.Marshal(raw)
+	var states map[string]map[string]interface{}
+	if err := json.Unmarshal(data, &states); err != nil {
+		return nil
+	}
+	return states
+}
 
-	return fixes, nil
+// contractState is the shape contracts are captured in by snapshots.
+func contractState(c domain.ContractDefinition) map[string]interface{} {
+	return map[string]interface{}{
+		"input":  c.InputSchema,
+		"output": c.OutputSchema,
+	}
+}
+
+func (s *driftService) GetDriftHistory(ctx context.Context) ([]domain.AuditLog, error) {
+	return s.auditLog.ListDriftEvents(ctx)
 }
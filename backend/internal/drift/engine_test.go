@@ -2,8 +2,19 @@ package drift
 
 import (
 	"testing"
+
+	specdrift "github.com/SpecForgeVC/SpecForge/internal/domain/drift"
 )
 
+func findItem(report specdrift.DriftReport, location string, t specdrift.DriftType) *specdrift.DriftItem {
+	for i := range report.Items {
+		if report.Items[i].Location == location && report.Items[i].Type == t {
+			return &report.Items[i]
+		}
+	}
+	return nil
+}
+
 func TestDiffEngine_Compare(t *testing.T) {
 	engine := NewDiffEngine()
 
@@ -19,25 +30,21 @@ func TestDiffEngine_Compare(t *testing.T) {
 		"type": "object",
 		"properties": map[string]interface{}{
 			"id":   map[string]interface{}{"type": "string"},
-			"name": map[string]interface{}{"type": "integer"}, // Type change (breaking)
+			"name": map[string]interface{}{"type": "integer"}, // Type change (critical)
 			"age":  map[string]interface{}{"type": "integer"}, // Addition (non-breaking)
 		},
 	}
 
-	diffs, err := engine.Compare(oldSchema, newSchema)
+	report, err := engine.Compare(map[string]interface{}{"input": oldSchema}, map[string]interface{}{"input": newSchema})
 	if err != nil {
 		t.Fatalf("Compare failed: %v", err)
 	}
 
-	foundBreaking := false
-	for _, d := range diffs {
-		if d.Path == "properties.name.type" && d.IsBreaking {
-			foundBreaking = true
-		}
+	if item := findItem(report, "input/properties/name", specdrift.FieldTypeChanged); item == nil || item.Severity != specdrift.Critical {
+		t.Errorf("Expected critical type change for input/properties/name, got %+v", item)
 	}
-
-	if !foundBreaking {
-		t.Error("Expected breaking change for properties.name.type not found")
+	if item := findItem(report, "input", specdrift.FieldAdded); item == nil || isBreaking(*item) {
+		t.Errorf("Expected non-breaking addition of age, got %+v", item)
 	}
 }
 
@@ -66,33 +73,72 @@ func TestDiffEngine_Compare_Complex(t *testing.T) {
 			"tags": map[string]interface{}{
 				"type": "array",
 				"items": map[string]interface{}{
-					"type": "integer", // Type change in array items (breaking)
+					"type": "integer", // Type change in array items (critical)
 				},
 			},
 		},
 	}
 
-	diffs, err := engine.Compare(oldSchema, newSchema)
+	report, err := engine.Compare(map[string]interface{}{"input": oldSchema}, map[string]interface{}{"input": newSchema})
 	if err != nil {
 		t.Fatalf("Compare failed: %v", err)
 	}
 
-	foundRequiredChange := false
-	foundArrayItemChange := false
+	if item := findItem(report, "input", specdrift.RequiredFieldAdded); item == nil || item.Severity != specdrift.Breaking {
+		t.Errorf("Expected breaking change for required fields, got %+v", item)
+	}
+	if item := findItem(report, "input/properties/tags/items", specdrift.FieldTypeChanged); item == nil || item.Severity != specdrift.Critical {
+		t.Errorf("Expected critical change for array items type, got %+v", item)
+	}
+}
+
+func TestDiffEngine_Compare_Direction(t *testing.T) {
+	engine := NewDiffEngine()
 
-	for _, d := range diffs {
-		if d.Path == "required" && d.IsBreaking {
-			foundRequiredChange = true
-		}
-		if d.Path == "properties.tags.items.type" && d.IsBreaking {
-			foundArrayItemChange = true
+	schema := func(status map[string]interface{}) map[string]interface{} {
+		return map[string]interface{}{
+			"type":                 "object",
+			"additionalProperties": true,
+			"properties":           map[string]interface{}{"status": status},
 		}
 	}
+	before := schema(map[string]interface{}{"type": "string", "enum": []interface{}{"open", "closed"}, "maxLength": 10.0, "pattern": "^[a-z]+$"})
+	after := schema(map[string]interface{}{"type": "string", "enum": []interface{}{"open", "closed", "archived"}, "maxLength": 5.0})
+	after["additionalProperties"] = false
+
+	report, err := engine.Compare(
+		map[string]interface{}{"input": before, "output": before},
+		map[string]interface{}{"input": after, "output": after},
+	)
+	if err != nil {
+		t.Fatalf("Compare failed: %v", err)
+	}
 
-	if !foundRequiredChange {
-		t.Error("Expected breaking change for required fields not found")
+	cases := []struct {
+		location string
+		typ      specdrift.DriftType
+		want     specdrift.DriftSeverity
+	}{
+		{"input/properties/status/maxLength", specdrift.ConstraintTightened, specdrift.Breaking},
+		{"output/properties/status/maxLength", specdrift.ConstraintTightened, specdrift.Info},
+		{"input/properties/status", specdrift.EnumValueAdded, specdrift.Warning},
+		{"output/properties/status", specdrift.EnumValueAdded, specdrift.Breaking},
+		{"input/properties/status/pattern", specdrift.ConstraintLoosened, specdrift.Warning},
+		{"output/properties/status/pattern", specdrift.ConstraintLoosened, specdrift.Breaking},
+		{"input/additionalProperties", specdrift.ConstraintTightened, specdrift.Breaking},
+		{"output/additionalProperties", specdrift.ConstraintTightened, specdrift.Info},
+	}
+	for _, tc := range cases {
+		item := findItem(report, tc.location, tc.typ)
+		if item == nil {
+			t.Errorf("missing %s at %s", tc.typ, tc.location)
+			continue
+		}
+		if item.Severity != tc.want {
+			t.Errorf("%s at %s: got %s, want %s", tc.typ, tc.location, item.Severity, tc.want)
+		}
 	}
-	if !foundArrayItemChange {
-		t.Error("Expected breaking change for array items type not found")
+	if got := riskScore(report); got != 1.0 {
+		t.Errorf("expected risk score to saturate at 1.0, got %v", got)
 	}
 }
@@ -0,0 +1,335 @@
+package drift
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/SpecForgeVC/SpecForge/internal/asyncapi"
+	"github.com/SpecForgeVC/SpecForge/internal/clispec"
+	"github.com/SpecForgeVC/SpecForge/internal/domain"
+	"github.com/SpecForgeVC/SpecForge/internal/graphql"
+	"github.com/SpecForgeVC/SpecForge/internal/jsonpatch"
+	"github.com/SpecForgeVC/SpecForge/internal/openapi"
+	"github.com/google/uuid"
+	"github.com/xeipuuv/gojsonschema"
+)
+
+var (
+	ErrLLMUnavailable     = errors.New("no LLM is available")
+	ErrInvalidFixResponse = errors.New("LLM returned an invalid fix response")
+	ErrInvalidPatch       = errors.New("invalid contract patch")
+)
+
+// LLMClientProvider resolves the configured LLM client.
+type LLMClientProvider interface {
+	GetClient(ctx context.Context) (domain.LLMClient, error)
+}
+
+// ProposalCreator files AI proposals for review.
+type ProposalCreator interface {
+	CreateProposal(ctx context.Context, roadmapItemID uuid.UUID, pType domain.ProposalType, diff map[string]interface{}, reasoning string, confidence float64, createdBy uuid.UUID) (*domain.AiProposal, error)
+}
+
+// DriftFixInput asks for fixes to the breaking changes of a drift report.
+// ContractID restricts fixes to one contract of the roadmap item; with
+// CreateProposals each valid fix is filed as a MODIFY_SCHEMA proposal.
+type DriftFixInput struct {
+	Report          *domain.DriftReport
+	RoadmapItemID   uuid.UUID
+	ContractID      uuid.UUID
+	CreateProposals bool
+	UserID          uuid.UUID
+}
+
+// Contract patch sections. Drift fix patches address the contract as
+// {"input_schema": ..., "output_schema": ..., "error_schema": ...}.
+const (
+	sectionInput  = "input_schema"
+	sectionOutput = "output_schema"
+	sectionError  = "error_schema"
+)
+
+// ApplyContractPatch returns a copy of c with the patch applied, after checking
+// that the patched schemas are still valid for the contract type: embedded
+// GraphQL, AsyncAPI, CLI and OpenAPI documents must still load and plain
+// schemas must still compile as JSON Schema.
+func ApplyContractPatch(c domain.ContractDefinition, patch jsonpatch.Patch) (*domain.ContractDefinition, error) {
+	doc := map[string]interface{}{
+		sectionInput:  orEmpty(c.InputSchema),
+		sectionOutput: orEmpty(c.OutputSchema),
+		sectionError:  orEmpty(c.ErrorSchema),
+	}
+	out, err := patch.Apply(doc)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidPatch, err)
+	}
+	root, ok := out.(map[string]interface{})
+	if !ok {
+		return nil, fmt.Errorf("%w: patch replaced the contract document with %T", ErrInvalidPatch, out)
+	}
+
+	sections := make(map[string]map[string]interface{}, 3)
+	for key, v := range root {
+		if key != sectionInput && key != sectionOutput && key != sectionError {
+			return nil, fmt.Errorf("%w: unknown contract section %q", ErrInvalidPatch, key)
+		}
+		if v == nil {
+			continue
+		}
+		m, ok := v.(map[string]interface{})
+		if !ok {
+			return nil, fmt.Errorf("%w: %s must remain an object", ErrInvalidPatch, key)
+		}
+		sections[key] = m
+	}
+
+	patched := c
+	patched.InputSchema = orEmpty(sections[sectionInput])
+	patched.OutputSchema = orEmpty(sections[sectionOutput])
+	patched.ErrorSchema = orEmpty(sections[sectionError])
+	if err := ValidateContract(patched); err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidPatch, err)
+	}
+	return &patched, nil
+}
+
+// ValidateContract checks that the contract's schemas are valid for its type:
+// embedded GraphQL, AsyncAPI, CLI and OpenAPI documents must load and plain
+// schemas must compile as JSON Schema.
+func ValidateContract(c domain.ContractDefinition) error {
+	inputIsDocument := true
+	switch {
+	case c.ContractType == domain.GraphQL:
+		if err := graphql.ValidateContract(c.InputSchema); err != nil {
+			return fmt.Errorf("%s: %v", sectionInput, err)
+		}
+	case asyncapi.IsAsyncAPI(c.InputSchema):
+		if err := asyncapi.ValidateContract(c.InputSchema); err != nil {
+			return fmt.Errorf("%s: %v", sectionInput, err)
+		}
+	case clispec.IsCLI(c.InputSchema):
+		if err := clispec.ValidateContract(c.InputSchema); err != nil {
+			return fmt.Errorf("%s: %v", sectionInput, err)
+		}
+	case c.InputSchema["openapi"] != nil:
+		if _, err := openapi.FromContracts([]domain.ContractDefinition{c}); err != nil {
+			return fmt.Errorf("%s: %v", sectionInput, err)
+		}
+	default:
+		inputIsDocument = false
+	}
+
+	schemas := map[string]map[string]interface{}{sectionOutput: c.OutputSchema, sectionError: c.ErrorSchema}
+	if !inputIsDocument {
+		schemas[sectionInput] = c.InputSchema
+	}
+	for name, schema := range schemas {
+		if len(schema) == 0 {
+			continue
+		}
+		if _, err := gojsonschema.NewSchema(gojsonschema.NewGoLoader(schema)); err != nil {
+			return fmt.Errorf("%s is not a valid JSON Schema: %v", name, err)
+		}
+	}
+	return nil
+}
+
+func orEmpty(m map[string]interface{}) map[string]interface{} {
+	if m == nil {
+		return map[string]interface{}{}
+	}
+	return m
+}
+
+// GenerateDriftFixes asks the configured LLM for JSON Patch fixes to the report's
+// breaking changes, given the current contracts and their approved baseline.
+// Every returned patch is applied to its contract and validated; fixes that fail
+// are returned with Valid=false and the reason, and are never filed as proposals.
+func (s *driftService) GenerateDriftFixes(ctx context.Context, input DriftFixInput) ([]domain.DriftFix, error) {
+	if input.Report == nil || len(input.Report.BreakingChanges) == 0 {
+		return []domain.DriftFix{}, nil
+	}
+
+	contracts, err := s.contractRepo.List(ctx, input.RoadmapItemID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list contracts: %w", err)
+	}
+	byID := make(map[uuid.UUID]domain.ContractDefinition)
+	for _, c := range contracts {
+		if input.ContractID == uuid.Nil || c.ID == input.ContractID {
+			byID[c.ID] = c
+		}
+	}
+	if len(byID) == 0 {
+		return []domain.DriftFix{}, nil
+	}
+
+	var baseline map[string]map[string]interface{}
+	if snapshots, err := s.snapshotRepo.List(ctx, input.RoadmapItemID); err == nil {
+		_, baseline = latestContractSnapshot(snapshots)
+	}
+
+	client, err := s.llm.GetClient(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrLLMUnavailable, err)
+	}
+	resp, err := client.Generate(ctx, driftFixPrompt(input.Report, byID, baseline))
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrLLMUnavailable, err)
+	}
+	suggestions, err := parseFixSuggestions(resp)
+	if err != nil {
+		return nil, err
+	}
+
+	fixes := make([]domain.DriftFix, 0, len(suggestions))
+	for _, sug := range suggestions {
+		fix := domain.DriftFix{
+			Field:       sug.Field,
+			Issue:       sug.Issue,
+			Explanation: sug.Explanation,
+			Patch:       sug.Patch,
+			Confidence:  sug.Confidence,
+		}
+		contractID := sug.ContractID
+		if contractID == uuid.Nil && len(byID) == 1 {
+			for id := range byID {
+				contractID = id
+			}
+		}
+		fix.ContractID = contractID
+		fix.SuggestedChange = describePatch(sug.Patch)
+
+		if c, ok := byID[contractID]; !ok {
+			fix.ValidationError = fmt.Sprintf("unknown contract %q", sug.ContractID)
+		} else if _, err := ApplyContractPatch(c, sug.Patch); err != nil {
+			fix.ValidationError = err.Error()
+		} else {
+			fix.Valid = true
+		}
+
+		if fix.Valid && input.CreateProposals {
+			proposal, err := s.proposals.CreateProposal(ctx, input.RoadmapItemID, domain.ModifySchema,
+				map[string]interface{}{
+					"contract_id": contractID.String(),
+					"json_patch":  fix.Patch,
+					"field":       fix.Field,
+					"issue":       fix.Issue,
+				},
+				fix.Explanation, fix.Confidence, input.UserID,
+			)
+			if err != nil {
+				return nil, fmt.Errorf("failed to file proposal for %s: %w", fix.Field, err)
+			}
+			fix.ProposalID = &proposal.ID
+		}
+		fixes = append(fixes, fix)
+	}
+
+	s.auditLog.Log(ctx, "ROADMAP_ITEM", input.RoadmapItemID, "DRIFT_FIXES_GENERATED", input.UserID,
+		nil,
+		map[string]interface{}{
+			"fix_count":        len(fixes),
+			"valid_fix_count":  countValidFixes(fixes),
+			"risk_score":       input.Report.RiskScore,
+			"create_proposals": input.CreateProposals,
+		},
+	)
+
+	return fixes, nil
+}
+
+type fixSuggestion struct {
+	ContractID  uuid.UUID       `json:"contract_id"`
+	Field       string          `json:"field"`
+	Issue       string          `json:"issue"`
+	Explanation string          `json:"explanation"`
+	Confidence  float64         `json:"confidence"`
+	Patch       jsonpatch.Patch `json:"patch"`
+}
+
+func driftFixPrompt(report *domain.DriftReport, contracts map[uuid.UUID]domain.ContractDefinition, baseline map[string]map[string]interface{}) string {
+	type contractContext struct {
+		ID           uuid.UUID              `json:"contract_id"`
+		Type         domain.ContractType    `json:"contract_type"`
+		Version      string                 `json:"version"`
+		InputSchema  map[string]interface{} `json:"input_schema"`
+		OutputSchema map[string]interface{} `json:"output_schema"`
+		ErrorSchema  map[string]interface{} `json:"error_schema"`
+		Approved     map[string]interface{} `json:"approved_baseline,omitempty"`
+	}
+	ctxContracts := make([]contractContext, 0, len(contracts))
+	for _, c := range contracts {
+		ctxContracts = append(ctxContracts, contractContext{
+			ID:           c.ID,
+			Type:         c.ContractType,
+			Version:      c.Version,
+			InputSchema:  c.InputSchema,
+			OutputSchema: c.OutputSchema,
+			ErrorSchema:  c.ErrorSchema,
+			Approved:     baseline[c.ID.String()],
+		})
+	}
+	contractsJSON, _ := json.MarshalIndent(ctxContracts, "", "  ")
+	changesJSON, _ := json.MarshalIndent(report.BreakingChanges, "", "  ")
+
+	return fmt.Sprintf(`You are an API contract governance assistant. The contracts below have drifted from their approved baseline.
+For each breaking change, propose the smallest fix that restores compatibility for existing consumers.
+
+Contracts (current state; "approved_baseline" holds the last approved {"input", "output"} schemas when known):
+%s
+
+Breaking changes (risk score %.1f):
+%s
+
+Express every fix as an RFC 6902 JSON Patch against the document {"input_schema": ..., "output_schema": ..., "error_schema": ...} of one contract,
+e.g. {"op": "replace", "path": "/input_schema/properties/id/type", "value": "string"}. Escape "/" in keys as "~1" and "~" as "~0".
+The patched schemas must remain valid JSON Schema (or a valid embedded OpenAPI, AsyncAPI, GraphQL or CLI document).
+
+Return ONLY a JSON object of the form:
+{"fixes": [{"contract_id": "<uuid>", "field": "<field>", "issue": "<issue>", "explanation": "<why this fixes the drift>", "confidence": <0..1>, "patch": [<operations>]}]}
+No markdown formatting, NO COMMENTS, and strictly valid JSON.`,
+		contractsJSON, report.RiskScore, changesJSON)
+}
+
+// parseFixSuggestions extracts the {"fixes": [...]} object from an LLM response,
+// tolerating surrounding prose and markdown code fences.
+func parseFixSuggestions(resp string) ([]fixSuggestion, error) {
+	start, end := strings.Index(resp, "{"), strings.LastIndex(resp, "}")
+	if start == -1 || end < start {
+		return nil, fmt.Errorf("%w: no JSON object found", ErrInvalidFixResponse)
+	}
+	var out struct {
+		Fixes []fixSuggestion `json:"fixes"`
+	}
+	if err := json.Unmarshal([]byte(resp[start:end+1]), &out); err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidFixResponse, err)
+	}
+	return out.Fixes, nil
+}
+
+// describePatch summarizes a patch as "replace /input_schema/...; add ...".
+func describePatch(p jsonpatch.Patch) string {
+	parts := make([]string, 0, len(p))
+	for _, op := range p {
+		if op.From != "" {
+			parts = append(parts, fmt.Sprintf("%s %s -> %s", op.Op, op.From, op.Path))
+			continue
+		}
+		parts = append(parts, fmt.Sprintf("%s %s", op.Op, op.Path))
+	}
+	return strings.Join(parts, "; ")
+}
+
+func countValidFixes(fixes []domain.DriftFix) int {
+	n := 0
+	for _, f := range fixes {
+		if f.Valid {
+			n++
+		}
+	}
+	return n
+}
@@ -0,0 +1,87 @@
+package drift
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/SpecForgeVC/SpecForge/internal/domain"
+	"github.com/google/uuid"
+)
+
+type fakeLLMClient struct {
+	domain.LLMClient
+	response string
+	prompt   string
+}
+
+func (c *fakeLLMClient) Generate(ctx context.Context, prompt string) (string, error) {
+	c.prompt = prompt
+	return c.response, nil
+}
+
+type fakeLLMProvider struct{ client *fakeLLMClient }
+
+func (p *fakeLLMProvider) GetClient(ctx context.Context) (domain.LLMClient, error) {
+	return p.client, nil
+}
+
+type fakeProposalCreator struct{ diffs []map[string]interface{} }
+
+func (p *fakeProposalCreator) CreateProposal(ctx context.Context, roadmapItemID uuid.UUID, pType domain.ProposalType, diff map[string]interface{}, reasoning string, confidence float64, createdBy uuid.UUID) (*domain.AiProposal, error) {
+	p.diffs = append(p.diffs, diff)
+	return &domain.AiProposal{ID: uuid.New(), RoadmapItemID: roadmapItemID, ProposalType: pType, Diff: diff}, nil
+}
+
+func TestGenerateDriftFixes(t *testing.T) {
+	contract := domain.ContractDefinition{
+		ID:            uuid.MustParse("33333333-3333-3333-3333-333333333333"),
+		RoadmapItemID: uuid.New(),
+		ContractType:  domain.REST,
+		InputSchema: map[string]interface{}{
+			"type":       "object",
+			"properties": map[string]interface{}{"id": map[string]interface{}{"type": "integer"}},
+		},
+	}
+	client := &fakeLLMClient{response: "```json\n" + `{"fixes": [
+		{"contract_id": "33333333-3333-3333-3333-333333333333", "field": "id", "issue": "type changed", "explanation": "restore string ids", "confidence": 0.9,
+		 "patch": [{"op": "replace", "path": "/input_schema/properties/id/type", "value": "string"}]},
+		{"contract_id": "33333333-3333-3333-3333-333333333333", "field": "id", "issue": "type changed", "explanation": "bogus", "confidence": 0.2,
+		 "patch": [{"op": "replace", "path": "/input_schema/properties/id/type", "value": "strng"}]},
+		{"field": "name", "issue": "removed", "explanation": "missing path",
+		 "patch": [{"op": "remove", "path": "/input_schema/properties/name"}]}
+	]}` + "\n```"}
+	proposals := &fakeProposalCreator{}
+	svc := NewDriftService(&fakeContractRepo{contracts: []domain.ContractDefinition{contract}}, nil, &fakeSnapshotRepo{}, nil, nil, nil, nil,
+		&fakeLLMProvider{client: client}, proposals, NewDiffEngine(), &fakeAuditLogger{})
+
+	fixes, err := svc.GenerateDriftFixes(context.Background(), DriftFixInput{
+		Report:          &domain.DriftReport{DriftDetected: true, BreakingChanges: []domain.BreakingChange{{Field: "id", Issue: "type changed"}}},
+		RoadmapItemID:   contract.RoadmapItemID,
+		CreateProposals: true,
+	})
+	if err != nil {
+		t.Fatalf("GenerateDriftFixes failed: %v", err)
+	}
+	if !strings.Contains(client.prompt, contract.ID.String()) || !strings.Contains(client.prompt, "type changed") {
+		t.Errorf("expected the prompt to carry the contract and drift context")
+	}
+	if len(fixes) != 3 {
+		t.Fatalf("expected 3 fixes, got %d", len(fixes))
+	}
+	if !fixes[0].Valid || fixes[0].ProposalID == nil || fixes[0].SuggestedChange != "replace /input_schema/properties/id/type" {
+		t.Errorf("expected the first fix to be valid and filed, got %+v", fixes[0])
+	}
+	if fixes[1].Valid || !strings.Contains(fixes[1].ValidationError, "JSON Schema") {
+		t.Errorf("expected a patch producing an invalid schema to be rejected, got %+v", fixes[1])
+	}
+	if fixes[2].Valid || fixes[2].ContractID != contract.ID {
+		t.Errorf("expected a patch against a missing member to fail on the only contract, got %+v", fixes[2])
+	}
+	if len(proposals.diffs) != 1 || proposals.diffs[0]["contract_id"] != contract.ID.String() {
+		t.Errorf("expected only the valid fix to be filed as a proposal, got %v", proposals.diffs)
+	}
+	if contract.InputSchema["properties"].(map[string]interface{})["id"].(map[string]interface{})["type"] != "integer" {
+		t.Errorf("expected validation not to modify the stored contract")
+	}
+}
@@ -0,0 +1,335 @@
+package drift
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/SpecForgeVC/SpecForge/internal/domain"
+	"github.com/SpecForgeVC/SpecForge/internal/logger"
+	"github.com/google/uuid"
+	"github.com/robfig/cron/v3"
+	"go.uber.org/zap"
+)
+
+var (
+	ErrInvalidCronExpression = errors.New("invalid cron expression")
+	ErrLeaseLost             = errors.New("drift monitor lease lost")
+)
+
+// parseCron accepts standard five-field expressions and descriptors such as "@hourly".
+func parseCron(expr string) (cron.Schedule, error) {
+	schedule, err := cron.ParseStandard(expr)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidCronExpression, err)
+	}
+	return schedule, nil
+}
+
+// GetDriftMonitorSchedule returns the project's schedule, or a disabled one if none is stored.
+func (s *driftService) GetDriftMonitorSchedule(ctx context.Context, projectID uuid.UUID) (*domain.DriftMonitorSchedule, error) {
+	schedule, err := s.monitorRepo.GetSchedule(ctx, projectID)
+	if err != nil {
+		return nil, err
+	}
+	if schedule == nil {
+		return &domain.DriftMonitorSchedule{ProjectID: projectID}, nil
+	}
+	return schedule, nil
+}
+
+func (s *driftService) UpdateDriftMonitorSchedule(ctx context.Context, projectID uuid.UUID, cronExpr string, enabled bool, userID uuid.UUID) (*domain.DriftMonitorSchedule, error) {
+	parsed, err := parseCron(cronExpr)
+	if err != nil {
+		return nil, err
+	}
+	old, err := s.GetDriftMonitorSchedule(ctx, projectID)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	next := parsed.Next(now)
+	schedule := &domain.DriftMonitorSchedule{
+		ProjectID:      projectID,
+		CronExpression: cronExpr,
+		Enabled:        enabled,
+		NextRunAt:      &next,
+		LastRunAt:      old.LastRunAt,
+		UpdatedBy:      userID,
+		UpdatedAt:      now,
+	}
+	if err := s.monitorRepo.UpsertSchedule(ctx, schedule); err != nil {
+		return nil, err
+	}
+
+	s.auditLog.Log(ctx, "DRIFT_MONITOR", projectID, "UPDATE", userID,
+		map[string]interface{}{"cron_expression": old.CronExpression, "enabled": old.Enabled},
+		map[string]interface{}{"cron_expression": cronExpr, "enabled": enabled},
+	)
+	return schedule, nil
+}
+
+func (s *driftService) ListDriftTimeline(ctx context.Context, projectID uuid.UUID, contractID uuid.UUID, limit int) ([]domain.DriftTimelineEntry, error) {
+	return s.monitorRepo.ListTimeline(ctx, projectID, contractID, limit)
+}
+
+// RunDriftMonitor checks every contract of a project against the newest snapshot
+// of its roadmap item that captured it and appends the results to the timeline.
+// Contracts that no snapshot captured yet have no baseline and are skipped.
+// NewBreakingChanges counts breaking changes absent from the contract's previous entry.
+// A roadmap item or contract that cannot be checked is recorded in the audit
+// log and does not stop the run; the returned error joins those failures.
+func (s *driftService) RunDriftMonitor(ctx context.Context, projectID uuid.UUID) ([]domain.DriftTimelineEntry, error) {
+	contracts, err := s.contractRepo.ListByProject(ctx, projectID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list contracts: %w", err)
+	}
+
+	byItem := make(map[uuid.UUID][]domain.ContractDefinition)
+	var items []uuid.UUID
+	for _, c := range contracts {
+		if _, seen := byItem[c.RoadmapItemID]; !seen {
+			items = append(items, c.RoadmapItemID)
+		}
+		byItem[c.RoadmapItemID] = append(byItem[c.RoadmapItemID], c)
+	}
+
+	var entries []domain.DriftTimelineEntry
+	var errs []error
+	for _, itemID := range items {
+		if err := ctx.Err(); err != nil {
+			return entries, errors.Join(append(errs, err)...)
+		}
+		snapshots, err := s.snapshotRepo.List(ctx, itemID)
+		if err != nil {
+			err = fmt.Errorf("failed to list snapshots for roadmap item %s: %w", itemID, err)
+			s.recordMonitorFailure(ctx, "ROADMAP_ITEM", itemID, projectID, err)
+			errs = append(errs, err)
+			continue
+		}
+		snapshot, states := latestContractSnapshot(snapshots)
+		if snapshot == nil {
+			continue
+		}
+
+		for _, c := range byItem[itemID] {
+			if err := ctx.Err(); err != nil {
+				return entries, errors.Join(append(errs, err)...)
+			}
+			baseline, ok := states[c.ID.String()]
+			if !ok {
+				continue
+			}
+			entry, err := s.checkMonitoredContract(ctx, projectID, snapshot.ID, baseline, c)
+			if err != nil {
+				s.recordMonitorFailure(ctx, "CONTRACT", c.ID, projectID, err)
+				errs = append(errs, err)
+				continue
+			}
+			entries = append(entries, *entry)
+		}
+	}
+	return entries, errors.Join(errs...)
+}
+
+func (s *driftService) recordMonitorFailure(ctx context.Context, entityType string, entityID, projectID uuid.UUID, err error) {
+	s.auditLog.Log(ctx, entityType, entityID, "DRIFT_MONITOR_FAILED", uuid.Nil, nil,
+		map[string]interface{}{"source": "monitor", "project_id": projectID, "error": err.Error()},
+	)
+}
+
+func (s *driftService) checkMonitoredContract(ctx context.Context, projectID, snapshotID uuid.UUID, baseline map[string]interface{}, c domain.ContractDefinition) (*domain.DriftTimelineEntry, error) {
+	details, err := s.diffEngine.Compare(baseline, contractState(c))
+	if err != nil {
+		return nil, fmt.Errorf("contract %s: %w", c.ID, err)
+	}
+
+	entry := &domain.DriftTimelineEntry{
+		ID:            uuid.New(),
+		ProjectID:     projectID,
+		RoadmapItemID: c.RoadmapItemID,
+		ContractID:    c.ID,
+		SnapshotID:    snapshotID,
+		Changes:       make([]domain.DriftTimelineChange, 0, len(details.Items)),
+		CheckedAt:     time.Now(),
+	}
+	for _, item := range details.Items {
+		breaking := isBreaking(item)
+		entry.Changes = append(entry.Changes, domain.DriftTimelineChange{Path: item.Location, Description: item.Description, Severity: item.Severity, Breaking: breaking})
+		if breaking {
+			entry.BreakingChanges++
+		} else {
+			entry.NonBreakingChanges++
+		}
+	}
+
+	previous, err := s.monitorRepo.LatestTimelineEntry(ctx, c.ID)
+	if err != nil {
+		return nil, fmt.Errorf("contract %s: %w", c.ID, err)
+	}
+	entry.NewBreakingChanges = len(newBreakingChanges(previous, entry))
+
+	if err := s.monitorRepo.AppendTimelineEntry(ctx, entry); err != nil {
+		return nil, fmt.Errorf("contract %s: failed to store timeline entry: %w", c.ID, err)
+	}
+	if entry.NewBreakingChanges > 0 {
+		s.auditLog.Log(ctx, "CONTRACT", c.ID, "DRIFT_DETECTED", uuid.Nil,
+			map[string]interface{}{"snapshot_id": snapshotID},
+			map[string]interface{}{
+				"source":               "monitor",
+				"breaking_changes":     entry.BreakingChanges,
+				"new_breaking_changes": entry.NewBreakingChanges,
+			},
+		)
+	}
+	return entry, nil
+}
+
+// newBreakingChanges returns the breaking changes of current that previous did not report.
+func newBreakingChanges(previous, current *domain.DriftTimelineEntry) []domain.DriftTimelineChange {
+	known := make(map[string]bool)
+	if previous != nil {
+		for _, ch := range previous.Changes {
+			if ch.Breaking {
+				known[ch.Path+"\x00"+ch.Description] = true
+			}
+		}
+	}
+	var out []domain.DriftTimelineChange
+	for _, ch := range current.Changes {
+		if ch.Breaking && !known[ch.Path+"\x00"+ch.Description] {
+			out = append(out, ch)
+		}
+	}
+	return out
+}
+
+// FeatureScorer recalculates a roadmap item's feature intelligence, including its DriftRiskScore.
+type FeatureScorer interface {
+	CalculateFeatureScore(ctx context.Context, featureID uuid.UUID) (*domain.FeatureIntelligence, error)
+}
+
+type Notifier interface {
+	Broadcast(eventType string, payload interface{})
+}
+
+// Monitor runs scheduled drift checks in the background. Schedules are claimed
+// through the database with a lease, so several server instances can run a
+// Monitor without checking the same project twice; a lease left behind by a
+// crashed instance expires and the schedule is picked up again. The lease is
+// renewed while a run is in progress, so long runs keep their claim.
+type Monitor struct {
+	service  DriftService
+	repo     DriftMonitorRepo
+	scorer   FeatureScorer
+	notifier Notifier
+	owner    string
+	interval time.Duration
+	lease    time.Duration
+}
+
+func NewMonitor(service DriftService, repo DriftMonitorRepo, scorer FeatureScorer, notifier Notifier) *Monitor {
+	host, _ := os.Hostname()
+	return &Monitor{
+		service:  service,
+		repo:     repo,
+		scorer:   scorer,
+		notifier: notifier,
+		owner:    fmt.Sprintf("%s/%d/%s", host, os.Getpid(), uuid.NewString()[:8]),
+		interval: 30 * time.Second,
+		lease:    15 * time.Minute,
+	}
+}
+
+// Start polls for due schedules until ctx is cancelled.
+func (m *Monitor) Start(ctx context.Context) {
+	ticker := time.NewTicker(m.interval)
+	defer ticker.Stop()
+	for {
+		m.RunDue(ctx)
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// RunDue claims and runs due schedules until none are left.
+func (m *Monitor) RunDue(ctx context.Context) {
+	for ctx.Err() == nil {
+		schedule, err := m.repo.ClaimDue(ctx, m.owner, time.Now(), m.lease)
+		if err != nil {
+			logger.Error("Drift monitor failed to claim schedule", zap.Error(err))
+			return
+		}
+		if schedule == nil {
+			return
+		}
+		m.runSchedule(ctx, schedule)
+	}
+}
+
+func (m *Monitor) runSchedule(ctx context.Context, schedule *domain.DriftMonitorSchedule) {
+	started := time.Now()
+	runCtx, cancel := context.WithCancel(ctx)
+	renewed := make(chan struct{})
+	go func() {
+		defer close(renewed)
+		m.renewLease(runCtx, cancel, schedule.ProjectID)
+	}()
+	entries, err := m.service.RunDriftMonitor(runCtx, schedule.ProjectID)
+	cancel()
+	<-renewed
+	if err != nil {
+		logger.Error("Drift monitor run failed", zap.String("project_id", schedule.ProjectID.String()), zap.Error(err))
+	}
+
+	rescored := make(map[uuid.UUID]bool)
+	for _, e := range entries {
+		if !rescored[e.RoadmapItemID] {
+			rescored[e.RoadmapItemID] = true
+			if _, err := m.scorer.CalculateFeatureScore(ctx, e.RoadmapItemID); err != nil {
+				logger.Warn("Drift monitor failed to recalculate feature score", zap.String("roadmap_item_id", e.RoadmapItemID.String()), zap.Error(err))
+			}
+		}
+		if e.NewBreakingChanges > 0 && m.notifier != nil {
+			m.notifier.Broadcast("DRIFT_BREAKING_CHANGE", e)
+		}
+	}
+
+	// An unparsable expression should have been rejected on save; back off a day rather than spin.
+	next := started.Add(24 * time.Hour)
+	if parsed, err := parseCron(schedule.CronExpression); err == nil {
+		next = parsed.Next(started)
+	}
+	if err := m.repo.Release(ctx, schedule.ProjectID, m.owner, started, next); err != nil {
+		logger.Error("Drift monitor failed to release schedule", zap.String("project_id", schedule.ProjectID.String()), zap.Error(err))
+	}
+}
+
+// renewLease extends the schedule's lease every third of its length until ctx
+// is done. If another instance took the schedule over, the run is cancelled.
+func (m *Monitor) renewLease(ctx context.Context, cancel context.CancelFunc, projectID uuid.UUID) {
+	ticker := time.NewTicker(m.lease / 3)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case now := <-ticker.C:
+			err := m.repo.Renew(ctx, projectID, m.owner, now.Add(m.lease))
+			if errors.Is(err, ErrLeaseLost) {
+				logger.Error("Drift monitor lost its lease, stopping run", zap.String("project_id", projectID.String()))
+				cancel()
+				return
+			}
+			if err != nil && ctx.Err() == nil {
+				logger.Warn("Drift monitor failed to renew lease", zap.String("project_id", projectID.String()), zap.Error(err))
+			}
+		}
+	}
+}
@@ -0,0 +1,204 @@
+package drift
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/SpecForgeVC/SpecForge/internal/domain"
+	"github.com/google/uuid"
+)
+
+type fakeContractRepo struct{ contracts []domain.ContractDefinition }
+
+func (r *fakeContractRepo) Get(ctx context.Context, id uuid.UUID) (*domain.ContractDefinition, error) {
+	return nil, nil
+}
+func (r *fakeContractRepo) List(ctx context.Context, roadmapItemID uuid.UUID) ([]domain.ContractDefinition, error) {
+	return r.contracts, nil
+}
+func (r *fakeContractRepo) ListByProject(ctx context.Context, projectID uuid.UUID) ([]domain.ContractDefinition, error) {
+	return r.contracts, nil
+}
+
+type fakeSnapshotRepo struct{ snapshots []domain.VersionSnapshot }
+
+func (r *fakeSnapshotRepo) Get(ctx context.Context, id uuid.UUID) (*domain.VersionSnapshot, error) {
+	return nil, nil
+}
+func (r *fakeSnapshotRepo) List(ctx context.Context, roadmapItemID uuid.UUID) ([]domain.VersionSnapshot, error) {
+	return r.snapshots, nil
+}
+
+type fakeMonitorRepo struct {
+	DriftMonitorRepo
+	timeline []domain.DriftTimelineEntry
+	failFor  uuid.UUID // contract whose timeline entries fail to store
+
+	mu       sync.Mutex
+	renewals int
+	released bool
+}
+
+func (r *fakeMonitorRepo) AppendTimelineEntry(ctx context.Context, e *domain.DriftTimelineEntry) error {
+	if e.ContractID == r.failFor {
+		return errors.New("insert failed")
+	}
+	r.timeline = append(r.timeline, *e)
+	return nil
+}
+func (r *fakeMonitorRepo) Renew(ctx context.Context, projectID uuid.UUID, owner string, until time.Time) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.renewals++
+	return nil
+}
+func (r *fakeMonitorRepo) Release(ctx context.Context, projectID uuid.UUID, owner string, lastRun, nextRun time.Time) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.released = true
+	return nil
+}
+func (r *fakeMonitorRepo) LatestTimelineEntry(ctx context.Context, contractID uuid.UUID) (*domain.DriftTimelineEntry, error) {
+	for i := len(r.timeline) - 1; i >= 0; i-- {
+		if r.timeline[i].ContractID == contractID {
+			return &r.timeline[i], nil
+		}
+	}
+	return nil, nil
+}
+
+type fakeAuditLogger struct{ actions []string }
+
+func (a *fakeAuditLogger) Log(ctx context.Context, entityType string, entityID uuid.UUID, action string, userID uuid.UUID, oldData, newData map[string]interface{}) error {
+	a.actions = append(a.actions, entityType+":"+action)
+	return nil
+}
+func (a *fakeAuditLogger) ListDriftEvents(ctx context.Context) ([]domain.AuditLog, error) {
+	return nil, nil
+}
+
+func TestRunDriftMonitor(t *testing.T) {
+	itemID := uuid.New()
+	contract := domain.ContractDefinition{
+		ID:            uuid.New(),
+		RoadmapItemID: itemID,
+		InputSchema: map[string]interface{}{
+			"type":       "object",
+			"properties": map[string]interface{}{"id": map[string]interface{}{"type": "integer"}},
+		},
+	}
+	baseline := map[string]interface{}{
+		"input": map[string]interface{}{
+			"type":       "object",
+			"properties": map[string]interface{}{"id": map[string]interface{}{"type": "string"}},
+		},
+	}
+	baselineID := uuid.New()
+	snapshots := &fakeSnapshotRepo{snapshots: []domain.VersionSnapshot{
+		{ID: uuid.New(), RoadmapItemID: itemID, CreatedAt: time.Now().Add(-2 * time.Hour), SnapshotData: map[string]interface{}{"roadmap_item": "pre-contracts"}},
+		{ID: baselineID, RoadmapItemID: itemID, CreatedAt: time.Now().Add(-time.Hour), SnapshotData: map[string]interface{}{
+			"contracts": map[string]interface{}{contract.ID.String(): baseline},
+		}},
+	}}
+	monitorRepo := &fakeMonitorRepo{}
+	audit := &fakeAuditLogger{}
+	svc := NewDriftService(&fakeContractRepo{contracts: []domain.ContractDefinition{contract}}, nil, snapshots, nil, nil, nil, monitorRepo, nil, nil, NewDiffEngine(), audit)
+
+	first, err := svc.RunDriftMonitor(context.Background(), uuid.New())
+	if err != nil {
+		t.Fatalf("RunDriftMonitor failed: %v", err)
+	}
+	if len(first) != 1 || first[0].BreakingChanges == 0 || first[0].NewBreakingChanges != first[0].BreakingChanges {
+		t.Fatalf("expected the type change to be reported as new breaking drift, got %+v", first)
+	}
+	if first[0].SnapshotID != baselineID {
+		t.Errorf("expected the snapshot capturing contracts to be the baseline")
+	}
+
+	second, err := svc.RunDriftMonitor(context.Background(), uuid.New())
+	if err != nil {
+		t.Fatalf("RunDriftMonitor failed: %v", err)
+	}
+	if len(second) != 1 || second[0].NewBreakingChanges != 0 {
+		t.Errorf("expected already reported drift not to count as new, got %+v", second)
+	}
+	if len(monitorRepo.timeline) != 2 || len(audit.actions) != 1 {
+		t.Errorf("expected 2 timeline entries and 1 drift audit event, got %d and %v", len(monitorRepo.timeline), audit.actions)
+	}
+}
+
+func TestRunDriftMonitor_ContinuesPastContractErrors(t *testing.T) {
+	itemID := uuid.New()
+	schema := map[string]interface{}{"type": "object"}
+	failing := domain.ContractDefinition{ID: uuid.New(), RoadmapItemID: itemID, InputSchema: schema}
+	healthy := domain.ContractDefinition{ID: uuid.New(), RoadmapItemID: itemID, InputSchema: schema}
+	snapshots := &fakeSnapshotRepo{snapshots: []domain.VersionSnapshot{
+		{ID: uuid.New(), RoadmapItemID: itemID, CreatedAt: time.Now(), SnapshotData: map[string]interface{}{
+			"contracts": map[string]interface{}{
+				failing.ID.String(): map[string]interface{}{"input": schema},
+				healthy.ID.String(): map[string]interface{}{"input": schema},
+			},
+		}},
+	}}
+	monitorRepo := &fakeMonitorRepo{failFor: failing.ID}
+	audit := &fakeAuditLogger{}
+	contracts := &fakeContractRepo{contracts: []domain.ContractDefinition{failing, healthy}}
+	svc := NewDriftService(contracts, nil, snapshots, nil, nil, nil, monitorRepo, nil, nil, NewDiffEngine(), audit)
+
+	entries, err := svc.RunDriftMonitor(context.Background(), uuid.New())
+	if err == nil {
+		t.Error("expected the failed contract to be reported")
+	}
+	if len(entries) != 1 || entries[0].ContractID != healthy.ID {
+		t.Errorf("expected the healthy contract to still be checked, got %+v", entries)
+	}
+	if len(audit.actions) != 1 || audit.actions[0] != "CONTRACT:DRIFT_MONITOR_FAILED" {
+		t.Errorf("expected the failure to be recorded, got %v", audit.actions)
+	}
+}
+
+// slowDriftService stands in for a monitor run that outlasts several lease renewals.
+type slowDriftService struct {
+	DriftService
+	delay time.Duration
+}
+
+func (s *slowDriftService) RunDriftMonitor(ctx context.Context, projectID uuid.UUID) ([]domain.DriftTimelineEntry, error) {
+	select {
+	case <-time.After(s.delay):
+	case <-ctx.Done():
+	}
+	return nil, nil
+}
+
+func TestMonitor_RenewsLeaseDuringRun(t *testing.T) {
+	repo := &fakeMonitorRepo{}
+	m := NewMonitor(&slowDriftService{delay: 100 * time.Millisecond}, repo, nil, nil)
+	m.lease = 30 * time.Millisecond
+
+	m.runSchedule(context.Background(), &domain.DriftMonitorSchedule{ProjectID: uuid.New(), CronExpression: "@hourly"})
+
+	repo.mu.Lock()
+	defer repo.mu.Unlock()
+	if repo.renewals < 2 {
+		t.Errorf("expected the lease to be renewed during the run, got %d renewals", repo.renewals)
+	}
+	if !repo.released {
+		t.Error("expected the schedule to be released after the run")
+	}
+}
+
+func TestParseCron(t *testing.T) {
+	if _, err := parseCron("*/15 * * * *"); err != nil {
+		t.Errorf("expected five-field expression to parse: %v", err)
+	}
+	if _, err := parseCron("@daily"); err != nil {
+		t.Errorf("expected descriptor to parse: %v", err)
+	}
+	if _, err := parseCron("every minute"); err == nil {
+		t.Errorf("expected invalid expression to be rejected")
+	}
+}
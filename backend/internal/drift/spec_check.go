@@ -0,0 +1,198 @@
+package drift
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/SpecForgeVC/SpecForge/internal/domain"
+	specdrift "github.com/SpecForgeVC/SpecForge/internal/domain/drift"
+	"github.com/SpecForgeVC/SpecForge/internal/openapi"
+	"github.com/google/uuid"
+)
+
+// SpecSource identifies one side of a spec drift check: either an uploaded
+// OpenAPI document (YAML or JSON) or the stored contract set of a roadmap item.
+type SpecSource struct {
+	Document      []byte
+	RoadmapItemID uuid.UUID
+}
+
+// SpecDriftInput describes an OpenAPI-to-OpenAPI drift check. Role is the
+// caller's role; only roles in PolicyEditorRoles may set Policy.
+type SpecDriftInput struct {
+	ProjectID uuid.UUID
+	Baseline  SpecSource
+	Proposed  SpecSource
+	Policy    *specdrift.DriftPolicy // overrides the project policy when set
+	UserID    uuid.UUID
+	Role      domain.Role
+}
+
+// PolicyEditorRoles may change a project's drift policy, and so override it
+// for a single check.
+var PolicyEditorRoles = []domain.Role{domain.RoleOwner, domain.RoleAdmin}
+
+var (
+	ErrPolicyOverrideForbidden = errors.New("only owners and admins may override the drift policy")
+	ErrForeignRoadmapItem      = errors.New("roadmap item does not belong to the project")
+)
+
+// DefaultSpecDriftPolicy blocks on any breaking or critical change.
+// It applies to projects that have not configured their own policy.
+var DefaultSpecDriftPolicy = specdrift.DriftPolicy{
+	BlockOnBreaking: true,
+	BlockOnCritical: true,
+}
+
+func (s *driftService) RunSpecDriftCheck(ctx context.Context, input SpecDriftInput) (*domain.SpecDriftCheck, error) {
+	if input.Policy != nil && !slices.Contains(PolicyEditorRoles, input.Role) {
+		return nil, ErrPolicyOverrideForbidden
+	}
+	baseline, baselineLabel, err := s.loadSpecSource(ctx, input.ProjectID, input.Baseline)
+	if err != nil {
+		return nil, fmt.Errorf("baseline: %w", err)
+	}
+	proposed, proposedLabel, err := s.loadSpecSource(ctx, input.ProjectID, input.Proposed)
+	if err != nil {
+		return nil, fmt.Errorf("proposed: %w", err)
+	}
+
+	policy := DefaultSpecDriftPolicy
+	if input.Policy != nil {
+		policy = *input.Policy
+	} else {
+		projectPolicy, err := s.GetDriftPolicy(ctx, input.ProjectID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to load drift policy: %w", err)
+		}
+		policy = projectPolicy.Policy
+	}
+
+	report := specdrift.DetectDrift(specdrift.DriftInput{Baseline: *baseline, Proposed: *proposed})
+	policy.Evaluate(&report)
+
+	check := &domain.SpecDriftCheck{
+		ID:             uuid.New(),
+		ProjectID:      input.ProjectID,
+		BaselineSource: baselineLabel,
+		ProposedSource: proposedLabel,
+		Policy:         policy,
+		Report:         report,
+		CreatedBy:      input.UserID,
+		CreatedAt:      time.Now(),
+	}
+	if err := s.checkRepo.Create(ctx, check); err != nil {
+		return nil, fmt.Errorf("failed to persist drift check: %w", err)
+	}
+
+	if len(report.Items) > 0 {
+		s.auditLog.Log(ctx, "SPEC_DRIFT_CHECK", check.ID, "DRIFT_DETECTED", input.UserID,
+			map[string]interface{}{"source": baselineLabel},
+			map[string]interface{}{
+				"source":           proposedLabel,
+				"project_id":       input.ProjectID,
+				"critical_changes": report.CriticalChanges,
+				"breaking_changes": report.BreakingChanges,
+				"warnings":         report.Warnings,
+				"infos":            report.Infos,
+				"blocked":          report.Blocked,
+			},
+		)
+	}
+
+	return check, nil
+}
+
+func (s *driftService) GetSpecDriftCheck(ctx context.Context, id uuid.UUID) (*domain.SpecDriftCheck, error) {
+	return s.checkRepo.Get(ctx, id)
+}
+
+func (s *driftService) ListSpecDriftChecks(ctx context.Context, projectID uuid.UUID) ([]domain.SpecDriftCheck, error) {
+	return s.checkRepo.ListByProject(ctx, projectID)
+}
+
+// GetDriftPolicy returns the project's drift policy, or the default policy if none is stored.
+func (s *driftService) GetDriftPolicy(ctx context.Context, projectID uuid.UUID) (*domain.ProjectDriftPolicy, error) {
+	p, err := s.policyRepo.Get(ctx, projectID)
+	if err != nil {
+		return nil, err
+	}
+	if p == nil {
+		return &domain.ProjectDriftPolicy{ProjectID: projectID, Policy: DefaultSpecDriftPolicy}, nil
+	}
+	return p, nil
+}
+
+func (s *driftService) UpdateDriftPolicy(ctx context.Context, projectID uuid.UUID, policy specdrift.DriftPolicy, userID uuid.UUID) (*domain.ProjectDriftPolicy, error) {
+	if err := policy.Validate(); err != nil {
+		return nil, err
+	}
+	for i := range policy.Waivers {
+		if policy.Waivers[i].ID == "" {
+			policy.Waivers[i].ID = uuid.NewString()
+		}
+	}
+
+	old, err := s.GetDriftPolicy(ctx, projectID)
+	if err != nil {
+		return nil, err
+	}
+
+	p := &domain.ProjectDriftPolicy{
+		ProjectID: projectID,
+		Policy:    policy,
+		UpdatedBy: userID,
+		UpdatedAt: time.Now(),
+	}
+	if err := s.policyRepo.Upsert(ctx, p); err != nil {
+		return nil, err
+	}
+
+	s.auditLog.Log(ctx, "DRIFT_POLICY", projectID, "UPDATE", userID, policyAuditData(old.Policy), policyAuditData(policy))
+	return p, nil
+}
+
+func policyAuditData(p specdrift.DriftPolicy) map[string]interface{} {
+	var data map[string]interface{}
+	raw, _ := json.Marshal(p)
+	json.Unmarshal(raw, &data)
+	return data
+}
+
+// loadSpecSource resolves a SpecSource into the drift model and a label
+// describing where it came from. A roadmap item must belong to projectID.
+func (s *driftService) loadSpecSource(ctx context.Context, projectID uuid.UUID, src SpecSource) (*specdrift.OpenAPIDocument, string, error) {
+	switch {
+	case len(src.Document) > 0:
+		doc, err := openapi.Load(src.Document)
+		if err != nil {
+			return nil, "", err
+		}
+		return doc, "upload", nil
+
+	case src.RoadmapItemID != uuid.Nil:
+		item, err := s.roadmapRepo.Get(ctx, src.RoadmapItemID)
+		if err != nil {
+			return nil, "", fmt.Errorf("failed to load roadmap item: %w", err)
+		}
+		if item.ProjectID != projectID {
+			return nil, "", ErrForeignRoadmapItem
+		}
+		contracts, err := s.contractRepo.List(ctx, src.RoadmapItemID)
+		if err != nil {
+			return nil, "", fmt.Errorf("failed to list contracts: %w", err)
+		}
+		doc, err := openapi.FromContracts(contracts)
+		if err != nil {
+			return nil, "", err
+		}
+		return doc, "roadmap_item:" + src.RoadmapItemID.String(), nil
+
+	default:
+		return nil, "", fmt.Errorf("either a document or a roadmap_item_id is required")
+	}
+}
@@ -0,0 +1,107 @@
+package drift
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/SpecForgeVC/SpecForge/internal/domain"
+	specdrift "github.com/SpecForgeVC/SpecForge/internal/domain/drift"
+	"github.com/google/uuid"
+)
+
+const emptySpec = `openapi: 3.0.0
+info: {title: test, version: "1"}
+paths: {}
+`
+
+type fakeRoadmapRepo struct {
+	items map[uuid.UUID]domain.RoadmapItem
+}
+
+func (r *fakeRoadmapRepo) Get(ctx context.Context, id uuid.UUID) (*domain.RoadmapItem, error) {
+	item, ok := r.items[id]
+	if !ok {
+		return nil, errors.New("not found")
+	}
+	return &item, nil
+}
+
+type fakeSpecCheckRepo struct {
+	SpecDriftCheckRepo
+	checks []domain.SpecDriftCheck
+}
+
+func (r *fakeSpecCheckRepo) Create(ctx context.Context, check *domain.SpecDriftCheck) error {
+	r.checks = append(r.checks, *check)
+	return nil
+}
+
+type fakePolicyRepo struct{ policy *domain.ProjectDriftPolicy }
+
+func (r *fakePolicyRepo) Get(ctx context.Context, projectID uuid.UUID) (*domain.ProjectDriftPolicy, error) {
+	return r.policy, nil
+}
+func (r *fakePolicyRepo) Upsert(ctx context.Context, p *domain.ProjectDriftPolicy) error {
+	r.policy = p
+	return nil
+}
+
+func TestRunSpecDriftCheck_ForeignRoadmapItem(t *testing.T) {
+	projectID, otherProject := uuid.New(), uuid.New()
+	foreign := domain.RoadmapItem{ID: uuid.New(), ProjectID: otherProject}
+	roadmap := &fakeRoadmapRepo{items: map[uuid.UUID]domain.RoadmapItem{foreign.ID: foreign}}
+	checks := &fakeSpecCheckRepo{}
+	svc := NewDriftService(&fakeContractRepo{}, roadmap, nil, checks, &fakePolicyRepo{}, nil, nil, nil, nil, nil, &fakeAuditLogger{})
+
+	_, err := svc.RunSpecDriftCheck(context.Background(), SpecDriftInput{
+		ProjectID: projectID,
+		Baseline:  SpecSource{RoadmapItemID: foreign.ID},
+		Proposed:  SpecSource{Document: []byte(emptySpec)},
+	})
+	if !errors.Is(err, ErrForeignRoadmapItem) {
+		t.Fatalf("expected ErrForeignRoadmapItem, got %v", err)
+	}
+	if len(checks.checks) != 0 {
+		t.Errorf("expected no check to be persisted, got %d", len(checks.checks))
+	}
+}
+
+func TestRunSpecDriftCheck_PolicyOverride(t *testing.T) {
+	projectID := uuid.New()
+	stored := specdrift.DriftPolicy{BlockOnBreaking: true, BlockOnCritical: true}
+	override := &specdrift.DriftPolicy{}
+	checks := &fakeSpecCheckRepo{}
+	policies := &fakePolicyRepo{policy: &domain.ProjectDriftPolicy{ProjectID: projectID, Policy: stored}}
+	svc := NewDriftService(&fakeContractRepo{}, &fakeRoadmapRepo{}, nil, checks, policies, nil, nil, nil, nil, nil, &fakeAuditLogger{})
+
+	input := SpecDriftInput{
+		ProjectID: projectID,
+		Baseline:  SpecSource{Document: []byte(emptySpec)},
+		Proposed:  SpecSource{Document: []byte(emptySpec)},
+		Policy:    override,
+		Role:      domain.RoleEngineer,
+	}
+	if _, err := svc.RunSpecDriftCheck(context.Background(), input); !errors.Is(err, ErrPolicyOverrideForbidden) {
+		t.Fatalf("expected ErrPolicyOverrideForbidden for an engineer, got %v", err)
+	}
+
+	input.Policy = nil
+	check, err := svc.RunSpecDriftCheck(context.Background(), input)
+	if err != nil {
+		t.Fatalf("RunSpecDriftCheck: %v", err)
+	}
+	if !check.Policy.BlockOnBreaking {
+		t.Errorf("expected the stored project policy without an override, got %+v", check.Policy)
+	}
+
+	input.Policy = override
+	input.Role = domain.RoleAdmin
+	check, err = svc.RunSpecDriftCheck(context.Background(), input)
+	if err != nil {
+		t.Fatalf("RunSpecDriftCheck: %v", err)
+	}
+	if check.Policy.BlockOnBreaking || check.Policy.BlockOnCritical {
+		t.Errorf("expected the admin's override to apply, got %+v", check.Policy)
+	}
+}
@@ -0,0 +1,82 @@
+package drift
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/SpecForgeVC/SpecForge/internal/domain"
+	"github.com/SpecForgeVC/SpecForge/internal/traffic"
+	"github.com/google/uuid"
+)
+
+var ErrInvalidRecording = errors.New("invalid traffic recording")
+
+// TrafficDriftInput is an uploaded HAR or JSONL recording to check against the
+// REST contracts of a project, or of a single roadmap item when RoadmapItemID is set.
+type TrafficDriftInput struct {
+	ProjectID     uuid.UUID
+	RoadmapItemID uuid.UUID
+	Recording     []byte
+	UserID        uuid.UUID
+}
+
+func (s *driftService) RunTrafficDriftCheck(ctx context.Context, input TrafficDriftInput) (*domain.TrafficDriftCheck, error) {
+	exchanges, format, err := traffic.Parse(input.Recording)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidRecording, err)
+	}
+
+	var contracts []domain.ContractDefinition
+	if input.RoadmapItemID != uuid.Nil {
+		var item *domain.RoadmapItem
+		if item, err = s.roadmapRepo.Get(ctx, input.RoadmapItemID); err != nil {
+			return nil, fmt.Errorf("failed to load roadmap item: %w", err)
+		}
+		if item.ProjectID != input.ProjectID {
+			return nil, ErrForeignRoadmapItem
+		}
+		contracts, err = s.contractRepo.List(ctx, input.RoadmapItemID)
+	} else {
+		contracts, err = s.contractRepo.ListByProject(ctx, input.ProjectID)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to list contracts: %w", err)
+	}
+
+	check := &domain.TrafficDriftCheck{
+		ID:        uuid.New(),
+		ProjectID: input.ProjectID,
+		Source:    format,
+		Report:    traffic.Analyze(exchanges, traffic.RoutesFromContracts(contracts)),
+		CreatedBy: input.UserID,
+		CreatedAt: time.Now(),
+	}
+	if err := s.trafficRepo.Create(ctx, check); err != nil {
+		return nil, fmt.Errorf("failed to persist traffic drift check: %w", err)
+	}
+
+	if check.Report.HasFindings() {
+		s.auditLog.Log(ctx, "TRAFFIC_DRIFT_CHECK", check.ID, "DRIFT_DETECTED", input.UserID, nil,
+			map[string]interface{}{
+				"source":                 format,
+				"project_id":             input.ProjectID,
+				"total_exchanges":        check.Report.TotalExchanges,
+				"undocumented_endpoints": len(check.Report.UndocumentedEndpoints),
+				"undocumented_fields":    len(check.Report.UndocumentedFields),
+				"schema_violations":      len(check.Report.SchemaViolations),
+			},
+		)
+	}
+
+	return check, nil
+}
+
+func (s *driftService) GetTrafficDriftCheck(ctx context.Context, id uuid.UUID) (*domain.TrafficDriftCheck, error) {
+	return s.trafficRepo.Get(ctx, id)
+}
+
+func (s *driftService) ListTrafficDriftChecks(ctx context.Context, projectID uuid.UUID) ([]domain.TrafficDriftCheck, error) {
+	return s.trafficRepo.ListByProject(ctx, projectID)
+}
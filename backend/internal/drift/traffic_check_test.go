@@ -0,0 +1,49 @@
+package drift
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/SpecForgeVC/SpecForge/internal/domain"
+	"github.com/google/uuid"
+)
+
+type fakeTrafficRepo struct {
+	TrafficDriftCheckRepo
+	checks []domain.TrafficDriftCheck
+}
+
+func (r *fakeTrafficRepo) Create(ctx context.Context, check *domain.TrafficDriftCheck) error {
+	r.checks = append(r.checks, *check)
+	return nil
+}
+
+func TestRunTrafficDriftCheck_ForeignRoadmapItem(t *testing.T) {
+	projectID := uuid.New()
+	own := domain.RoadmapItem{ID: uuid.New(), ProjectID: projectID}
+	foreign := domain.RoadmapItem{ID: uuid.New(), ProjectID: uuid.New()}
+	roadmap := &fakeRoadmapRepo{items: map[uuid.UUID]domain.RoadmapItem{own.ID: own, foreign.ID: foreign}}
+	checks := &fakeTrafficRepo{}
+	svc := NewDriftService(&fakeContractRepo{}, roadmap, nil, nil, nil, checks, nil, nil, nil, nil, &fakeAuditLogger{})
+
+	input := TrafficDriftInput{
+		ProjectID:     projectID,
+		RoadmapItemID: foreign.ID,
+		Recording:     []byte(`{"method": "GET", "path": "/users", "status": 200}` + "\n"),
+	}
+	if _, err := svc.RunTrafficDriftCheck(context.Background(), input); !errors.Is(err, ErrForeignRoadmapItem) {
+		t.Fatalf("expected ErrForeignRoadmapItem, got %v", err)
+	}
+	if len(checks.checks) != 0 {
+		t.Errorf("expected no check to be persisted, got %d", len(checks.checks))
+	}
+
+	input.RoadmapItemID = own.ID
+	if _, err := svc.RunTrafficDriftCheck(context.Background(), input); err != nil {
+		t.Fatalf("RunTrafficDriftCheck: %v", err)
+	}
+	if len(checks.checks) != 1 {
+		t.Errorf("expected the check to be persisted, got %d", len(checks.checks))
+	}
+}
@@ -0,0 +1,249 @@
+package graphql
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/SpecForgeVC/SpecForge/internal/domain/drift"
+	"github.com/vektah/gqlparser/v2/ast"
+)
+
+// Compare parses two SDL documents and reports the drift between them.
+// Locations are GraphQL schema coordinates ("Query.user", "Query.user(id:)", "Role.ADMIN").
+func Compare(baselineSDL, proposedSDL string) (drift.DriftReport, error) {
+	baseline, err := Parse(baselineSDL)
+	if err != nil {
+		return drift.DriftReport{}, fmt.Errorf("baseline: %w", err)
+	}
+	proposed, err := Parse(proposedSDL)
+	if err != nil {
+		return drift.DriftReport{}, fmt.Errorf("proposed: %w", err)
+	}
+	return drift.NewDriftReport(CompareSchemas(baseline, proposed)), nil
+}
+
+// CompareSchemas compares two parsed schemas, ignoring built-in types.
+func CompareSchemas(baseline, proposed *ast.Schema) []drift.DriftItem {
+	var items []drift.DriftItem
+
+	for _, name := range typeNames(baseline) {
+		base := baseline.Types[name]
+		prop, exists := proposed.Types[name]
+		if !exists {
+			items = append(items, newItem(drift.TypeRemoved, name, name, nil, fmt.Sprintf("Type '%s' removed", name)))
+			continue
+		}
+		if base.Kind != prop.Kind {
+			items = append(items, newItem(drift.FieldTypeChanged, name, string(base.Kind), string(prop.Kind),
+				fmt.Sprintf("Type '%s' changed from %s to %s", name, base.Kind, prop.Kind)))
+			continue
+		}
+		items = append(items, compareDefinition(base, prop)...)
+	}
+
+	for _, name := range typeNames(proposed) {
+		if _, exists := baseline.Types[name]; !exists {
+			items = append(items, newItem(drift.TypeAdded, name, nil, name, fmt.Sprintf("Type '%s' added", name)))
+		}
+	}
+
+	return items
+}
+
+func compareDefinition(base, prop *ast.Definition) []drift.DriftItem {
+	switch base.Kind {
+	case ast.Object, ast.Interface:
+		return compareFields(base, prop, false)
+	case ast.InputObject:
+		return compareFields(base, prop, true)
+	case ast.Enum:
+		return compareEnumValues(base, prop)
+	case ast.Union:
+		return compareUnionMembers(base, prop)
+	default:
+		return nil
+	}
+}
+
+func compareFields(base, prop *ast.Definition, input bool) []drift.DriftItem {
+	var items []drift.DriftItem
+
+	for _, bf := range base.Fields {
+		if strings.HasPrefix(bf.Name, "__") {
+			continue
+		}
+		coord := base.Name + "." + bf.Name
+		pf := prop.Fields.ForName(bf.Name)
+		if pf == nil {
+			items = append(items, newItem(drift.FieldRemoved, coord, bf.Type.String(), nil, fmt.Sprintf("Field '%s' removed", coord)))
+			continue
+		}
+		if input {
+			items = append(items, compareInputType(coord, bf.Type, pf.Type)...)
+		} else {
+			items = append(items, compareOutputType(coord, bf.Type, pf.Type)...)
+			items = append(items, compareArguments(coord, bf.Arguments, pf.Arguments)...)
+		}
+		items = append(items, compareDeprecation(coord, bf.Directives, pf.Directives)...)
+	}
+
+	for _, pf := range prop.Fields {
+		if strings.HasPrefix(pf.Name, "__") || base.Fields.ForName(pf.Name) != nil {
+			continue
+		}
+		coord := base.Name + "." + pf.Name
+		if input && pf.Type.NonNull && pf.DefaultValue == nil {
+			items = append(items, newItem(drift.RequiredFieldAdded, coord, nil, pf.Type.String(), fmt.Sprintf("Required input field '%s' added", coord)))
+			continue
+		}
+		items = append(items, newItem(drift.FieldAdded, coord, nil, pf.Type.String(), fmt.Sprintf("Field '%s' added", coord)))
+	}
+
+	return items
+}
+
+func compareArguments(field string, base, prop ast.ArgumentDefinitionList) []drift.DriftItem {
+	var items []drift.DriftItem
+
+	for _, ba := range base {
+		coord := field + "(" + ba.Name + ":)"
+		pa := prop.ForName(ba.Name)
+		if pa == nil {
+			items = append(items, newItem(drift.ArgumentRemoved, coord, ba.Type.String(), nil, fmt.Sprintf("Argument '%s' removed", coord)))
+			continue
+		}
+		items = append(items, compareInputType(coord, ba.Type, pa.Type)...)
+		items = append(items, compareDeprecation(coord, ba.Directives, pa.Directives)...)
+	}
+
+	for _, pa := range prop {
+		if base.ForName(pa.Name) != nil {
+			continue
+		}
+		coord := field + "(" + pa.Name + ":)"
+		if pa.Type.NonNull && pa.DefaultValue == nil {
+			items = append(items, newItem(drift.RequiredFieldAdded, coord, nil, pa.Type.String(), fmt.Sprintf("Required argument '%s' added", coord)))
+			continue
+		}
+		items = append(items, newItem(drift.FieldAdded, coord, nil, pa.Type.String(), fmt.Sprintf("Optional argument '%s' added", coord)))
+	}
+
+	return items
+}
+
+// compareInputType classifies type changes on arguments and input fields:
+// clients must now send a value when the type becomes non-null.
+func compareInputType(coord string, base, prop *ast.Type) []drift.DriftItem {
+	if base.String() == prop.String() {
+		return nil
+	}
+	if unwrapNonNull(base).String() == unwrapNonNull(prop).String() {
+		if prop.NonNull {
+			return []drift.DriftItem{newItem(drift.ArgumentMadeNonNull, coord, base.String(), prop.String(), fmt.Sprintf("'%s' became non-null", coord))}
+		}
+		return []drift.DriftItem{newItem(drift.ConstraintLoosened, coord, base.String(), prop.String(), fmt.Sprintf("'%s' became nullable", coord))}
+	}
+	return []drift.DriftItem{newItem(drift.FieldTypeChanged, coord, base.String(), prop.String(),
+		fmt.Sprintf("Type of '%s' changed from %s to %s", coord, base.String(), prop.String()))}
+}
+
+// compareOutputType classifies type changes on output fields: returning null
+// where clients expect a value breaks them, the reverse does not.
+func compareOutputType(coord string, base, prop *ast.Type) []drift.DriftItem {
+	if base.String() == prop.String() {
+		return nil
+	}
+	if unwrapNonNull(base).String() == unwrapNonNull(prop).String() && prop.NonNull {
+		return []drift.DriftItem{newItem(drift.MetadataChanged, coord, base.String(), prop.String(), fmt.Sprintf("'%s' became non-null", coord))}
+	}
+	return []drift.DriftItem{newItem(drift.FieldTypeChanged, coord, base.String(), prop.String(),
+		fmt.Sprintf("Type of '%s' changed from %s to %s", coord, base.String(), prop.String()))}
+}
+
+func compareEnumValues(base, prop *ast.Definition) []drift.DriftItem {
+	var items []drift.DriftItem
+	for _, bv := range base.EnumValues {
+		coord := base.Name + "." + bv.Name
+		pv := prop.EnumValues.ForName(bv.Name)
+		if pv == nil {
+			items = append(items, newItem(drift.EnumValueRemoved, coord, bv.Name, nil, fmt.Sprintf("Enum value '%s' removed", coord)))
+			continue
+		}
+		items = append(items, compareDeprecation(coord, bv.Directives, pv.Directives)...)
+	}
+	for _, pv := range prop.EnumValues {
+		if base.EnumValues.ForName(pv.Name) == nil {
+			items = append(items, newItem(drift.EnumValueAdded, base.Name+"."+pv.Name, nil, pv.Name, fmt.Sprintf("Enum value '%s.%s' added", base.Name, pv.Name)))
+		}
+	}
+	return items
+}
+
+func compareUnionMembers(base, prop *ast.Definition) []drift.DriftItem {
+	var items []drift.DriftItem
+	for _, member := range base.Types {
+		if !contains(prop.Types, member) {
+			items = append(items, newItem(drift.FieldRemoved, base.Name, member, nil, fmt.Sprintf("Member '%s' removed from union '%s'", member, base.Name)))
+		}
+	}
+	for _, member := range prop.Types {
+		if !contains(base.Types, member) {
+			items = append(items, newItem(drift.FieldAdded, base.Name, nil, member, fmt.Sprintf("Member '%s' added to union '%s'", member, base.Name)))
+		}
+	}
+	return items
+}
+
+func compareDeprecation(coord string, base, prop ast.DirectiveList) []drift.DriftItem {
+	if base.ForName("deprecated") != nil {
+		return nil
+	}
+	d := prop.ForName("deprecated")
+	if d == nil {
+		return nil
+	}
+	reason := ""
+	if arg := d.Arguments.ForName("reason"); arg != nil && arg.Value != nil {
+		reason = arg.Value.Raw
+	}
+	return []drift.DriftItem{newItem(drift.DeprecationAdded, coord, nil, reason, fmt.Sprintf("'%s' deprecated", coord))}
+}
+
+func newItem(t drift.DriftType, loc string, base, prop any, desc string) drift.DriftItem {
+	return drift.DriftItem{
+		Type:        t,
+		Severity:    drift.GetSeverity(t, base, prop),
+		Location:    loc,
+		Baseline:    base,
+		Proposed:    prop,
+		Description: desc,
+	}
+}
+
+func unwrapNonNull(t *ast.Type) *ast.Type {
+	c := *t
+	c.NonNull = false
+	return &c
+}
+
+func typeNames(s *ast.Schema) []string {
+	names := make([]string, 0, len(s.Types))
+	for name, def := range s.Types {
+		if def.BuiltIn || strings.HasPrefix(name, "__") {
+			continue
+		}
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+func contains(list []string, v string) bool {
+	for _, s := range list {
+		if s == v {
+			return true
+		}
+	}
+	return false
+}
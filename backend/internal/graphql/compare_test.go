@@ -0,0 +1,162 @@
+package graphql
+
+import (
+	"testing"
+
+	"github.com/SpecForgeVC/SpecForge/internal/domain/drift"
+)
+
+const baselineSDL = `
+type Query {
+  user(id: ID, locale: String): User
+  users(role: Role): [User!]!
+}
+
+type User {
+  id: ID!
+  name: String
+  email: String!
+  role: Role!
+}
+
+enum Role {
+  ADMIN
+  EDITOR
+  VIEWER
+}
+
+input UserFilter {
+  role: Role
+}
+`
+
+const proposedSDL = `
+type Query {
+  user(id: ID!): User
+  users(role: Role, first: Int!): [User!]!
+}
+
+type User {
+  id: ID!
+  name: String!
+  email: Int!
+  role: Role! @deprecated(reason: "use roles")
+}
+
+enum Role {
+  ADMIN
+  VIEWER
+  OWNER
+}
+
+input UserFilter {
+  role: Role
+  team: ID!
+}
+`
+
+func findItem(report drift.DriftReport, t drift.DriftType, location string) *drift.DriftItem {
+	for i := range report.Items {
+		if report.Items[i].Type == t && report.Items[i].Location == location {
+			return &report.Items[i]
+		}
+	}
+	return nil
+}
+
+func TestCompare_ClassifiesChanges(t *testing.T) {
+	report, err := Compare(baselineSDL, proposedSDL)
+	if err != nil {
+		t.Fatalf("Compare failed: %v", err)
+	}
+
+	cases := []struct {
+		driftType drift.DriftType
+		location  string
+		severity  drift.DriftSeverity
+	}{
+		{drift.ArgumentMadeNonNull, "Query.user(id:)", drift.Breaking},
+		{drift.ArgumentRemoved, "Query.user(locale:)", drift.Critical},
+		{drift.RequiredFieldAdded, "Query.users(first:)", drift.Breaking},
+		{drift.FieldTypeChanged, "User.email", drift.Critical},
+		{drift.MetadataChanged, "User.name", drift.Info},
+		{drift.DeprecationAdded, "User.role", drift.Warning},
+		{drift.EnumValueRemoved, "Role.EDITOR", drift.Critical},
+		{drift.EnumValueAdded, "Role.OWNER", drift.Warning},
+		{drift.RequiredFieldAdded, "UserFilter.team", drift.Breaking},
+	}
+	for _, tc := range cases {
+		item := findItem(report, tc.driftType, tc.location)
+		if item == nil {
+			t.Errorf("expected %s at %s, got %+v", tc.driftType, tc.location, report.Items)
+			continue
+		}
+		if item.Severity != tc.severity {
+			t.Errorf("expected %s at %s to be %s, got %s", tc.driftType, tc.location, tc.severity, item.Severity)
+		}
+	}
+}
+
+func TestCompare_FieldAndTypeRemoval(t *testing.T) {
+	proposed := `
+type Query {
+  user(id: ID, locale: String): User
+}
+
+type User {
+  id: ID!
+}
+`
+	report, err := Compare(baselineSDL, proposed)
+	if err != nil {
+		t.Fatalf("Compare failed: %v", err)
+	}
+	if findItem(report, drift.FieldRemoved, "Query.users") == nil {
+		t.Errorf("expected Query.users removal")
+	}
+	if findItem(report, drift.FieldRemoved, "User.email") == nil {
+		t.Errorf("expected User.email removal")
+	}
+	if findItem(report, drift.TypeRemoved, "Role") == nil {
+		t.Errorf("expected Role type removal")
+	}
+	if report.CriticalChanges == 0 {
+		t.Errorf("expected removals to count as critical")
+	}
+}
+
+func TestValidateContract(t *testing.T) {
+	if err := ValidateContract(map[string]interface{}{SDLKey: baselineSDL}); err != nil {
+		t.Errorf("expected valid SDL, got %v", err)
+	}
+	if err := ValidateContract(map[string]interface{}{}); err != ErrMissingSDL {
+		t.Errorf("expected ErrMissingSDL, got %v", err)
+	}
+	if err := ValidateContract(map[string]interface{}{SDLKey: "type Query { user: Missing }"}); err == nil {
+		t.Errorf("expected unknown type reference to be rejected")
+	}
+}
+
+func TestParse_Federation(t *testing.T) {
+	subgraph := `extend schema @link(url: "https://specs.apollo.dev/federation/v2.3", import: ["@key", "@external", "@requires"])
+
+type Query { user(id: ID!): User }
+
+type User @key(fields: "id") {
+	id: ID!
+	name: String @external
+	greeting: String @requires(fields: "name")
+}`
+	schema, err := Parse(subgraph)
+	if err != nil {
+		t.Fatalf("expected federation directives to be accepted, got %v", err)
+	}
+	if names := typeNames(schema); len(names) != 2 {
+		t.Errorf("expected federation scalars to be treated as built in, got %v", names)
+	}
+
+	// A document that declares a federation directive itself keeps its own definition.
+	if _, err := Parse("directive @key(fields: String!) on OBJECT\ntype Query { me: User }\ntype User @key(fields: \"id\") { id: ID! }"); err != nil {
+		t.Errorf("expected self-declared @key to be accepted, got %v", err)
+	}
+}
@@ -0,0 +1,97 @@
+package graphql
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/SpecForgeVC/SpecForge/internal/domain"
+	"github.com/vektah/gqlparser/v2"
+	"github.com/vektah/gqlparser/v2/ast"
+	"github.com/vektah/gqlparser/v2/parser"
+)
+
+// SDLKey is the InputSchema key under which GraphQL contracts store their SDL.
+const SDLKey = "sdl"
+
+var ErrMissingSDL = errors.New("GraphQL contracts require the schema SDL in input_schema.sdl")
+
+// SDL returns the SDL stored on a GraphQL contract.
+func SDL(c domain.ContractDefinition) (string, bool) {
+	sdl, ok := c.InputSchema[SDLKey].(string)
+	return sdl, ok && strings.TrimSpace(sdl) != ""
+}
+
+// federationDefinitions declares the Apollo Federation (v1 and v2) directives
+// and scalars a subgraph SDL may use without defining them, keyed by name.
+var federationDefinitions = []struct{ name, sdl string }{
+	{"_Any", "scalar _Any"},
+	{"_FieldSet", "scalar _FieldSet"},
+	{"FieldSet", "scalar FieldSet"},
+	{"link__Import", "scalar link__Import"},
+	{"link__Purpose", "enum link__Purpose { SECURITY EXECUTION }"},
+	{"link", "directive @link(url: String!, as: String, for: link__Purpose, import: [link__Import]) repeatable on SCHEMA"},
+	{"key", "directive @key(fields: FieldSet!, resolvable: Boolean = true) repeatable on OBJECT | INTERFACE"},
+	{"requires", "directive @requires(fields: FieldSet!) on FIELD_DEFINITION"},
+	{"provides", "directive @provides(fields: FieldSet!) on FIELD_DEFINITION"},
+	{"external", "directive @external(reason: String) on OBJECT | FIELD_DEFINITION"},
+	{"extends", "directive @extends on OBJECT | INTERFACE"},
+	{"shareable", "directive @shareable repeatable on OBJECT | FIELD_DEFINITION"},
+	{"inaccessible", "directive @inaccessible on FIELD_DEFINITION | OBJECT | INTERFACE | UNION | ARGUMENT_DEFINITION | SCALAR | ENUM | ENUM_VALUE | INPUT_OBJECT | INPUT_FIELD_DEFINITION"},
+	{"override", "directive @override(from: String!, label: String) on FIELD_DEFINITION"},
+	{"tag", "directive @tag(name: String!) repeatable on FIELD_DEFINITION | OBJECT | INTERFACE | UNION | ARGUMENT_DEFINITION | SCALAR | ENUM | ENUM_VALUE | INPUT_OBJECT | INPUT_FIELD_DEFINITION | SCHEMA"},
+	{"composeDirective", "directive @composeDirective(name: String!) repeatable on SCHEMA"},
+	{"interfaceObject", "directive @interfaceObject on OBJECT"},
+	{"authenticated", "directive @authenticated on FIELD_DEFINITION | OBJECT | INTERFACE | SCALAR | ENUM"},
+	{"requiresScopes", "directive @requiresScopes(scopes: [[String!]!]!) on FIELD_DEFINITION | OBJECT | INTERFACE | SCALAR | ENUM"},
+	{"policy", "directive @policy(policies: [[String!]!]!) on FIELD_DEFINITION | OBJECT | INTERFACE | SCALAR | ENUM"},
+}
+
+// Parse parses and validates an SDL document against the GraphQL spec
+// (built-in scalars and directives are provided by the prelude). Federation
+// directives and scalars are predeclared unless the document defines them.
+func Parse(sdl string) (*ast.Schema, error) {
+	sources := []*ast.Source{{Name: "contract.graphql", Input: sdl}}
+	if prelude := federationPrelude(sdl); prelude != "" {
+		sources = append([]*ast.Source{{Name: "federation.graphql", Input: prelude, BuiltIn: true}}, sources...)
+	}
+	schema, err := gqlparser.LoadSchema(sources...)
+	if err != nil {
+		return nil, fmt.Errorf("invalid GraphQL SDL: %w", err)
+	}
+	return schema, nil
+}
+
+// federationPrelude returns the federation definitions the document does not
+// declare itself. Syntax errors are left for LoadSchema to report.
+func federationPrelude(sdl string) string {
+	doc, err := parser.ParseSchema(&ast.Source{Input: sdl})
+	if err != nil {
+		return ""
+	}
+	declared := map[string]bool{}
+	for _, d := range doc.Directives {
+		declared[d.Name] = true
+	}
+	for _, d := range doc.Definitions {
+		declared[d.Name] = true
+	}
+	var b strings.Builder
+	for _, def := range federationDefinitions {
+		if !declared[def.name] {
+			b.WriteString(def.sdl)
+			b.WriteString("\n")
+		}
+	}
+	return b.String()
+}
+
+// ValidateContract checks that a GraphQL contract carries a valid SDL document.
+func ValidateContract(input map[string]interface{}) error {
+	sdl, ok := SDL(domain.ContractDefinition{InputSchema: input})
+	if !ok {
+		return ErrMissingSDL
+	}
+	_, err := Parse(sdl)
+	return err
+}
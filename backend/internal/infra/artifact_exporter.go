@@ -5,6 +5,7 @@ import (
 	"bytes"
 	"encoding/json"
 	"fmt"
+	"strings"
 
 	"github.com/SpecForgeVC/SpecForge/internal/domain"
 )
@@ -60,6 +61,14 @@ func (e *artifactExporter) exportMarkdown(pkg *domain.BuildArtifactPackage) ([]b
 		buf.WriteString("### Technical Context\n" + pkg.RoadmapContext.TechnicalContext + "\n\n")
 	}
 
+	e.writeEventChannels(&buf, pkg.Contracts)
+	for _, c := range pkg.Contracts {
+		if c.CLI != nil {
+			buf.WriteString("## CLI Reference\n")
+			e.writeCLICommand(&buf, c.CLI, "")
+		}
+	}
+
 	buf.WriteString("## Implementation Prompt\n")
 	buf.WriteString("```markdown\n")
 	buf.WriteString(pkg.BuildPrompts.Implementation)
@@ -76,6 +85,99 @@ func (e *artifactExporter) exportMarkdown(pkg *domain.BuildArtifactPackage) ([]b
 	return buf.Bytes(), "text/markdown", nil
 }
 
+// writeEventChannels lists the topics agents must produce to or consume from.
+func (e *artifactExporter) writeEventChannels(buf *bytes.Buffer, contracts []domain.ContractBundle) {
+	var channels []domain.EventChannel
+	for _, c := range contracts {
+		channels = append(channels, c.Channels...)
+	}
+	if len(channels) == 0 {
+		return
+	}
+
+	buf.WriteString("## Event Channels\n")
+	buf.WriteString("| Channel | Operations | Messages |\n|---|---|---|\n")
+	for _, ch := range channels {
+		names := make([]string, 0, len(ch.Messages))
+		for _, m := range ch.Messages {
+			names = append(names, m.Name)
+		}
+		buf.WriteString(fmt.Sprintf("| `%s` | %s | %s |\n", ch.Name, strings.Join(ch.Operations, ", "), strings.Join(names, ", ")))
+	}
+	buf.WriteString("\n")
+}
+
+// writeCLICommand renders a command and its subcommands as a usage reference.
+func (e *artifactExporter) writeCLICommand(buf *bytes.Buffer, cmd *domain.CLICommand, parent string) {
+	path := strings.TrimSpace(parent + " " + cmd.Name)
+	usage := path
+	if len(cmd.Flags) > 0 {
+		usage += " [flags]"
+	}
+	for _, a := range cmd.Args {
+		arg := a.Name
+		if a.Variadic {
+			arg += "..."
+		}
+		if a.Required {
+			usage += " <" + arg + ">"
+		} else {
+			usage += " [" + arg + "]"
+		}
+	}
+	buf.WriteString(fmt.Sprintf("### `%s`\n", usage))
+	if cmd.Description != "" {
+		buf.WriteString(cmd.Description + "\n")
+	}
+	buf.WriteString("\n")
+
+	if len(cmd.Flags) > 0 {
+		buf.WriteString("| Flag | Type | Default | Required | Description |\n|---|---|---|---|---|\n")
+		for _, f := range cmd.Flags {
+			name := "--" + f.Name
+			if f.Shorthand != "" {
+				name = "-" + f.Shorthand + ", " + name
+			}
+			def := ""
+			if f.Default != nil {
+				def = fmt.Sprintf("`%v`", f.Default)
+			}
+			buf.WriteString(fmt.Sprintf("| `%s` | %s | %s | %t | %s |\n", name, f.Type, def, f.Required, f.Description))
+		}
+		buf.WriteString("\n")
+	}
+	if len(cmd.Env) > 0 {
+		buf.WriteString("**Environment**\n")
+		for _, env := range cmd.Env {
+			line := fmt.Sprintf("- `%s`", env.Name)
+			if env.Required {
+				line += " (required)"
+			} else if env.Default != "" {
+				line += fmt.Sprintf(" (default `%s`)", env.Default)
+			}
+			if env.Description != "" {
+				line += ": " + env.Description
+			}
+			buf.WriteString(line + "\n")
+		}
+		buf.WriteString("\n")
+	}
+	if len(cmd.ExitCodes) > 0 {
+		buf.WriteString("**Exit codes**\n")
+		for _, ec := range cmd.ExitCodes {
+			buf.WriteString(fmt.Sprintf("- `%d`: %s\n", ec.Code, ec.Meaning))
+		}
+		buf.WriteString("\n")
+	}
+	if len(cmd.OutputFormats) > 0 {
+		buf.WriteString(fmt.Sprintf("**Output formats**: %s\n\n", strings.Join(cmd.OutputFormats, ", ")))
+	}
+
+	for i := range cmd.Subcommands {
+		e.writeCLICommand(buf, &cmd.Subcommands[i], path)
+	}
+}
+
 func (e *artifactExporter) exportZip(pkg *domain.BuildArtifactPackage) ([]byte, string, error) {
 	buf := new(bytes.Buffer)
 	w := zip.NewWriter(buf)
@@ -97,6 +199,11 @@ func (e *artifactExporter) exportZip(pkg *domain.BuildArtifactPackage) ([]byte,
 	for _, c := range pkg.Contracts {
 		data, _ := json.MarshalIndent(c, "", "  ")
 		e.addToZip(w, fmt.Sprintf("contracts/%s.json", c.ID), data)
+		if c.CLI != nil {
+			var ref bytes.Buffer
+			e.writeCLICommand(&ref, c.CLI, "")
+			e.addToZip(w, fmt.Sprintf("contracts/%s.cli.md", c.ID), ref.Bytes())
+		}
 	}
 
 	// Schemas (as part of package, or separate files if needed)
@@ -46,6 +46,14 @@ func (r *contractRepository) Get(ctx context.Context, id uuid.UUID) (*domain.Con
 	}, nil
 }
 
+func (r *contractRepository) GetForUpdate(ctx context.Context, id uuid.UUID) (*domain.ContractDefinition, error) {
+	row, err := r.queries.GetContractDefinitionForUpdate(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	return r.mapRow(row), nil
+}
+
 func (r *contractRepository) List(ctx context.Context, roadmapItemID uuid.UUID) ([]domain.ContractDefinition, error) {
 	rows, err := r.queries.ListContractDefinitions(ctx, roadmapItemID)
 	if err != nil {
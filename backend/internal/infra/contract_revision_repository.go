@@ -0,0 +1,119 @@
+package infra
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+
+	"github.com/SpecForgeVC/SpecForge/internal/app"
+	"github.com/SpecForgeVC/SpecForge/internal/domain"
+	"github.com/SpecForgeVC/SpecForge/internal/infra/db"
+	"github.com/google/uuid"
+)
+
+type contractRevisionRepository struct {
+	db db.DBTX
+}
+
+func NewContractRevisionRepository(db db.DBTX) app.ContractRevisionRepository {
+	return &contractRevisionRepository{db: db}
+}
+
+const contractRevisionColumns = `id, contract_id, revision, contract_type, version, input_schema, output_schema, error_schema, deprecated_fields, bump, created_by, created_at`
+
+func (r *contractRevisionRepository) Create(ctx context.Context, rev *domain.ContractRevision) error {
+	query := `
+		INSERT INTO contract_revisions (` + contractRevisionColumns + `)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12)
+	`
+	inputJSON, _ := json.Marshal(rev.InputSchema)
+	outputJSON, _ := json.Marshal(rev.OutputSchema)
+	errorJSON, _ := json.Marshal(rev.ErrorSchema)
+	deprecatedJSON, _ := json.Marshal(rev.DeprecatedFields)
+
+	_, err := r.db.ExecContext(ctx, query,
+		rev.ID,
+		rev.ContractID,
+		rev.Revision,
+		string(rev.ContractType),
+		rev.Version,
+		inputJSON,
+		outputJSON,
+		errorJSON,
+		deprecatedJSON,
+		string(rev.Bump),
+		uuid.NullUUID{UUID: rev.CreatedBy, Valid: rev.CreatedBy != uuid.Nil},
+		rev.CreatedAt,
+	)
+	return err
+}
+
+func (r *contractRevisionRepository) Get(ctx context.Context, contractID uuid.UUID, revision int) (*domain.ContractRevision, error) {
+	query := `SELECT ` + contractRevisionColumns + ` FROM contract_revisions WHERE contract_id = $1 AND revision = $2`
+	return r.scan(r.db.QueryRowContext(ctx, query, contractID, revision))
+}
+
+func (r *contractRevisionRepository) GetLatest(ctx context.Context, contractID uuid.UUID) (*domain.ContractRevision, error) {
+	query := `SELECT ` + contractRevisionColumns + ` FROM contract_revisions WHERE contract_id = $1 ORDER BY revision DESC LIMIT 1`
+	rev, err := r.scan(r.db.QueryRowContext(ctx, query, contractID))
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	return rev, err
+}
+
+func (r *contractRevisionRepository) List(ctx context.Context, contractID uuid.UUID) ([]domain.ContractRevision, error) {
+	query := `SELECT ` + contractRevisionColumns + ` FROM contract_revisions WHERE contract_id = $1 ORDER BY revision ASC`
+	rows, err := r.db.QueryContext(ctx, query, contractID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var revisions []domain.ContractRevision
+	for rows.Next() {
+		rev, err := r.scan(rows)
+		if err != nil {
+			return nil, err
+		}
+		revisions = append(revisions, *rev)
+	}
+	return revisions, rows.Err()
+}
+
+func (r *contractRevisionRepository) scan(row rowScanner) (*domain.ContractRevision, error) {
+	var rev domain.ContractRevision
+	var contractType, bump string
+	var inputJSON, outputJSON, errorJSON, deprecatedJSON []byte
+	var createdBy uuid.NullUUID
+	var createdAt sql.NullTime
+
+	if err := row.Scan(
+		&rev.ID,
+		&rev.ContractID,
+		&rev.Revision,
+		&contractType,
+		&rev.Version,
+		&inputJSON,
+		&outputJSON,
+		&errorJSON,
+		&deprecatedJSON,
+		&bump,
+		&createdBy,
+		&createdAt,
+	); err != nil {
+		return nil, err
+	}
+
+	rev.ContractType = domain.ContractType(contractType)
+	rev.Bump = domain.VersionBump(bump)
+	json.Unmarshal(inputJSON, &rev.InputSchema)
+	json.Unmarshal(outputJSON, &rev.OutputSchema)
+	json.Unmarshal(errorJSON, &rev.ErrorSchema)
+	json.Unmarshal(deprecatedJSON, &rev.DeprecatedFields)
+	if createdBy.Valid {
+		rev.CreatedBy = createdBy.UUID
+	}
+	rev.CreatedAt = createdAt.Time
+	return &rev, nil
+}
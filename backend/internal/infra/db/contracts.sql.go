@@ -93,6 +93,30 @@ func (q *Queries) GetContractDefinition(ctx context.Context, id uuid.UUID) (Cont
 	return i, err
 }
 
+const getContractDefinitionForUpdate = `-- name: GetContractDefinitionForUpdate :one
+SELECT id, roadmap_item_id, contract_type, version, input_schema, output_schema, error_schema, backward_compatible, deprecated_fields, created_at FROM contract_definitions
+WHERE id = $1
+FOR UPDATE
+`
+
+func (q *Queries) GetContractDefinitionForUpdate(ctx context.Context, id uuid.UUID) (ContractDefinition, error) {
+	row := q.db.QueryRowContext(ctx, getContractDefinitionForUpdate, id)
+	var i ContractDefinition
+	err := row.Scan(
+		&i.ID,
+		&i.RoadmapItemID,
+		&i.ContractType,
+		&i.Version,
+		&i.InputSchema,
+		&i.OutputSchema,
+		&i.ErrorSchema,
+		&i.BackwardCompatible,
+		&i.DeprecatedFields,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
 const listContractDefinitions = `-- name: ListContractDefinitions :many
 SELECT id, roadmap_item_id, contract_type, version, input_schema, output_schema, error_schema, backward_compatible, deprecated_fields, created_at FROM contract_definitions
 WHERE roadmap_item_id = $1
@@ -105,9 +105,11 @@ func (ns NullImportStatus) Value() (driver.Value, error) {
 type ProposalStatus string
 
 const (
-	ProposalStatusPENDING  ProposalStatus = "PENDING"
-	ProposalStatusAPPROVED ProposalStatus = "APPROVED"
-	ProposalStatusREJECTED ProposalStatus = "REJECTED"
+	ProposalStatusPENDING          ProposalStatus = "PENDING"
+	ProposalStatusAPPROVED         ProposalStatus = "APPROVED"
+	ProposalStatusREJECTED         ProposalStatus = "REJECTED"
+	ProposalStatusCHANGESREQUESTED ProposalStatus = "CHANGES_REQUESTED"
+	ProposalStatusSTALE            ProposalStatus = "STALE"
 )
 
 func (e *ProposalStatus) Scan(src interface{}) error {
@@ -152,6 +154,7 @@ const (
 	ProposalTypeMODIFYSCHEMA    ProposalType = "MODIFY_SCHEMA"
 	ProposalTypeADDVARIABLE     ProposalType = "ADD_VARIABLE"
 	ProposalTypeREMOVEFIELD     ProposalType = "REMOVE_FIELD"
+	ProposalTypeJSONPATCH       ProposalType = "JSON_PATCH"
 )
 
 func (e *ProposalType) Scan(src interface{}) error {
@@ -367,15 +370,20 @@ func (ns NullRoadmapItemType) Value() (driver.Value, error) {
 }
 
 type AiProposal struct {
-	ID              uuid.UUID          `json:"id"`
-	RoadmapItemID   uuid.UUID          `json:"roadmap_item_id"`
-	ProposalType    ProposalType       `json:"proposal_type"`
-	Diff            json.RawMessage    `json:"diff"`
-	Reasoning       sql.NullString     `json:"reasoning"`
-	ConfidenceScore sql.NullFloat64    `json:"confidence_score"`
-	Status          NullProposalStatus `json:"status"`
-	ReviewedBy      uuid.NullUUID      `json:"reviewed_by"`
-	CreatedAt       sql.NullTime       `json:"created_at"`
+	ID              uuid.UUID             `json:"id"`
+	RoadmapItemID   uuid.UUID             `json:"roadmap_item_id"`
+	ProposalType    ProposalType          `json:"proposal_type"`
+	Diff            json.RawMessage       `json:"diff"`
+	Reasoning       sql.NullString        `json:"reasoning"`
+	ConfidenceScore sql.NullFloat64       `json:"confidence_score"`
+	Status          NullProposalStatus    `json:"status"`
+	ReviewedBy      uuid.NullUUID         `json:"reviewed_by"`
+	CreatedAt       sql.NullTime          `json:"created_at"`
+	CreatedBy       uuid.NullUUID         `json:"created_by"`
+	TargetType      sql.NullString        `json:"target_type"`
+	TargetID        uuid.NullUUID         `json:"target_id"`
+	BaseHash        sql.NullString        `json:"base_hash"`
+	BaseDocument    pqtype.NullRawMessage `json:"base_document"`
 }
 
 type AlignmentReport struct {
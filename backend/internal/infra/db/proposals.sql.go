@@ -11,23 +11,30 @@ import (
 	"encoding/json"
 
 	"github.com/google/uuid"
+	"github.com/sqlc-dev/pqtype"
 )
 
 const createAiProposal = `-- name: CreateAiProposal :one
 INSERT INTO ai_proposals (
-    roadmap_item_id, proposal_type, diff, reasoning, confidence_score, status
+    roadmap_item_id, proposal_type, diff, reasoning, confidence_score, status, created_by,
+    target_type, target_id, base_hash, base_document
 ) VALUES (
-    $1, $2, $3, $4, $5, 'PENDING'
+    $1, $2, $3, $4, $5, 'PENDING', $6, $7, $8, $9, $10
 )
-RETURNING id, roadmap_item_id, proposal_type, diff, reasoning, confidence_score, status, reviewed_by, created_at
+RETURNING id, roadmap_item_id, proposal_type, diff, reasoning, confidence_score, status, reviewed_by, created_at, created_by, target_type, target_id, base_hash, base_document
 `
 
 type CreateAiProposalParams struct {
-	RoadmapItemID   uuid.UUID       `json:"roadmap_item_id"`
-	ProposalType    ProposalType    `json:"proposal_type"`
-	Diff            json.RawMessage `json:"diff"`
-	Reasoning       sql.NullString  `json:"reasoning"`
-	ConfidenceScore sql.NullFloat64 `json:"confidence_score"`
+	RoadmapItemID   uuid.UUID             `json:"roadmap_item_id"`
+	ProposalType    ProposalType          `json:"proposal_type"`
+	Diff            json.RawMessage       `json:"diff"`
+	Reasoning       sql.NullString        `json:"reasoning"`
+	ConfidenceScore sql.NullFloat64       `json:"confidence_score"`
+	CreatedBy       uuid.NullUUID         `json:"created_by"`
+	TargetType      sql.NullString        `json:"target_type"`
+	TargetID        uuid.NullUUID         `json:"target_id"`
+	BaseHash        sql.NullString        `json:"base_hash"`
+	BaseDocument    pqtype.NullRawMessage `json:"base_document"`
 }
 
 func (q *Queries) CreateAiProposal(ctx context.Context, arg CreateAiProposalParams) (AiProposal, error) {
@@ -37,6 +44,11 @@ func (q *Queries) CreateAiProposal(ctx context.Context, arg CreateAiProposalPara
 		arg.Diff,
 		arg.Reasoning,
 		arg.ConfidenceScore,
+		arg.CreatedBy,
+		arg.TargetType,
+		arg.TargetID,
+		arg.BaseHash,
+		arg.BaseDocument,
 	)
 	var i AiProposal
 	err := row.Scan(
@@ -49,6 +61,11 @@ func (q *Queries) CreateAiProposal(ctx context.Context, arg CreateAiProposalPara
 		&i.Status,
 		&i.ReviewedBy,
 		&i.CreatedAt,
+		&i.CreatedBy,
+		&i.TargetType,
+		&i.TargetID,
+		&i.BaseHash,
+		&i.BaseDocument,
 	)
 	return i, err
 }
@@ -64,7 +81,7 @@ func (q *Queries) DeleteAiProposal(ctx context.Context, id uuid.UUID) error {
 }
 
 const getAiProposal = `-- name: GetAiProposal :one
-SELECT id, roadmap_item_id, proposal_type, diff, reasoning, confidence_score, status, reviewed_by, created_at FROM ai_proposals
+SELECT id, roadmap_item_id, proposal_type, diff, reasoning, confidence_score, status, reviewed_by, created_at, created_by, target_type, target_id, base_hash, base_document FROM ai_proposals
 WHERE id = $1 LIMIT 1
 `
 
@@ -81,12 +98,45 @@ func (q *Queries) GetAiProposal(ctx context.Context, id uuid.UUID) (AiProposal,
 		&i.Status,
 		&i.ReviewedBy,
 		&i.CreatedAt,
+		&i.CreatedBy,
+		&i.TargetType,
+		&i.TargetID,
+		&i.BaseHash,
+		&i.BaseDocument,
+	)
+	return i, err
+}
+
+const getAiProposalForUpdate = `-- name: GetAiProposalForUpdate :one
+SELECT id, roadmap_item_id, proposal_type, diff, reasoning, confidence_score, status, reviewed_by, created_at, created_by, target_type, target_id, base_hash, base_document FROM ai_proposals
+WHERE id = $1
+FOR UPDATE
+`
+
+func (q *Queries) GetAiProposalForUpdate(ctx context.Context, id uuid.UUID) (AiProposal, error) {
+	row := q.db.QueryRowContext(ctx, getAiProposalForUpdate, id)
+	var i AiProposal
+	err := row.Scan(
+		&i.ID,
+		&i.RoadmapItemID,
+		&i.ProposalType,
+		&i.Diff,
+		&i.Reasoning,
+		&i.ConfidenceScore,
+		&i.Status,
+		&i.ReviewedBy,
+		&i.CreatedAt,
+		&i.CreatedBy,
+		&i.TargetType,
+		&i.TargetID,
+		&i.BaseHash,
+		&i.BaseDocument,
 	)
 	return i, err
 }
 
 const listAiProposalsByProject = `-- name: ListAiProposalsByProject :many
-SELECT id, roadmap_item_id, proposal_type, diff, reasoning, confidence_score, status, reviewed_by, created_at FROM ai_proposals
+SELECT id, roadmap_item_id, proposal_type, diff, reasoning, confidence_score, status, reviewed_by, created_at, created_by, target_type, target_id, base_hash, base_document FROM ai_proposals
 WHERE roadmap_item_id IN (
     SELECT id FROM roadmap_items WHERE project_id = $1
 )
@@ -112,6 +162,11 @@ func (q *Queries) ListAiProposalsByProject(ctx context.Context, projectID uuid.U
 			&i.Status,
 			&i.ReviewedBy,
 			&i.CreatedAt,
+			&i.CreatedBy,
+			&i.TargetType,
+			&i.TargetID,
+			&i.BaseHash,
+			&i.BaseDocument,
 		); err != nil {
 			return nil, err
 		}
@@ -127,7 +182,7 @@ func (q *Queries) ListAiProposalsByProject(ctx context.Context, projectID uuid.U
 }
 
 const listAiProposalsByRoadmapItem = `-- name: ListAiProposalsByRoadmapItem :many
-SELECT id, roadmap_item_id, proposal_type, diff, reasoning, confidence_score, status, reviewed_by, created_at FROM ai_proposals
+SELECT id, roadmap_item_id, proposal_type, diff, reasoning, confidence_score, status, reviewed_by, created_at, created_by, target_type, target_id, base_hash, base_document FROM ai_proposals
 WHERE roadmap_item_id = $1
 ORDER BY created_at DESC
 `
@@ -151,6 +206,11 @@ func (q *Queries) ListAiProposalsByRoadmapItem(ctx context.Context, roadmapItemI
 			&i.Status,
 			&i.ReviewedBy,
 			&i.CreatedAt,
+			&i.CreatedBy,
+			&i.TargetType,
+			&i.TargetID,
+			&i.BaseHash,
+			&i.BaseDocument,
 		); err != nil {
 			return nil, err
 		}
@@ -165,11 +225,108 @@ func (q *Queries) ListAiProposalsByRoadmapItem(ctx context.Context, roadmapItemI
 	return items, nil
 }
 
+const markAiProposalsStale = `-- name: MarkAiProposalsStale :execrows
+UPDATE ai_proposals
+SET status = 'STALE'
+WHERE target_type = $1 AND target_id = $2 AND base_hash <> $3
+  AND status IN ('PENDING', 'CHANGES_REQUESTED')
+`
+
+type MarkAiProposalsStaleParams struct {
+	TargetType sql.NullString `json:"target_type"`
+	TargetID   uuid.NullUUID  `json:"target_id"`
+	BaseHash   sql.NullString `json:"base_hash"`
+}
+
+func (q *Queries) MarkAiProposalsStale(ctx context.Context, arg MarkAiProposalsStaleParams) (int64, error) {
+	result, err := q.db.ExecContext(ctx, markAiProposalsStale, arg.TargetType, arg.TargetID, arg.BaseHash)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
+const rebaseAiProposal = `-- name: RebaseAiProposal :one
+UPDATE ai_proposals
+SET diff = $2, base_hash = $3, base_document = $4, status = 'PENDING', reviewed_by = NULL
+WHERE id = $1
+RETURNING id, roadmap_item_id, proposal_type, diff, reasoning, confidence_score, status, reviewed_by, created_at, created_by, target_type, target_id, base_hash, base_document
+`
+
+type RebaseAiProposalParams struct {
+	ID           uuid.UUID             `json:"id"`
+	Diff         json.RawMessage       `json:"diff"`
+	BaseHash     sql.NullString        `json:"base_hash"`
+	BaseDocument pqtype.NullRawMessage `json:"base_document"`
+}
+
+func (q *Queries) RebaseAiProposal(ctx context.Context, arg RebaseAiProposalParams) (AiProposal, error) {
+	row := q.db.QueryRowContext(ctx, rebaseAiProposal,
+		arg.ID,
+		arg.Diff,
+		arg.BaseHash,
+		arg.BaseDocument,
+	)
+	var i AiProposal
+	err := row.Scan(
+		&i.ID,
+		&i.RoadmapItemID,
+		&i.ProposalType,
+		&i.Diff,
+		&i.Reasoning,
+		&i.ConfidenceScore,
+		&i.Status,
+		&i.ReviewedBy,
+		&i.CreatedAt,
+		&i.CreatedBy,
+		&i.TargetType,
+		&i.TargetID,
+		&i.BaseHash,
+		&i.BaseDocument,
+	)
+	return i, err
+}
+
+const resubmitAiProposal = `-- name: ResubmitAiProposal :one
+UPDATE ai_proposals
+SET diff = $2, reasoning = $3, status = 'PENDING', reviewed_by = NULL
+WHERE id = $1
+RETURNING id, roadmap_item_id, proposal_type, diff, reasoning, confidence_score, status, reviewed_by, created_at, created_by, target_type, target_id, base_hash, base_document
+`
+
+type ResubmitAiProposalParams struct {
+	ID        uuid.UUID       `json:"id"`
+	Diff      json.RawMessage `json:"diff"`
+	Reasoning sql.NullString  `json:"reasoning"`
+}
+
+func (q *Queries) ResubmitAiProposal(ctx context.Context, arg ResubmitAiProposalParams) (AiProposal, error) {
+	row := q.db.QueryRowContext(ctx, resubmitAiProposal, arg.ID, arg.Diff, arg.Reasoning)
+	var i AiProposal
+	err := row.Scan(
+		&i.ID,
+		&i.RoadmapItemID,
+		&i.ProposalType,
+		&i.Diff,
+		&i.Reasoning,
+		&i.ConfidenceScore,
+		&i.Status,
+		&i.ReviewedBy,
+		&i.CreatedAt,
+		&i.CreatedBy,
+		&i.TargetType,
+		&i.TargetID,
+		&i.BaseHash,
+		&i.BaseDocument,
+	)
+	return i, err
+}
+
 const updateAiProposalStatus = `-- name: UpdateAiProposalStatus :one
 UPDATE ai_proposals
 SET status = $2, reviewed_by = $3
 WHERE id = $1
-RETURNING id, roadmap_item_id, proposal_type, diff, reasoning, confidence_score, status, reviewed_by, created_at
+RETURNING id, roadmap_item_id, proposal_type, diff, reasoning, confidence_score, status, reviewed_by, created_at, created_by, target_type, target_id, base_hash, base_document
 `
 
 type UpdateAiProposalStatusParams struct {
@@ -191,6 +348,11 @@ func (q *Queries) UpdateAiProposalStatus(ctx context.Context, arg UpdateAiPropos
 		&i.Status,
 		&i.ReviewedBy,
 		&i.CreatedAt,
+		&i.CreatedBy,
+		&i.TargetType,
+		&i.TargetID,
+		&i.BaseHash,
+		&i.BaseDocument,
 	)
 	return i, err
 }
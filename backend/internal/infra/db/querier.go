@@ -40,7 +40,9 @@ type Querier interface {
 	DeleteWebhook(ctx context.Context, id uuid.UUID) error
 	DeleteWorkspace(ctx context.Context, id uuid.UUID) error
 	GetAiProposal(ctx context.Context, id uuid.UUID) (AiProposal, error)
+	GetAiProposalForUpdate(ctx context.Context, id uuid.UUID) (AiProposal, error)
 	GetContractDefinition(ctx context.Context, id uuid.UUID) (ContractDefinition, error)
+	GetContractDefinitionForUpdate(ctx context.Context, id uuid.UUID) (ContractDefinition, error)
 	GetFeatureIntelligence(ctx context.Context, featureID uuid.UUID) (FeatureIntelligence, error)
 	GetImportSession(ctx context.Context, id uuid.UUID) (ProjectImportSession, error)
 	GetIntelligenceSnapshot(ctx context.Context, id uuid.UUID) (ProjectIntelligenceSnapshot, error)
@@ -85,6 +87,9 @@ type Querier interface {
 	ListVersionSnapshotsByProject(ctx context.Context, projectID uuid.UUID) ([]VersionSnapshot, error)
 	ListWebhooksByProject(ctx context.Context, projectID uuid.UUID) ([]Webhook, error)
 	ListWorkspaces(ctx context.Context) ([]Workspace, error)
+	MarkAiProposalsStale(ctx context.Context, arg MarkAiProposalsStaleParams) (int64, error)
+	RebaseAiProposal(ctx context.Context, arg RebaseAiProposalParams) (AiProposal, error)
+	ResubmitAiProposal(ctx context.Context, arg ResubmitAiProposalParams) (AiProposal, error)
 	UpdateAiProposalStatus(ctx context.Context, arg UpdateAiProposalStatusParams) (AiProposal, error)
 	UpdateContractDefinition(ctx context.Context, arg UpdateContractDefinitionParams) (ContractDefinition, error)
 	UpdateFeatureIntelligence(ctx context.Context, arg UpdateFeatureIntelligenceParams) (FeatureIntelligence, error)
@@ -0,0 +1,204 @@
+package infra
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"time"
+
+	"github.com/SpecForgeVC/SpecForge/internal/domain"
+	"github.com/SpecForgeVC/SpecForge/internal/drift"
+	"github.com/SpecForgeVC/SpecForge/internal/infra/db"
+	"github.com/google/uuid"
+)
+
+type driftMonitorRepository struct {
+	db db.DBTX
+}
+
+func NewDriftMonitorRepository(db db.DBTX) drift.DriftMonitorRepo {
+	return &driftMonitorRepository{db: db}
+}
+
+const scheduleColumns = `project_id, cron_expression, enabled, next_run_at, last_run_at, updated_by, updated_at`
+
+func (r *driftMonitorRepository) GetSchedule(ctx context.Context, projectID uuid.UUID) (*domain.DriftMonitorSchedule, error) {
+	query := `SELECT ` + scheduleColumns + ` FROM drift_monitor_schedules WHERE project_id = $1`
+	s, err := r.scanSchedule(r.db.QueryRowContext(ctx, query, projectID))
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	return s, err
+}
+
+func (r *driftMonitorRepository) UpsertSchedule(ctx context.Context, s *domain.DriftMonitorSchedule) error {
+	query := `
+		INSERT INTO drift_monitor_schedules (project_id, cron_expression, enabled, next_run_at, updated_by, updated_at)
+		VALUES ($1, $2, $3, $4, $5, $6)
+		ON CONFLICT (project_id) DO UPDATE
+		SET cron_expression = EXCLUDED.cron_expression, enabled = EXCLUDED.enabled, next_run_at = EXCLUDED.next_run_at,
+		    updated_by = EXCLUDED.updated_by, updated_at = EXCLUDED.updated_at
+	`
+	_, err := r.db.ExecContext(ctx, query,
+		s.ProjectID,
+		s.CronExpression,
+		s.Enabled,
+		s.NextRunAt,
+		uuid.NullUUID{UUID: s.UpdatedBy, Valid: s.UpdatedBy != uuid.Nil},
+		s.UpdatedAt,
+	)
+	return err
+}
+
+// ClaimDue leases the most overdue schedule. FOR UPDATE SKIP LOCKED keeps
+// concurrent instances from claiming the same row; the lease keeps it claimed
+// for the duration of the run.
+func (r *driftMonitorRepository) ClaimDue(ctx context.Context, owner string, now time.Time, lease time.Duration) (*domain.DriftMonitorSchedule, error) {
+	query := `
+		UPDATE drift_monitor_schedules
+		SET locked_by = $1, locked_until = $2
+		WHERE project_id = (
+			SELECT project_id FROM drift_monitor_schedules
+			WHERE enabled AND next_run_at <= $3 AND (locked_until IS NULL OR locked_until < $3)
+			ORDER BY next_run_at
+			LIMIT 1
+			FOR UPDATE SKIP LOCKED
+		)
+		RETURNING ` + scheduleColumns
+	s, err := r.scanSchedule(r.db.QueryRowContext(ctx, query, owner, now.Add(lease), now))
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	return s, err
+}
+
+func (r *driftMonitorRepository) Renew(ctx context.Context, projectID uuid.UUID, owner string, until time.Time) error {
+	query := `
+		UPDATE drift_monitor_schedules
+		SET locked_until = $3
+		WHERE project_id = $1 AND locked_by = $2
+	`
+	res, err := r.db.ExecContext(ctx, query, projectID, owner, until)
+	if err != nil {
+		return err
+	}
+	if n, err := res.RowsAffected(); err != nil {
+		return err
+	} else if n == 0 {
+		return drift.ErrLeaseLost
+	}
+	return nil
+}
+
+func (r *driftMonitorRepository) Release(ctx context.Context, projectID uuid.UUID, owner string, lastRun, nextRun time.Time) error {
+	query := `
+		UPDATE drift_monitor_schedules
+		SET last_run_at = $3, next_run_at = $4, locked_by = NULL, locked_until = NULL
+		WHERE project_id = $1 AND locked_by = $2
+	`
+	_, err := r.db.ExecContext(ctx, query, projectID, owner, lastRun, nextRun)
+	return err
+}
+
+func (r *driftMonitorRepository) scanSchedule(row rowScanner) (*domain.DriftMonitorSchedule, error) {
+	var s domain.DriftMonitorSchedule
+	var nextRun, lastRun, updatedAt sql.NullTime
+	var updatedBy uuid.NullUUID
+
+	if err := row.Scan(&s.ProjectID, &s.CronExpression, &s.Enabled, &nextRun, &lastRun, &updatedBy, &updatedAt); err != nil {
+		return nil, err
+	}
+	if nextRun.Valid {
+		s.NextRunAt = &nextRun.Time
+	}
+	if lastRun.Valid {
+		s.LastRunAt = &lastRun.Time
+	}
+	if updatedBy.Valid {
+		s.UpdatedBy = updatedBy.UUID
+	}
+	s.UpdatedAt = updatedAt.Time
+	return &s, nil
+}
+
+const timelineColumns = `id, project_id, roadmap_item_id, contract_id, snapshot_id, breaking_changes, non_breaking_changes, new_breaking_changes, changes, checked_at`
+
+func (r *driftMonitorRepository) AppendTimelineEntry(ctx context.Context, e *domain.DriftTimelineEntry) error {
+	query := `INSERT INTO drift_timeline_entries (` + timelineColumns + `) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10)`
+	changesJSON, err := json.Marshal(e.Changes)
+	if err != nil {
+		return err
+	}
+	_, err = r.db.ExecContext(ctx, query,
+		e.ID,
+		e.ProjectID,
+		e.RoadmapItemID,
+		e.ContractID,
+		e.SnapshotID,
+		e.BreakingChanges,
+		e.NonBreakingChanges,
+		e.NewBreakingChanges,
+		changesJSON,
+		e.CheckedAt,
+	)
+	return err
+}
+
+func (r *driftMonitorRepository) LatestTimelineEntry(ctx context.Context, contractID uuid.UUID) (*domain.DriftTimelineEntry, error) {
+	query := `SELECT ` + timelineColumns + ` FROM drift_timeline_entries WHERE contract_id = $1 ORDER BY checked_at DESC LIMIT 1`
+	e, err := r.scanTimelineEntry(r.db.QueryRowContext(ctx, query, contractID))
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	return e, err
+}
+
+// ListTimeline returns entries newest first, optionally for one contract (uuid.Nil for all).
+func (r *driftMonitorRepository) ListTimeline(ctx context.Context, projectID uuid.UUID, contractID uuid.UUID, limit int) ([]domain.DriftTimelineEntry, error) {
+	query := `
+		SELECT ` + timelineColumns + `
+		FROM drift_timeline_entries
+		WHERE project_id = $1 AND ($2::uuid IS NULL OR contract_id = $2)
+		ORDER BY checked_at DESC
+		LIMIT $3
+	`
+	rows, err := r.db.QueryContext(ctx, query, projectID, uuid.NullUUID{UUID: contractID, Valid: contractID != uuid.Nil}, limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var entries []domain.DriftTimelineEntry
+	for rows.Next() {
+		e, err := r.scanTimelineEntry(rows)
+		if err != nil {
+			return nil, err
+		}
+		entries = append(entries, *e)
+	}
+	return entries, rows.Err()
+}
+
+func (r *driftMonitorRepository) scanTimelineEntry(row rowScanner) (*domain.DriftTimelineEntry, error) {
+	var e domain.DriftTimelineEntry
+	var changesJSON []byte
+	var checkedAt sql.NullTime
+
+	if err := row.Scan(
+		&e.ID,
+		&e.ProjectID,
+		&e.RoadmapItemID,
+		&e.ContractID,
+		&e.SnapshotID,
+		&e.BreakingChanges,
+		&e.NonBreakingChanges,
+		&e.NewBreakingChanges,
+		&changesJSON,
+		&checkedAt,
+	); err != nil {
+		return nil, err
+	}
+	json.Unmarshal(changesJSON, &e.Changes)
+	e.CheckedAt = checkedAt.Time
+	return &e, nil
+}
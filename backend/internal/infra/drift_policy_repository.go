@@ -0,0 +1,67 @@
+package infra
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+
+	"github.com/SpecForgeVC/SpecForge/internal/domain"
+	"github.com/SpecForgeVC/SpecForge/internal/drift"
+	"github.com/SpecForgeVC/SpecForge/internal/infra/db"
+	"github.com/google/uuid"
+)
+
+type driftPolicyRepository struct {
+	db db.DBTX
+}
+
+func NewDriftPolicyRepository(db db.DBTX) drift.DriftPolicyRepo {
+	return &driftPolicyRepository{db: db}
+}
+
+func (r *driftPolicyRepository) Get(ctx context.Context, projectID uuid.UUID) (*domain.ProjectDriftPolicy, error) {
+	query := `
+		SELECT project_id, policy, updated_by, updated_at
+		FROM project_drift_policies
+		WHERE project_id = $1
+	`
+	var p domain.ProjectDriftPolicy
+	var policyJSON []byte
+	var updatedBy uuid.NullUUID
+	var updatedAt sql.NullTime
+
+	err := r.db.QueryRowContext(ctx, query, projectID).Scan(&p.ProjectID, &policyJSON, &updatedBy, &updatedAt)
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	json.Unmarshal(policyJSON, &p.Policy)
+	if updatedBy.Valid {
+		p.UpdatedBy = updatedBy.UUID
+	}
+	p.UpdatedAt = updatedAt.Time
+	return &p, nil
+}
+
+func (r *driftPolicyRepository) Upsert(ctx context.Context, p *domain.ProjectDriftPolicy) error {
+	query := `
+		INSERT INTO project_drift_policies (project_id, policy, updated_by, updated_at)
+		VALUES ($1, $2, $3, $4)
+		ON CONFLICT (project_id) DO UPDATE
+		SET policy = EXCLUDED.policy, updated_by = EXCLUDED.updated_by, updated_at = EXCLUDED.updated_at
+	`
+	policyJSON, err := json.Marshal(p.Policy)
+	if err != nil {
+		return err
+	}
+	_, err = r.db.ExecContext(ctx, query,
+		p.ProjectID,
+		policyJSON,
+		uuid.NullUUID{UUID: p.UpdatedBy, Valid: p.UpdatedBy != uuid.Nil},
+		p.UpdatedAt,
+	)
+	return err
+}
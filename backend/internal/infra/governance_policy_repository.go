@@ -0,0 +1,89 @@
+package infra
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+
+	"github.com/SpecForgeVC/SpecForge/internal/app"
+	"github.com/SpecForgeVC/SpecForge/internal/domain"
+	"github.com/SpecForgeVC/SpecForge/internal/infra/db"
+	"github.com/google/uuid"
+)
+
+type governancePolicyRepository struct {
+	db db.DBTX
+}
+
+func NewGovernancePolicyRepository(db db.DBTX) app.GovernancePolicyRepository {
+	return &governancePolicyRepository{db: db}
+}
+
+const governancePolicyColumns = `project_id, version, policy, created_by, created_at`
+
+// Create appends p as the project's next version and sets p.Version. The
+// primary key on (project_id, version) rejects concurrent writers racing for
+// the same version.
+func (r *governancePolicyRepository) Create(ctx context.Context, p *domain.ProjectGovernancePolicy) error {
+	query := `
+		INSERT INTO governance_policies (` + governancePolicyColumns + `)
+		SELECT $1, COALESCE(MAX(version), 0) + 1, $2, $3, $4
+		FROM governance_policies WHERE project_id = $1
+		RETURNING version
+	`
+	policyJSON, err := json.Marshal(p.Policy)
+	if err != nil {
+		return err
+	}
+	return r.db.QueryRowContext(ctx, query,
+		p.ProjectID,
+		policyJSON,
+		uuid.NullUUID{UUID: p.CreatedBy, Valid: p.CreatedBy != uuid.Nil},
+		p.CreatedAt,
+	).Scan(&p.Version)
+}
+
+func (r *governancePolicyRepository) GetLatest(ctx context.Context, projectID uuid.UUID) (*domain.ProjectGovernancePolicy, error) {
+	query := `SELECT ` + governancePolicyColumns + ` FROM governance_policies WHERE project_id = $1 ORDER BY version DESC LIMIT 1`
+	p, err := r.scan(r.db.QueryRowContext(ctx, query, projectID))
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	return p, err
+}
+
+func (r *governancePolicyRepository) List(ctx context.Context, projectID uuid.UUID) ([]domain.ProjectGovernancePolicy, error) {
+	query := `SELECT ` + governancePolicyColumns + ` FROM governance_policies WHERE project_id = $1 ORDER BY version DESC`
+	rows, err := r.db.QueryContext(ctx, query, projectID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var policies []domain.ProjectGovernancePolicy
+	for rows.Next() {
+		p, err := r.scan(rows)
+		if err != nil {
+			return nil, err
+		}
+		policies = append(policies, *p)
+	}
+	return policies, rows.Err()
+}
+
+func (r *governancePolicyRepository) scan(row rowScanner) (*domain.ProjectGovernancePolicy, error) {
+	var p domain.ProjectGovernancePolicy
+	var policyJSON []byte
+	var createdBy uuid.NullUUID
+	var createdAt sql.NullTime
+
+	if err := row.Scan(&p.ProjectID, &p.Version, &policyJSON, &createdBy, &createdAt); err != nil {
+		return nil, err
+	}
+	json.Unmarshal(policyJSON, &p.Policy)
+	if createdBy.Valid {
+		p.CreatedBy = createdBy.UUID
+	}
+	p.CreatedAt = createdAt.Time
+	return &p, nil
+}
@@ -0,0 +1,116 @@
+package infra
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/SpecForgeVC/SpecForge/internal/app"
+	"github.com/SpecForgeVC/SpecForge/internal/domain"
+	"github.com/SpecForgeVC/SpecForge/internal/infra/db"
+	"github.com/google/uuid"
+)
+
+type governanceWaiverRepository struct {
+	db db.DBTX
+}
+
+func NewGovernanceWaiverRepository(db db.DBTX) app.GovernanceWaiverRepository {
+	return &governanceWaiverRepository{db: db}
+}
+
+const governanceWaiverColumns = `id, project_id, roadmap_item_id, gate, rule, justification, status, requested_by, decided_by, decision_comment, decided_at, expires_at, created_at`
+
+func (r *governanceWaiverRepository) Create(ctx context.Context, w *domain.GovernanceWaiver) error {
+	query := `
+		INSERT INTO governance_waivers (id, project_id, roadmap_item_id, gate, rule, justification, status, requested_by, expires_at, created_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10)
+	`
+	_, err := r.db.ExecContext(ctx, query,
+		w.ID,
+		w.ProjectID,
+		w.RoadmapItemID,
+		w.Gate,
+		w.Rule,
+		w.Justification,
+		w.Status,
+		w.RequestedBy,
+		w.ExpiresAt,
+		w.CreatedAt,
+	)
+	return err
+}
+
+func (r *governanceWaiverRepository) Get(ctx context.Context, id uuid.UUID) (*domain.GovernanceWaiver, error) {
+	query := `SELECT ` + governanceWaiverColumns + ` FROM governance_waivers WHERE id = $1`
+	return r.scan(r.db.QueryRowContext(ctx, query, id))
+}
+
+// Decide only updates the waiver while it is still in status from, so two
+// concurrent decisions cannot both succeed.
+func (r *governanceWaiverRepository) Decide(ctx context.Context, w *domain.GovernanceWaiver, from domain.WaiverStatus) (bool, error) {
+	query := `
+		UPDATE governance_waivers
+		SET status = $2, decided_by = $3, decision_comment = $4, decided_at = $5
+		WHERE id = $1 AND status = $6
+	`
+	res, err := r.db.ExecContext(ctx, query,
+		w.ID,
+		w.Status,
+		w.DecidedBy,
+		sql.NullString{String: w.DecisionComment, Valid: w.DecisionComment != ""},
+		w.DecidedAt,
+		from,
+	)
+	if err != nil {
+		return false, err
+	}
+	n, err := res.RowsAffected()
+	return n == 1, err
+}
+
+func (r *governanceWaiverRepository) ListByProject(ctx context.Context, projectID uuid.UUID) ([]domain.GovernanceWaiver, error) {
+	query := `SELECT ` + governanceWaiverColumns + ` FROM governance_waivers WHERE project_id = $1 ORDER BY created_at DESC`
+	return r.list(ctx, query, projectID)
+}
+
+func (r *governanceWaiverRepository) ListByRoadmapItem(ctx context.Context, roadmapItemID uuid.UUID) ([]domain.GovernanceWaiver, error) {
+	query := `SELECT ` + governanceWaiverColumns + ` FROM governance_waivers WHERE roadmap_item_id = $1 ORDER BY created_at DESC`
+	return r.list(ctx, query, roadmapItemID)
+}
+
+func (r *governanceWaiverRepository) list(ctx context.Context, query string, arg interface{}) ([]domain.GovernanceWaiver, error) {
+	rows, err := r.db.QueryContext(ctx, query, arg)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var waivers []domain.GovernanceWaiver
+	for rows.Next() {
+		w, err := r.scan(rows)
+		if err != nil {
+			return nil, err
+		}
+		waivers = append(waivers, *w)
+	}
+	return waivers, rows.Err()
+}
+
+func (r *governanceWaiverRepository) scan(row rowScanner) (*domain.GovernanceWaiver, error) {
+	var w domain.GovernanceWaiver
+	var decidedBy uuid.NullUUID
+	var comment sql.NullString
+	var decidedAt, createdAt sql.NullTime
+
+	if err := row.Scan(&w.ID, &w.ProjectID, &w.RoadmapItemID, &w.Gate, &w.Rule, &w.Justification, &w.Status,
+		&w.RequestedBy, &decidedBy, &comment, &decidedAt, &w.ExpiresAt, &createdAt); err != nil {
+		return nil, err
+	}
+	w.DecidedBy = decidedBy.UUID
+	w.DecisionComment = comment.String
+	if decidedAt.Valid {
+		w.DecidedAt = &decidedAt.Time
+	}
+	w.CreatedAt = createdAt.Time
+	return &w, nil
+}
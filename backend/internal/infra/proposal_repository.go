@@ -2,6 +2,7 @@ package infra
 
 import (
 	"context"
+	"database/sql"
 
 	"github.com/SpecForgeVC/SpecForge/internal/app"
 	"github.com/SpecForgeVC/SpecForge/internal/domain"
@@ -22,22 +23,17 @@ func (r *aiProposalRepository) Get(ctx context.Context, id uuid.UUID) (*domain.A
 	if err != nil {
 		return nil, err
 	}
-	var reviewedBy uuid.UUID
-	if row.ReviewedBy.Valid {
-		reviewedBy = row.ReviewedBy.UUID
-	}
+	p := toDomainProposal(row)
+	return &p, nil
+}
 
-	return &domain.AiProposal{
-		ID:              row.ID,
-		RoadmapItemID:   row.RoadmapItemID,
-		ProposalType:    domain.ProposalType(row.ProposalType),
-		Diff:            db.RawMessageToJSON(row.Diff),
-		Reasoning:       row.Reasoning.String,
-		ConfidenceScore: row.ConfidenceScore.Float64,
-		Status:          domain.ProposalStatus(row.Status.ProposalStatus),
-		ReviewedBy:      reviewedBy,
-		CreatedAt:       row.CreatedAt.Time,
-	}, nil
+func (r *aiProposalRepository) GetForUpdate(ctx context.Context, id uuid.UUID) (*domain.AiProposal, error) {
+	row, err := r.queries.GetAiProposalForUpdate(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	p := toDomainProposal(row)
+	return &p, nil
 }
 
 func (r *aiProposalRepository) ListByProject(ctx context.Context, projectID uuid.UUID) ([]domain.AiProposal, error) {
@@ -59,34 +55,66 @@ func (r *aiProposalRepository) ListByRoadmapItem(ctx context.Context, roadmapIte
 func (r *aiProposalRepository) mapRows(rows []db.AiProposal) []domain.AiProposal {
 	proposals := make([]domain.AiProposal, len(rows))
 	for i, row := range rows {
-		var reviewedBy uuid.UUID
-		if row.ReviewedBy.Valid {
-			reviewedBy = row.ReviewedBy.UUID
-		}
-		proposals[i] = domain.AiProposal{
-			ID:              row.ID,
-			RoadmapItemID:   row.RoadmapItemID,
-			ProposalType:    domain.ProposalType(row.ProposalType),
-			Diff:            db.RawMessageToJSON(row.Diff),
-			Reasoning:       row.Reasoning.String,
-			ConfidenceScore: row.ConfidenceScore.Float64,
-			Status:          domain.ProposalStatus(row.Status.ProposalStatus),
-			ReviewedBy:      reviewedBy,
-			CreatedAt:       row.CreatedAt.Time,
-		}
+		proposals[i] = toDomainProposal(row)
 	}
 	return proposals
 }
 
+func toDomainProposal(row db.AiProposal) domain.AiProposal {
+	var reviewedBy uuid.UUID
+	if row.ReviewedBy.Valid {
+		reviewedBy = row.ReviewedBy.UUID
+	}
+	var createdBy uuid.UUID
+	if row.CreatedBy.Valid {
+		createdBy = row.CreatedBy.UUID
+	}
+	var targetID uuid.UUID
+	if row.TargetID.Valid {
+		targetID = row.TargetID.UUID
+	}
+	var baseDocument map[string]interface{}
+	if row.BaseDocument.Valid {
+		baseDocument = db.SqlToJSON(row.BaseDocument)
+	}
+	return domain.AiProposal{
+		ID:              row.ID,
+		RoadmapItemID:   row.RoadmapItemID,
+		ProposalType:    domain.ProposalType(row.ProposalType),
+		Diff:            db.RawMessageToJSON(row.Diff),
+		Reasoning:       row.Reasoning.String,
+		ConfidenceScore: row.ConfidenceScore.Float64,
+		Status:          domain.ProposalStatus(row.Status.ProposalStatus),
+		ReviewedBy:      reviewedBy,
+		CreatedBy:       createdBy,
+		CreatedAt:       row.CreatedAt.Time,
+		TargetType:      domain.PatchTargetType(row.TargetType.String),
+		TargetID:        targetID,
+		BaseHash:        row.BaseHash.String,
+		BaseDocument:    baseDocument,
+	}
+}
+
+// Create stores p and sets its database-generated ID and creation time.
 func (r *aiProposalRepository) Create(ctx context.Context, p *domain.AiProposal) error {
-	_, err := r.queries.CreateAiProposal(ctx, db.CreateAiProposalParams{
+	row, err := r.queries.CreateAiProposal(ctx, db.CreateAiProposalParams{
 		RoadmapItemID:   p.RoadmapItemID,
 		ProposalType:    db.ProposalType(p.ProposalType),
 		Diff:            db.JSONToRawMessage(p.Diff),
 		Reasoning:       db.TextToSql(p.Reasoning),
 		ConfidenceScore: db.FloatToSql(p.ConfidenceScore),
+		CreatedBy:       uuid.NullUUID{UUID: p.CreatedBy, Valid: p.CreatedBy != uuid.Nil},
+		TargetType:      db.TextToSql(string(p.TargetType)),
+		TargetID:        uuid.NullUUID{UUID: p.TargetID, Valid: p.TargetID != uuid.Nil},
+		BaseHash:        db.TextToSql(p.BaseHash),
+		BaseDocument:    db.JSONToSql(p.BaseDocument),
 	})
-	return err
+	if err != nil {
+		return err
+	}
+	p.ID = row.ID
+	p.CreatedAt = row.CreatedAt.Time
+	return nil
 }
 
 func (r *aiProposalRepository) UpdateStatus(ctx context.Context, id uuid.UUID, status domain.ProposalStatus, reviewedBy uuid.UUID) error {
@@ -97,3 +125,35 @@ func (r *aiProposalRepository) UpdateStatus(ctx context.Context, id uuid.UUID, s
 	})
 	return err
 }
+
+// Resubmit replaces the proposal's diff and reasoning and returns it to PENDING.
+func (r *aiProposalRepository) Resubmit(ctx context.Context, id uuid.UUID, diff map[string]interface{}, reasoning string) error {
+	_, err := r.queries.ResubmitAiProposal(ctx, db.ResubmitAiProposalParams{
+		ID:        id,
+		Diff:      db.JSONToRawMessage(diff),
+		Reasoning: db.TextToSql(reasoning),
+	})
+	return err
+}
+
+// Rebase moves the proposal onto a new base version of its target and
+// returns it to PENDING.
+func (r *aiProposalRepository) Rebase(ctx context.Context, id uuid.UUID, diff map[string]interface{}, baseHash string, baseDocument map[string]interface{}) error {
+	_, err := r.queries.RebaseAiProposal(ctx, db.RebaseAiProposalParams{
+		ID:           id,
+		Diff:         db.JSONToRawMessage(diff),
+		BaseHash:     db.TextToSql(baseHash),
+		BaseDocument: db.JSONToSql(baseDocument),
+	})
+	return err
+}
+
+// MarkStale moves the open proposals on the target whose base is not
+// currentHash to STALE and returns how many it moved.
+func (r *aiProposalRepository) MarkStale(ctx context.Context, targetType domain.PatchTargetType, targetID uuid.UUID, currentHash string) (int64, error) {
+	return r.queries.MarkAiProposalsStale(ctx, db.MarkAiProposalsStaleParams{
+		TargetType: db.TextToSql(string(targetType)),
+		TargetID:   uuid.NullUUID{UUID: targetID, Valid: true},
+		BaseHash:   sql.NullString{String: currentHash, Valid: true},
+	})
+}
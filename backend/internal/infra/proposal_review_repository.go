@@ -0,0 +1,72 @@
+package infra
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/SpecForgeVC/SpecForge/internal/app"
+	"github.com/SpecForgeVC/SpecForge/internal/domain"
+	"github.com/SpecForgeVC/SpecForge/internal/infra/db"
+	"github.com/google/uuid"
+)
+
+type proposalReviewRepository struct {
+	db db.DBTX
+}
+
+func NewProposalReviewRepository(db db.DBTX) app.ProposalReviewRepository {
+	return &proposalReviewRepository{db: db}
+}
+
+func (r *proposalReviewRepository) Create(ctx context.Context, review *domain.ProposalReview) error {
+	query := `
+		INSERT INTO proposal_reviews (id, proposal_id, reviewer_id, role, decision, comment, superseded, created_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+	`
+	_, err := r.db.ExecContext(ctx, query,
+		review.ID,
+		review.ProposalID,
+		review.ReviewerID,
+		review.Role,
+		review.Decision,
+		sql.NullString{String: review.Comment, Valid: review.Comment != ""},
+		review.Superseded,
+		review.CreatedAt,
+	)
+	return err
+}
+
+// List returns the proposal's reviews, oldest first.
+func (r *proposalReviewRepository) List(ctx context.Context, proposalID uuid.UUID) ([]domain.ProposalReview, error) {
+	query := `
+		SELECT id, proposal_id, reviewer_id, role, decision, comment, superseded, created_at
+		FROM proposal_reviews
+		WHERE proposal_id = $1
+		ORDER BY created_at
+	`
+	rows, err := r.db.QueryContext(ctx, query, proposalID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var reviews []domain.ProposalReview
+	for rows.Next() {
+		var review domain.ProposalReview
+		var comment sql.NullString
+		var createdAt sql.NullTime
+		if err := rows.Scan(&review.ID, &review.ProposalID, &review.ReviewerID, &review.Role, &review.Decision, &comment, &review.Superseded, &createdAt); err != nil {
+			return nil, err
+		}
+		review.Comment = comment.String
+		review.CreatedAt = createdAt.Time
+		reviews = append(reviews, review)
+	}
+	return reviews, rows.Err()
+}
+
+// Supersede marks every current review of the proposal as superseded.
+func (r *proposalReviewRepository) Supersede(ctx context.Context, proposalID uuid.UUID) error {
+	_, err := r.db.ExecContext(ctx, `UPDATE proposal_reviews SET superseded = TRUE WHERE proposal_id = $1 AND NOT superseded`, proposalID)
+	return err
+}
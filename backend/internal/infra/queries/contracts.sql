@@ -2,6 +2,11 @@
 SELECT * FROM contract_definitions
 WHERE id = $1 LIMIT 1;
 
+-- name: GetContractDefinitionForUpdate :one
+SELECT * FROM contract_definitions
+WHERE id = $1
+FOR UPDATE;
+
 -- name: ListContractDefinitions :many
 SELECT * FROM contract_definitions
 WHERE roadmap_item_id = $1
@@ -2,6 +2,11 @@
 SELECT * FROM ai_proposals
 WHERE id = $1 LIMIT 1;
 
+-- name: GetAiProposalForUpdate :one
+SELECT * FROM ai_proposals
+WHERE id = $1
+FOR UPDATE;
+
 -- name: ListAiProposalsByProject :many
 SELECT * FROM ai_proposals
 WHERE roadmap_item_id IN (
@@ -16,9 +21,10 @@ ORDER BY created_at DESC;
 
 -- name: CreateAiProposal :one
 INSERT INTO ai_proposals (
-    roadmap_item_id, proposal_type, diff, reasoning, confidence_score, status
+    roadmap_item_id, proposal_type, diff, reasoning, confidence_score, status, created_by,
+    target_type, target_id, base_hash, base_document
 ) VALUES (
-    $1, $2, $3, $4, $5, 'PENDING'
+    $1, $2, $3, $4, $5, 'PENDING', $6, $7, $8, $9, $10
 )
 RETURNING *;
 
@@ -28,6 +34,24 @@ SET status = $2, reviewed_by = $3
 WHERE id = $1
 RETURNING *;
 
+-- name: ResubmitAiProposal :one
+UPDATE ai_proposals
+SET diff = $2, reasoning = $3, status = 'PENDING', reviewed_by = NULL
+WHERE id = $1
+RETURNING *;
+
+-- name: RebaseAiProposal :one
+UPDATE ai_proposals
+SET diff = $2, base_hash = $3, base_document = $4, status = 'PENDING', reviewed_by = NULL
+WHERE id = $1
+RETURNING *;
+
+-- name: MarkAiProposalsStale :execrows
+UPDATE ai_proposals
+SET status = 'STALE'
+WHERE target_type = $1 AND target_id = $2 AND base_hash <> $3
+  AND status IN ('PENDING', 'CHANGES_REQUESTED');
+
 -- name: DeleteAiProposal :exec
 DELETE FROM ai_proposals
 WHERE id = $1;
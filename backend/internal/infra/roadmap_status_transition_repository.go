@@ -0,0 +1,71 @@
+package infra
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/SpecForgeVC/SpecForge/internal/app"
+	"github.com/SpecForgeVC/SpecForge/internal/domain"
+	"github.com/SpecForgeVC/SpecForge/internal/infra/db"
+	"github.com/google/uuid"
+)
+
+type roadmapStatusTransitionRepository struct {
+	db db.DBTX
+}
+
+func NewRoadmapStatusTransitionRepository(db db.DBTX) app.RoadmapStatusTransitionRepository {
+	return &roadmapStatusTransitionRepository{db: db}
+}
+
+func (r *roadmapStatusTransitionRepository) Create(ctx context.Context, t *domain.RoadmapStatusTransition) error {
+	query := `
+		INSERT INTO roadmap_status_transitions (id, roadmap_item_id, from_status, to_status, reason, transitioned_by, role, created_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+	`
+	_, err := r.db.ExecContext(ctx, query,
+		t.ID,
+		t.RoadmapItemID,
+		t.FromStatus,
+		t.ToStatus,
+		sql.NullString{String: t.Reason, Valid: t.Reason != ""},
+		uuid.NullUUID{UUID: t.TransitionedBy, Valid: t.TransitionedBy != uuid.Nil},
+		t.Role,
+		t.CreatedAt,
+	)
+	return err
+}
+
+// List returns the item's transitions, oldest first.
+func (r *roadmapStatusTransitionRepository) List(ctx context.Context, roadmapItemID uuid.UUID) ([]domain.RoadmapStatusTransition, error) {
+	query := `
+		SELECT id, roadmap_item_id, from_status, to_status, reason, transitioned_by, role, created_at
+		FROM roadmap_status_transitions
+		WHERE roadmap_item_id = $1
+		ORDER BY created_at
+	`
+	rows, err := r.db.QueryContext(ctx, query, roadmapItemID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var transitions []domain.RoadmapStatusTransition
+	for rows.Next() {
+		var t domain.RoadmapStatusTransition
+		var reason, role sql.NullString
+		var transitionedBy uuid.NullUUID
+		var createdAt sql.NullTime
+		if err := rows.Scan(&t.ID, &t.RoadmapItemID, &t.FromStatus, &t.ToStatus, &reason, &transitionedBy, &role, &createdAt); err != nil {
+			return nil, err
+		}
+		t.Reason = reason.String
+		t.Role = domain.Role(role.String)
+		if transitionedBy.Valid {
+			t.TransitionedBy = transitionedBy.UUID
+		}
+		t.CreatedAt = createdAt.Time
+		transitions = append(transitions, t)
+	}
+	return transitions, rows.Err()
+}
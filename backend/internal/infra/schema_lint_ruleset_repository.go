@@ -0,0 +1,69 @@
+package infra
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+
+	"github.com/SpecForgeVC/SpecForge/internal/app"
+	"github.com/SpecForgeVC/SpecForge/internal/domain"
+	"github.com/SpecForgeVC/SpecForge/internal/infra/db"
+	"github.com/google/uuid"
+)
+
+type schemaLintRulesetRepository struct {
+	db db.DBTX
+}
+
+func NewSchemaLintRulesetRepository(db db.DBTX) app.SchemaLintRulesetRepository {
+	return &schemaLintRulesetRepository{db: db}
+}
+
+func (r *schemaLintRulesetRepository) Get(ctx context.Context, projectID uuid.UUID) (*domain.ProjectSchemaLintRuleset, error) {
+	query := `
+		SELECT project_id, ruleset, updated_by, updated_at
+		FROM project_schema_lint_rulesets
+		WHERE project_id = $1
+	`
+	var rs domain.ProjectSchemaLintRuleset
+	var rulesetJSON []byte
+	var updatedBy uuid.NullUUID
+	var updatedAt sql.NullTime
+
+	err := r.db.QueryRowContext(ctx, query, projectID).Scan(&rs.ProjectID, &rulesetJSON, &updatedBy, &updatedAt)
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if err := json.Unmarshal(rulesetJSON, &rs.Ruleset); err != nil {
+		return nil, err
+	}
+	if updatedBy.Valid {
+		rs.UpdatedBy = updatedBy.UUID
+	}
+	rs.UpdatedAt = updatedAt.Time
+	return &rs, nil
+}
+
+func (r *schemaLintRulesetRepository) Upsert(ctx context.Context, rs *domain.ProjectSchemaLintRuleset) error {
+	query := `
+		INSERT INTO project_schema_lint_rulesets (project_id, ruleset, updated_by, updated_at)
+		VALUES ($1, $2, $3, $4)
+		ON CONFLICT (project_id) DO UPDATE
+		SET ruleset = EXCLUDED.ruleset, updated_by = EXCLUDED.updated_by, updated_at = EXCLUDED.updated_at
+	`
+	rulesetJSON, err := json.Marshal(rs.Ruleset)
+	if err != nil {
+		return err
+	}
+	_, err = r.db.ExecContext(ctx, query,
+		rs.ProjectID,
+		rulesetJSON,
+		uuid.NullUUID{UUID: rs.UpdatedBy, Valid: rs.UpdatedBy != uuid.Nil},
+		rs.UpdatedAt,
+	)
+	return err
+}
@@ -0,0 +1,107 @@
+package infra
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+
+	"github.com/SpecForgeVC/SpecForge/internal/domain"
+	"github.com/SpecForgeVC/SpecForge/internal/drift"
+	"github.com/SpecForgeVC/SpecForge/internal/infra/db"
+	"github.com/google/uuid"
+)
+
+type specDriftCheckRepository struct {
+	db db.DBTX
+}
+
+func NewSpecDriftCheckRepository(db db.DBTX) drift.SpecDriftCheckRepo {
+	return &specDriftCheckRepository{db: db}
+}
+
+func (r *specDriftCheckRepository) Create(ctx context.Context, c *domain.SpecDriftCheck) error {
+	query := `
+		INSERT INTO spec_drift_checks (id, project_id, baseline_source, proposed_source, policy, report, blocked, created_by, created_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)
+	`
+	policyJSON, _ := json.Marshal(c.Policy)
+	reportJSON, _ := json.Marshal(c.Report)
+
+	_, err := r.db.ExecContext(ctx, query,
+		c.ID,
+		c.ProjectID,
+		c.BaselineSource,
+		c.ProposedSource,
+		policyJSON,
+		reportJSON,
+		c.Report.Blocked,
+		uuid.NullUUID{UUID: c.CreatedBy, Valid: c.CreatedBy != uuid.Nil},
+		c.CreatedAt,
+	)
+	return err
+}
+
+func (r *specDriftCheckRepository) Get(ctx context.Context, id uuid.UUID) (*domain.SpecDriftCheck, error) {
+	query := `
+		SELECT id, project_id, baseline_source, proposed_source, policy, report, created_by, created_at
+		FROM spec_drift_checks
+		WHERE id = $1
+	`
+	return r.scan(r.db.QueryRowContext(ctx, query, id))
+}
+
+func (r *specDriftCheckRepository) ListByProject(ctx context.Context, projectID uuid.UUID) ([]domain.SpecDriftCheck, error) {
+	query := `
+		SELECT id, project_id, baseline_source, proposed_source, policy, report, created_by, created_at
+		FROM spec_drift_checks
+		WHERE project_id = $1
+		ORDER BY created_at DESC
+	`
+	rows, err := r.db.QueryContext(ctx, query, projectID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var checks []domain.SpecDriftCheck
+	for rows.Next() {
+		c, err := r.scan(rows)
+		if err != nil {
+			return nil, err
+		}
+		checks = append(checks, *c)
+	}
+	return checks, rows.Err()
+}
+
+type rowScanner interface {
+	Scan(dest ...interface{}) error
+}
+
+func (r *specDriftCheckRepository) scan(row rowScanner) (*domain.SpecDriftCheck, error) {
+	var c domain.SpecDriftCheck
+	var policyJSON, reportJSON []byte
+	var createdBy uuid.NullUUID
+	var createdAt sql.NullTime
+
+	if err := row.Scan(
+		&c.ID,
+		&c.ProjectID,
+		&c.BaselineSource,
+		&c.ProposedSource,
+		&policyJSON,
+		&reportJSON,
+		&createdBy,
+		&createdAt,
+	); err != nil {
+		return nil, err
+	}
+
+	json.Unmarshal(policyJSON, &c.Policy)
+	json.Unmarshal(reportJSON, &c.Report)
+	if createdBy.Valid {
+		c.CreatedBy = createdBy.UUID
+	}
+	c.CreatedAt = createdAt.Time
+	return &c, nil
+}
@@ -0,0 +1,96 @@
+package infra
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+
+	"github.com/SpecForgeVC/SpecForge/internal/domain"
+	"github.com/SpecForgeVC/SpecForge/internal/drift"
+	"github.com/SpecForgeVC/SpecForge/internal/infra/db"
+	"github.com/google/uuid"
+)
+
+type trafficDriftCheckRepository struct {
+	db db.DBTX
+}
+
+func NewTrafficDriftCheckRepository(db db.DBTX) drift.TrafficDriftCheckRepo {
+	return &trafficDriftCheckRepository{db: db}
+}
+
+func (r *trafficDriftCheckRepository) Create(ctx context.Context, c *domain.TrafficDriftCheck) error {
+	query := `
+		INSERT INTO traffic_drift_checks (id, project_id, source, report, created_by, created_at)
+		VALUES ($1, $2, $3, $4, $5, $6)
+	`
+	reportJSON, _ := json.Marshal(c.Report)
+
+	_, err := r.db.ExecContext(ctx, query,
+		c.ID,
+		c.ProjectID,
+		c.Source,
+		reportJSON,
+		uuid.NullUUID{UUID: c.CreatedBy, Valid: c.CreatedBy != uuid.Nil},
+		c.CreatedAt,
+	)
+	return err
+}
+
+func (r *trafficDriftCheckRepository) Get(ctx context.Context, id uuid.UUID) (*domain.TrafficDriftCheck, error) {
+	query := `
+		SELECT id, project_id, source, report, created_by, created_at
+		FROM traffic_drift_checks
+		WHERE id = $1
+	`
+	return r.scan(r.db.QueryRowContext(ctx, query, id))
+}
+
+func (r *trafficDriftCheckRepository) ListByProject(ctx context.Context, projectID uuid.UUID) ([]domain.TrafficDriftCheck, error) {
+	query := `
+		SELECT id, project_id, source, report, created_by, created_at
+		FROM traffic_drift_checks
+		WHERE project_id = $1
+		ORDER BY created_at DESC
+	`
+	rows, err := r.db.QueryContext(ctx, query, projectID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var checks []domain.TrafficDriftCheck
+	for rows.Next() {
+		c, err := r.scan(rows)
+		if err != nil {
+			return nil, err
+		}
+		checks = append(checks, *c)
+	}
+	return checks, rows.Err()
+}
+
+func (r *trafficDriftCheckRepository) scan(row rowScanner) (*domain.TrafficDriftCheck, error) {
+	var c domain.TrafficDriftCheck
+	var reportJSON []byte
+	var createdBy uuid.NullUUID
+	var createdAt sql.NullTime
+
+	if err := row.Scan(
+		&c.ID,
+		&c.ProjectID,
+		&c.Source,
+		&reportJSON,
+		&createdBy,
+		&createdAt,
+	); err != nil {
+		return nil, err
+	}
+
+	json.Unmarshal(reportJSON, &c.Report)
+	if createdBy.Valid {
+		c.CreatedBy = createdBy.UUID
+	}
+	c.CreatedAt = createdAt.Time
+	return &c, nil
+}
@@ -0,0 +1,72 @@
+package infra
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/SpecForgeVC/SpecForge/internal/app"
+	"github.com/SpecForgeVC/SpecForge/internal/infra/db"
+	"github.com/SpecForgeVC/SpecForge/internal/ui_roadmap"
+)
+
+// NewRepositories builds the unit-of-work repositories over conn, which is
+// either the connection pool or a transaction.
+func NewRepositories(conn db.DBTX) app.Repositories {
+	queries := db.New(conn)
+	return app.Repositories{
+		RoadmapItems:             NewRoadmapItemRepository(queries),
+		RoadmapStatusTransitions: NewRoadmapStatusTransitionRepository(conn),
+		Contracts:                NewContractRepository(queries),
+		ContractRevisions:        NewContractRevisionRepository(conn),
+		SchemaLintRulesets:       NewSchemaLintRulesetRepository(conn),
+		Requirements:             NewRequirementRepository(queries),
+		Variables:                NewVariableRepository(queries),
+		ValidationRules:          NewValidationRuleRepository(queries),
+		Snapshots:                NewSnapshotRepository(queries),
+		Proposals:                NewAiProposalRepository(queries),
+		ProposalReviews:          NewProposalReviewRepository(conn),
+		AuditLogs:                NewAuditLogRepository(queries),
+		Bootstrap:                NewBootstrapRepository(queries),
+		UIRoadmapItems:           ui_roadmap.NewDocumentRepository(ui_roadmap.NewRepository(conn)),
+	}
+}
+
+type unitOfWork struct {
+	db *sql.DB
+}
+
+func NewUnitOfWork(db *sql.DB) app.UnitOfWork {
+	return &unitOfWork{db: db}
+}
+
+// Read runs fn against repositories over the connection pool.
+func (u *unitOfWork) Read(ctx context.Context, fn func(ctx context.Context, repos app.Repositories) error) error {
+	return fn(ctx, NewRepositories(u.db))
+}
+
+// Do runs fn in a database transaction. The transaction is rolled back if fn
+// returns an error or panics.
+func (u *unitOfWork) Do(ctx context.Context, fn func(ctx context.Context, repos app.Repositories) error) (err error) {
+	tx, err := u.db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("begin transaction: %w", err)
+	}
+	defer func() {
+		if p := recover(); p != nil {
+			tx.Rollback()
+			panic(p)
+		}
+	}()
+
+	if err := fn(ctx, NewRepositories(tx)); err != nil {
+		if rbErr := tx.Rollback(); rbErr != nil {
+			return fmt.Errorf("%w (rollback failed: %v)", err, rbErr)
+		}
+		return err
+	}
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("commit transaction: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,126 @@
+package jsonpatch
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+type ChangeKind string
+
+const (
+	ChangeAdded   ChangeKind = "added"
+	ChangeRemoved ChangeKind = "removed"
+	ChangeChanged ChangeKind = "changed"
+)
+
+// Change is one leaf-level difference between two documents, addressed by a
+// JSON Pointer. Before is unset for additions and After for removals.
+type Change struct {
+	Path    string      `json:"path"`
+	Kind    ChangeKind  `json:"kind"`
+	Before  interface{} `json:"before,omitempty"`
+	After   interface{} `json:"after,omitempty"`
+	Summary string      `json:"summary"`
+}
+
+// Diff lists the differences between before and after in pointer order.
+// Objects are compared member by member and arrays element by element, so a
+// nested edit is reported at the deepest path that changed.
+func Diff(before, after interface{}) []Change {
+	var changes []Change
+	diff("", before, after, &changes)
+	return changes
+}
+
+func diff(path string, before, after interface{}, changes *[]Change) {
+	if reflect.DeepEqual(before, after) {
+		return
+	}
+	switch b := before.(type) {
+	case map[string]interface{}:
+		a, ok := after.(map[string]interface{})
+		if !ok {
+			break
+		}
+		keys := make([]string, 0, len(b)+len(a))
+		for k := range b {
+			keys = append(keys, k)
+		}
+		for k := range a {
+			if _, seen := b[k]; !seen {
+				keys = append(keys, k)
+			}
+		}
+		sort.Strings(keys)
+		for _, k := range keys {
+			child := path + "/" + escapeToken(k)
+			bv, inBefore := b[k]
+			av, inAfter := a[k]
+			switch {
+			case !inBefore:
+				*changes = append(*changes, newChange(child, ChangeAdded, nil, av))
+			case !inAfter:
+				*changes = append(*changes, newChange(child, ChangeRemoved, bv, nil))
+			default:
+				diff(child, bv, av, changes)
+			}
+		}
+		return
+	case []interface{}:
+		a, ok := after.([]interface{})
+		if !ok {
+			break
+		}
+		for i := 0; i < len(b) || i < len(a); i++ {
+			child := path + "/" + strconv.Itoa(i)
+			switch {
+			case i >= len(b):
+				*changes = append(*changes, newChange(child, ChangeAdded, nil, a[i]))
+			case i >= len(a):
+				*changes = append(*changes, newChange(child, ChangeRemoved, b[i], nil))
+			default:
+				diff(child, b[i], a[i], changes)
+			}
+		}
+		return
+	}
+	*changes = append(*changes, newChange(path, ChangeChanged, before, after))
+}
+
+func newChange(path string, kind ChangeKind, before, after interface{}) Change {
+	c := Change{Path: path, Kind: kind, Before: before, After: after}
+	where := path
+	if where == "" {
+		where = "/"
+	}
+	switch kind {
+	case ChangeAdded:
+		c.Summary = fmt.Sprintf("%s: added %s", where, render(after))
+	case ChangeRemoved:
+		c.Summary = fmt.Sprintf("%s: removed %s", where, render(before))
+	default:
+		c.Summary = fmt.Sprintf("%s: %s → %s", where, render(before), render(after))
+	}
+	return c
+}
+
+// render formats a value for a summary line, shortening long values.
+func render(v interface{}) string {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return fmt.Sprint(v)
+	}
+	s := string(data)
+	if len(s) > 80 {
+		s = s[:77] + "..."
+	}
+	return s
+}
+
+func escapeToken(t string) string {
+	return strings.ReplaceAll(strings.ReplaceAll(t, "~", "~0"), "/", "~1")
+}
@@ -0,0 +1,290 @@
+// Package jsonpatch applies RFC 6902 JSON Patch documents to decoded JSON values
+// (map[string]interface{}, []interface{} and scalars, as produced by encoding/json).
+package jsonpatch
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+)
+
+type OpType string
+
+const (
+	OpAdd     OpType = "add"
+	OpRemove  OpType = "remove"
+	OpReplace OpType = "replace"
+	OpMove    OpType = "move"
+	OpCopy    OpType = "copy"
+	OpTest    OpType = "test"
+)
+
+// Operation is a single RFC 6902 operation. Value is used by add, replace and
+// test; From by move and copy.
+type Operation struct {
+	Op    OpType      `json:"op"`
+	Path  string      `json:"path"`
+	From  string      `json:"from,omitempty"`
+	Value interface{} `json:"value,omitempty"`
+}
+
+type Patch []Operation
+
+// Decode reads a patch from a decoded JSON value such as a proposal diff entry.
+func Decode(v interface{}) (Patch, error) {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+	var p Patch
+	if err := json.Unmarshal(data, &p); err != nil {
+		return nil, fmt.Errorf("invalid JSON patch: %w", err)
+	}
+	return p, p.Validate()
+}
+
+// Validate checks operation names and pointers without applying the patch.
+func (p Patch) Validate() error {
+	if len(p) == 0 {
+		return fmt.Errorf("patch has no operations")
+	}
+	for i, op := range p {
+		if _, err := parsePointer(op.Path); err != nil {
+			return fmt.Errorf("operation %d: path: %w", i, err)
+		}
+		switch op.Op {
+		case OpAdd, OpReplace, OpTest, OpRemove:
+		case OpMove, OpCopy:
+			if _, err := parsePointer(op.From); err != nil {
+				return fmt.Errorf("operation %d: from: %w", i, err)
+			}
+			if op.Op == OpMove && strings.HasPrefix(op.Path+"/", op.From+"/") && op.Path != op.From {
+				return fmt.Errorf("operation %d: cannot move %q into its own child %q", i, op.From, op.Path)
+			}
+		default:
+			return fmt.Errorf("operation %d: unknown op %q", i, op.Op)
+		}
+	}
+	return nil
+}
+
+// Apply returns a patched copy of doc; doc itself is never modified. Patches
+// are atomic: if any operation fails, the error is returned and no result.
+func (p Patch) Apply(doc interface{}) (interface{}, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+	out, err := deepCopy(doc)
+	if err != nil {
+		return nil, err
+	}
+	for i, op := range p {
+		if out, err = apply(out, op); err != nil {
+			return nil, fmt.Errorf("operation %d (%s %s): %w", i, op.Op, op.Path, err)
+		}
+	}
+	return out, nil
+}
+
+func apply(doc interface{}, op Operation) (interface{}, error) {
+	path, _ := parsePointer(op.Path)
+	switch op.Op {
+	case OpAdd:
+		value, err := deepCopy(op.Value)
+		if err != nil {
+			return nil, err
+		}
+		return add(doc, path, value)
+	case OpRemove:
+		doc, _, err := remove(doc, path)
+		return doc, err
+	case OpReplace:
+		if _, err := get(doc, path); err != nil {
+			return nil, err
+		}
+		doc, _, err := remove(doc, path)
+		if err != nil {
+			return nil, err
+		}
+		value, err := deepCopy(op.Value)
+		if err != nil {
+			return nil, err
+		}
+		return add(doc, path, value)
+	case OpMove:
+		from, _ := parsePointer(op.From)
+		doc, value, err := remove(doc, from)
+		if err != nil {
+			return nil, err
+		}
+		return add(doc, path, value)
+	case OpCopy:
+		from, _ := parsePointer(op.From)
+		value, err := get(doc, from)
+		if err != nil {
+			return nil, err
+		}
+		if value, err = deepCopy(value); err != nil {
+			return nil, err
+		}
+		return add(doc, path, value)
+	case OpTest:
+		value, err := get(doc, path)
+		if err != nil {
+			return nil, err
+		}
+		expected, err := deepCopy(op.Value)
+		if err != nil {
+			return nil, err
+		}
+		if !reflect.DeepEqual(value, expected) {
+			return nil, fmt.Errorf("test failed: value differs")
+		}
+		return doc, nil
+	}
+	return nil, fmt.Errorf("unknown op %q", op.Op)
+}
+
+// parsePointer splits an RFC 6901 JSON Pointer into unescaped reference tokens.
+func parsePointer(ptr string) ([]string, error) {
+	if ptr == "" {
+		return nil, nil
+	}
+	if !strings.HasPrefix(ptr, "/") {
+		return nil, fmt.Errorf("JSON pointer %q must start with '/'", ptr)
+	}
+	tokens := strings.Split(ptr[1:], "/")
+	for i, t := range tokens {
+		tokens[i] = strings.ReplaceAll(strings.ReplaceAll(t, "~1", "/"), "~0", "~")
+	}
+	return tokens, nil
+}
+
+func get(doc interface{}, path []string) (interface{}, error) {
+	cur := doc
+	for _, tok := range path {
+		switch node := cur.(type) {
+		case map[string]interface{}:
+			v, ok := node[tok]
+			if !ok {
+				return nil, fmt.Errorf("member %q not found", tok)
+			}
+			cur = v
+		case []interface{}:
+			idx, err := arrayIndex(tok, len(node)-1)
+			if err != nil {
+				return nil, err
+			}
+			cur = node[idx]
+		default:
+			return nil, fmt.Errorf("cannot traverse into %T at %q", cur, tok)
+		}
+	}
+	return cur, nil
+}
+
+// add inserts value at path and returns the (possibly new) root.
+func add(doc interface{}, path []string, value interface{}) (interface{}, error) {
+	if len(path) == 0 {
+		return value, nil
+	}
+	parent, err := get(doc, path[:len(path)-1])
+	if err != nil {
+		return nil, err
+	}
+	last := path[len(path)-1]
+	switch node := parent.(type) {
+	case map[string]interface{}:
+		node[last] = value
+		return doc, nil
+	case []interface{}:
+		idx := len(node)
+		if last != "-" {
+			if idx, err = arrayIndex(last, len(node)); err != nil {
+				return nil, err
+			}
+		}
+		grown := append(node, nil)
+		copy(grown[idx+1:], grown[idx:])
+		grown[idx] = value
+		return setChild(doc, path[:len(path)-1], grown)
+	}
+	return nil, fmt.Errorf("cannot add to %T", parent)
+}
+
+// remove deletes the value at path and returns the new root and the removed value.
+func remove(doc interface{}, path []string) (interface{}, interface{}, error) {
+	if len(path) == 0 {
+		return nil, nil, fmt.Errorf("cannot remove the document root")
+	}
+	parent, err := get(doc, path[:len(path)-1])
+	if err != nil {
+		return nil, nil, err
+	}
+	last := path[len(path)-1]
+	switch node := parent.(type) {
+	case map[string]interface{}:
+		v, ok := node[last]
+		if !ok {
+			return nil, nil, fmt.Errorf("member %q not found", last)
+		}
+		delete(node, last)
+		return doc, v, nil
+	case []interface{}:
+		idx, err := arrayIndex(last, len(node)-1)
+		if err != nil {
+			return nil, nil, err
+		}
+		v := node[idx]
+		shrunk := append(node[:idx:idx], node[idx+1:]...)
+		doc, err = setChild(doc, path[:len(path)-1], shrunk)
+		return doc, v, err
+	}
+	return nil, nil, fmt.Errorf("cannot remove from %T", parent)
+}
+
+// setChild replaces the value at path, which is needed when an array is resized.
+func setChild(doc interface{}, path []string, value interface{}) (interface{}, error) {
+	if len(path) == 0 {
+		return value, nil
+	}
+	parent, err := get(doc, path[:len(path)-1])
+	if err != nil {
+		return nil, err
+	}
+	last := path[len(path)-1]
+	switch node := parent.(type) {
+	case map[string]interface{}:
+		node[last] = value
+	case []interface{}:
+		idx, err := arrayIndex(last, len(node)-1)
+		if err != nil {
+			return nil, err
+		}
+		node[idx] = value
+	}
+	return doc, nil
+}
+
+func arrayIndex(tok string, max int) (int, error) {
+	if tok == "-" || (len(tok) > 1 && tok[0] == '0') {
+		return 0, fmt.Errorf("invalid array index %q", tok)
+	}
+	idx, err := strconv.Atoi(tok)
+	if err != nil || idx < 0 || idx > max {
+		return 0, fmt.Errorf("array index %q out of range", tok)
+	}
+	return idx, nil
+}
+
+func deepCopy(v interface{}) (interface{}, error) {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+	var out interface{}
+	err = json.Unmarshal(data, &out)
+	return out, err
+}
@@ -0,0 +1,98 @@
+package jsonpatch
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+)
+
+func decode(t *testing.T, s string) interface{} {
+	t.Helper()
+	var v interface{}
+	if err := json.Unmarshal([]byte(s), &v); err != nil {
+		t.Fatalf("bad fixture %s: %v", s, err)
+	}
+	return v
+}
+
+func TestApply(t *testing.T) {
+	cases := []struct {
+		name, doc, patch, want string
+	}{
+		{"add member", `{"a":1}`, `[{"op":"add","path":"/b","value":2}]`, `{"a":1,"b":2}`},
+		{"add to array", `{"a":[1,3]}`, `[{"op":"add","path":"/a/1","value":2},{"op":"add","path":"/a/-","value":4}]`, `{"a":[1,2,3,4]}`},
+		{"remove array element", `{"a":[1,2,3]}`, `[{"op":"remove","path":"/a/1"}]`, `{"a":[1,3]}`},
+		{"replace", `{"a":{"type":"integer"}}`, `[{"op":"replace","path":"/a/type","value":"string"}]`, `{"a":{"type":"string"}}`},
+		{"move", `{"a":{"x":1},"b":{}}`, `[{"op":"move","from":"/a/x","path":"/b/y"}]`, `{"a":{},"b":{"y":1}}`},
+		{"copy", `{"a":[1]}`, `[{"op":"copy","from":"/a","path":"/b"}]`, `{"a":[1],"b":[1]}`},
+		{"escaped pointer", `{"paths":{"/users":{}}}`, `[{"op":"add","path":"/paths/~1users/get","value":{}}]`, `{"paths":{"/users":{"get":{}}}}`},
+		{"test passes", `{"a":[1,{"b":true}]}`, `[{"op":"test","path":"/a","value":[1,{"b":true}]}]`, `{"a":[1,{"b":true}]}`},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			doc := decode(t, tc.doc)
+			var p Patch
+			if err := json.Unmarshal([]byte(tc.patch), &p); err != nil {
+				t.Fatal(err)
+			}
+			got, err := p.Apply(doc)
+			if err != nil {
+				t.Fatalf("Apply failed: %v", err)
+			}
+			if !reflect.DeepEqual(got, decode(t, tc.want)) {
+				t.Errorf("got %v, want %s", got, tc.want)
+			}
+			if !reflect.DeepEqual(doc, decode(t, tc.doc)) {
+				t.Errorf("Apply modified its input")
+			}
+		})
+	}
+}
+
+func TestApply_Errors(t *testing.T) {
+	cases := map[string]string{
+		"missing member":  `[{"op":"remove","path":"/missing"}]`,
+		"replace missing": `[{"op":"replace","path":"/missing","value":1}]`,
+		"index range":     `[{"op":"add","path":"/a/5","value":1}]`,
+		"leading zero":    `[{"op":"remove","path":"/a/01"}]`,
+		"failed test":     `[{"op":"test","path":"/a/0","value":2}]`,
+		"unknown op":      `[{"op":"merge","path":"/a"}]`,
+		"bad pointer":     `[{"op":"add","path":"a","value":1}]`,
+		"move into child": `[{"op":"move","from":"/a","path":"/a/0"}]`,
+		"empty":           `[]`,
+	}
+	for name, patch := range cases {
+		t.Run(name, func(t *testing.T) {
+			var p Patch
+			if err := json.Unmarshal([]byte(patch), &p); err != nil {
+				t.Fatal(err)
+			}
+			if _, err := p.Apply(decode(t, `{"a":[1,2]}`)); err == nil {
+				t.Errorf("expected %s to fail", patch)
+			}
+		})
+	}
+}
+
+func TestDiff(t *testing.T) {
+	before := decode(t, `{"title":"Login","tags":["auth"],"schema":{"properties":{"id":{"type":"integer"},"a/b":{}}}}`)
+	after := decode(t, `{"title":"Sign in","tags":["auth","ui"],"schema":{"properties":{"id":{"type":"string"}}},"testable":true}`)
+
+	var got []string
+	for _, c := range Diff(before, after) {
+		got = append(got, c.Summary)
+	}
+	want := []string{
+		`/schema/properties/a~1b: removed {}`,
+		`/schema/properties/id/type: "integer" → "string"`,
+		`/tags/1: added "ui"`,
+		`/testable: added true`,
+		`/title: "Login" → "Sign in"`,
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Diff summaries = %q, want %q", got, want)
+	}
+	if changes := Diff(before, before); len(changes) != 0 {
+		t.Errorf("identical documents produced %d changes", len(changes))
+	}
+}
@@ -5,22 +5,28 @@ import (
 	"encoding/json"
 
 	"github.com/SpecForgeVC/SpecForge/internal/app"
+	"github.com/SpecForgeVC/SpecForge/internal/cireport"
 	"github.com/SpecForgeVC/SpecForge/internal/domain"
+	"github.com/SpecForgeVC/SpecForge/internal/drift"
 	"github.com/google/uuid"
 )
 
 // Handlers contains the logic for MCP tools
 type Handlers struct {
-	repo          app.MCPRepository
-	sm            *SnapshotStateMachine
-	importService app.ImportService
+	repo             app.MCPRepository
+	sm               *SnapshotStateMachine
+	importService    app.ImportService
+	driftService     drift.DriftService
+	alignmentService app.AlignmentService
 }
 
-func NewHandlers(repo app.MCPRepository, importService app.ImportService) *Handlers {
+func NewHandlers(repo app.MCPRepository, importService app.ImportService, driftService drift.DriftService, alignmentService app.AlignmentService) *Handlers {
 	return &Handlers{
-		repo:          repo,
-		sm:            NewSnapshotStateMachine(),
-		importService: importService,
+		repo:             repo,
+		sm:               NewSnapshotStateMachine(),
+		importService:    importService,
+		driftService:     driftService,
+		alignmentService: alignmentService,
 	}
 }
 
@@ -255,6 +261,63 @@ func (h *Handlers) ListActiveSnapshots(ctx context.Context, params json.RawMessa
 	return snapshots, nil
 }
 
+func (h *Handlers) ExportReport(ctx context.Context, params json.RawMessage) (interface{}, error) {
+	var input ToolInputExportReport
+	if err := json.Unmarshal(params, &input); err != nil {
+		return nil, &JSONRPCError{Code: -32602, Message: "Invalid arguments"}
+	}
+	format, err := cireport.ParseFormat(input.Format)
+	if err != nil {
+		return nil, &JSONRPCError{Code: -32602, Message: "Invalid format", Data: err.Error()}
+	}
+	id, err := uuid.Parse(input.ID)
+	if err != nil {
+		return nil, &JSONRPCError{Code: -32602, Message: "Invalid id", Data: err.Error()}
+	}
+
+	var report cireport.Report
+	var fileBase string
+	switch input.ReportType {
+	case "spec_drift_check":
+		check, err := h.driftService.GetSpecDriftCheck(ctx, id)
+		if err != nil {
+			return nil, &JSONRPCError{Code: -32603, Message: "Failed to load drift check", Data: err.Error()}
+		}
+		report = cireport.FromDriftReport("spec-drift-check "+id.String(), artifactOr(input.Artifact, "openapi.yaml"), check.Report)
+		fileBase = "drift-check-" + id.String()
+	case "alignment":
+		alignment, err := h.alignmentService.GetAlignmentReport(ctx, id)
+		if err != nil {
+			return nil, &JSONRPCError{Code: -32603, Message: "Failed to load alignment report", Data: err.Error()}
+		}
+		if alignment == nil {
+			return nil, &JSONRPCError{Code: -32603, Message: "No alignment report found"}
+		}
+		report = cireport.FromAlignmentReport("alignment "+id.String(), artifactOr(input.Artifact, "projects/"+id.String()+"/alignment"), *alignment)
+		fileBase = "alignment-" + id.String()
+	default:
+		return nil, &JSONRPCError{Code: -32602, Message: "Invalid report_type", Data: "expected spec_drift_check or alignment"}
+	}
+
+	data, contentType, err := cireport.Render(format, report)
+	if err != nil {
+		return nil, &JSONRPCError{Code: -32603, Message: "Failed to render report", Data: err.Error()}
+	}
+	return ToolOutputExportReport{
+		FileName:    cireport.FileName(fileBase, format),
+		ContentType: contentType,
+		Content:     string(data),
+		Failed:      report.Failed(),
+	}, nil
+}
+
+func artifactOr(artifact, def string) string {
+	if artifact != "" {
+		return artifact
+	}
+	return def
+}
+
 func (h *Handlers) Help(ctx context.Context, params json.RawMessage) (interface{}, error) {
 	return map[string]interface{}{
 		"description": "SpecForge Reality Anchor Engine (RAE) MCP Server",
@@ -176,6 +176,8 @@ func (r *Router) handleCallTool(ctx context.Context, params json.RawMessage) (in
 		return r.server.handlers.SubmitPostImportSnapshot(ctx, callParams.Arguments)
 	case "finalize_project_import":
 		return r.server.handlers.FinalizeProjectImport(ctx, callParams.Arguments)
+	case "export_report":
+		return r.server.handlers.ExportReport(ctx, callParams.Arguments)
 	case "help":
 		return r.server.handlers.Help(ctx, callParams.Arguments)
 	default:
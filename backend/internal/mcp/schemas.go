@@ -36,6 +36,22 @@ type ToolOutputPostSnapshot struct {
 	RequiredNextTool string        `json:"required_next_tool"`
 }
 
+// ToolInputExportReport defines the input for the export_report tool
+type ToolInputExportReport struct {
+	ReportType string `json:"report_type"` // spec_drift_check, alignment
+	ID         string `json:"id"`          // drift check ID or project ID
+	Format     string `json:"format"`      // sarif, junit
+	Artifact   string `json:"artifact"`
+}
+
+// ToolOutputExportReport defines the output for the export_report tool
+type ToolOutputExportReport struct {
+	FileName    string `json:"file_name"`
+	ContentType string `json:"content_type"`
+	Content     string `json:"content"`
+	Failed      bool   `json:"failed"` // true when the report has unwaived error-level findings
+}
+
 // JSON-RPC models
 type JSONRPCRequest struct {
 	JSONRPC string          `json:"jsonrpc"`
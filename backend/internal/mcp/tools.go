@@ -81,6 +81,11 @@ func GetToolDefinitions() []Tool {
 			Description: "Signals that the project cataloguing and documentation is 100% complete and requests finalization of the import process. This will trigger a transition to the project dashboard.",
 			InputSchema: ToolInputFinalizeProjectImportSchema,
 		},
+		{
+			Name:        "export_report",
+			Description: "Renders a spec drift check or a project's alignment report as SARIF 2.1.0 or JUnit XML for CI pipelines.",
+			InputSchema: ToolInputExportReportSchema,
+		},
 		{
 			Name:        "help",
 			Description: "Returns tool descriptions, required usage order, and JSON schema examples.",
@@ -253,3 +258,14 @@ var ToolInputFinalizeProjectImportSchema = map[string]interface{}{
 	},
 	"required": []string{"project_id"},
 }
+
+var ToolInputExportReportSchema = map[string]interface{}{
+	"type": "object",
+	"properties": map[string]interface{}{
+		"report_type": map[string]interface{}{"type": "string", "enum": []string{"spec_drift_check", "alignment"}},
+		"id":          map[string]interface{}{"type": "string", "description": "The drift check ID, or the project ID for alignment reports."},
+		"format":      map[string]interface{}{"type": "string", "enum": []string{"sarif", "junit"}},
+		"artifact":    map[string]interface{}{"type": "string", "description": "Optional file path findings are attributed to in SARIF output."},
+	},
+	"required": []string{"report_type", "id", "format"},
+}
@@ -0,0 +1,95 @@
+package openapi
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/SpecForgeVC/SpecForge/internal/domain"
+	"github.com/SpecForgeVC/SpecForge/internal/domain/drift"
+)
+
+// FromContracts builds a single OpenAPI model from a stored contract set.
+// Contracts whose input schema holds a full OpenAPI document (as produced by the
+// refinement flow) are loaded as-is; other contracts are synthesized into a
+// POST /contracts/{id} operation carrying their input, output and error schemas.
+// GraphQL (SDL), AsyncAPI event and structured CLI contracts are not HTTP APIs
+// and are skipped.
+func FromContracts(contracts []domain.ContractDefinition) (*drift.OpenAPIDocument, error) {
+	doc := &drift.OpenAPIDocument{
+		Paths: make(map[string]drift.PathItem),
+		Components: drift.Components{
+			Schemas:         make(map[string]drift.Schema),
+			SecuritySchemes: make(map[string]drift.SecurityScheme),
+		},
+	}
+
+	for _, c := range contracts {
+		if !isHTTP(c) {
+			continue
+		}
+		if _, embedded := c.InputSchema["openapi"]; embedded {
+			data, err := json.Marshal(c.InputSchema)
+			if err != nil {
+				return nil, fmt.Errorf("contract %s: %w", c.ID, err)
+			}
+			sub, err := Load(data)
+			if err != nil {
+				return nil, fmt.Errorf("contract %s: %w", c.ID, err)
+			}
+			merge(doc, sub)
+			continue
+		}
+
+		op := drift.Operation{
+			ID:          c.ID.String(),
+			Summary:     fmt.Sprintf("%s contract v%s", c.ContractType, c.Version),
+			RequestBody: &drift.RequestBody{Content: jsonContent(c.InputSchema)},
+			Responses: map[string]drift.Response{
+				"200": {Content: jsonContent(c.OutputSchema)},
+			},
+		}
+		if len(c.ErrorSchema) > 0 {
+			op.Responses["default"] = drift.Response{Content: jsonContent(c.ErrorSchema)}
+		}
+		doc.Paths["/contracts/"+c.ID.String()] = drift.PathItem{
+			Operations: map[string]drift.Operation{"post": op},
+		}
+	}
+
+	return doc, nil
+}
+
+func isHTTP(c domain.ContractDefinition) bool {
+	if c.ContractType == domain.GraphQL {
+		return false
+	}
+	_, async := c.InputSchema["asyncapi"]
+	_, cli := c.InputSchema["cli"]
+	return !async && !cli
+}
+
+func jsonContent(schema map[string]interface{}) map[string]drift.MediaType {
+	return map[string]drift.MediaType{
+		"application/json": {Schema: ToSchema(schema)},
+	}
+}
+
+// merge folds src into dst; later operations and components win on collision.
+func merge(dst, src *drift.OpenAPIDocument) {
+	for path, item := range src.Paths {
+		existing, ok := dst.Paths[path]
+		if !ok {
+			dst.Paths[path] = item
+			continue
+		}
+		for method, op := range item.Operations {
+			existing.Operations[method] = op
+		}
+	}
+	for name, s := range src.Components.Schemas {
+		dst.Components.Schemas[name] = s
+	}
+	for name, s := range src.Components.SecuritySchemes {
+		dst.Components.SecuritySchemes[name] = s
+	}
+}
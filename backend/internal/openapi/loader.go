@@ -0,0 +1,149 @@
+package openapi
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/SpecForgeVC/SpecForge/internal/domain/drift"
+	"gopkg.in/yaml.v3"
+)
+
+// RefError describes a $ref that could not be resolved.
+type RefError struct {
+	Ref      string `json:"ref"`
+	Location string `json:"location"`
+	File     string `json:"file,omitempty"`
+	Reason   string `json:"reason"`
+}
+
+func (e RefError) Error() string {
+	if e.File != "" {
+		return fmt.Sprintf("%s: unresolvable $ref '%s' at %s: %s", e.File, e.Ref, e.Location, e.Reason)
+	}
+	return fmt.Sprintf("unresolvable $ref '%s' at %s: %s", e.Ref, e.Location, e.Reason)
+}
+
+// UnresolvedRefsError is returned when one or more $refs in a document cannot be resolved.
+type UnresolvedRefsError struct {
+	Refs []RefError `json:"refs"`
+}
+
+func (e *UnresolvedRefsError) Error() string {
+	msgs := make([]string, 0, len(e.Refs))
+	for _, r := range e.Refs {
+		msgs = append(msgs, r.Error())
+	}
+	return fmt.Sprintf("%d unresolved reference(s): %s", len(e.Refs), strings.Join(msgs, "; "))
+}
+
+// LoadFile reads an OpenAPI 3.0/3.1 document (YAML or JSON) from disk, resolving
+// local and relative-file $refs against the file's directory.
+func LoadFile(path string) (*drift.OpenAPIDocument, error) {
+	abs, err := filepath.Abs(path)
+	if err != nil {
+		return nil, err
+	}
+	data, err := os.ReadFile(abs)
+	if err != nil {
+		return nil, fmt.Errorf("read openapi document: %w", err)
+	}
+	return load(data, abs)
+}
+
+// Load parses an in-memory OpenAPI 3.0/3.1 document (YAML or JSON). Only local
+// ("#/...") refs can be resolved; relative-file refs require LoadFile.
+func Load(data []byte) (*drift.OpenAPIDocument, error) {
+	return load(data, "")
+}
+
+// Parse decodes a YAML or JSON document into a generic tree with string map keys
+// and float64 numbers, matching the shape produced by encoding/json.
+func Parse(data []byte) (map[string]any, error) {
+	var raw any
+	if err := yaml.Unmarshal(data, &raw); err != nil {
+		return nil, fmt.Errorf("parse openapi document: %w", err)
+	}
+	root, ok := normalizeNode(raw).(map[string]any)
+	if !ok {
+		return nil, fmt.Errorf("parse openapi document: root must be an object")
+	}
+	return root, nil
+}
+
+// ResolveRefs inlines local ("#/...") $refs in a parsed document. It is shared
+// with other JSON-Schema-based formats such as AsyncAPI.
+func ResolveRefs(root map[string]any) (map[string]any, error) {
+	r := newResolver("", root)
+	resolved := r.resolve(root, "", "#", nil)
+	if len(r.errors) > 0 {
+		return nil, &UnresolvedRefsError{Refs: r.errors}
+	}
+	return resolved.(map[string]any), nil
+}
+
+func load(data []byte, file string) (*drift.OpenAPIDocument, error) {
+	root, err := Parse(data)
+	if err != nil {
+		return nil, err
+	}
+	if err := checkVersion(root); err != nil {
+		return nil, err
+	}
+
+	r := newResolver(file, root)
+	resolved := r.resolve(root, file, "#", nil)
+	if len(r.errors) > 0 {
+		return nil, &UnresolvedRefsError{Refs: r.errors}
+	}
+
+	doc := toDocument(resolved.(map[string]any))
+	return &doc, nil
+}
+
+func checkVersion(root map[string]any) error {
+	if _, ok := root["swagger"]; ok {
+		return fmt.Errorf("swagger 2.0 documents are not supported; convert to OpenAPI 3.x first")
+	}
+	version, _ := root["openapi"].(string)
+	if version == "" {
+		return fmt.Errorf("missing 'openapi' version field")
+	}
+	if !strings.HasPrefix(version, "3.0") && !strings.HasPrefix(version, "3.1") {
+		return fmt.Errorf("unsupported openapi version %s (expected 3.0.x or 3.1.x)", version)
+	}
+	return nil
+}
+
+// normalizeNode converts yaml.v3 output into encoding/json-compatible values.
+func normalizeNode(node any) any {
+	switch v := node.(type) {
+	case map[string]any:
+		out := make(map[string]any, len(v))
+		for k, val := range v {
+			out[k] = normalizeNode(val)
+		}
+		return out
+	case map[any]any:
+		out := make(map[string]any, len(v))
+		for k, val := range v {
+			out[fmt.Sprint(k)] = normalizeNode(val)
+		}
+		return out
+	case []any:
+		out := make([]any, len(v))
+		for i, val := range v {
+			out[i] = normalizeNode(val)
+		}
+		return out
+	case int:
+		return float64(v)
+	case int64:
+		return float64(v)
+	case uint64:
+		return float64(v)
+	default:
+		return v
+	}
+}
@@ -0,0 +1,214 @@
+package openapi
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/SpecForgeVC/SpecForge/internal/domain/drift"
+)
+
+const petstore = `
+openapi: 3.0.3
+info:
+  title: Petstore
+  version: 1.0.0
+security:
+  - bearerAuth: []
+paths:
+  /pets:
+    get:
+      operationId: listPets
+      responses:
+        200:
+          description: ok
+          content:
+            application/json:
+              schema:
+                type: array
+                items:
+                  $ref: '#/components/schemas/Pet'
+    post:
+      security: []
+      requestBody:
+        content:
+          application/json:
+            schema:
+              $ref: '#/components/schemas/Pet'
+      responses:
+        "201":
+          description: created
+components:
+  securitySchemes:
+    bearerAuth:
+      type: http
+      scheme: bearer
+  schemas:
+    Pet:
+      type: object
+      required: [id]
+      properties:
+        id:
+          type: integer
+        name:
+          type: string
+          nullable: true
+          maxLength: 64
+        status:
+          type: string
+          enum: [available, sold]
+        parent:
+          $ref: '#/components/schemas/Pet'
+`
+
+func TestLoad_ResolvesLocalRefs(t *testing.T) {
+	doc, err := Load([]byte(petstore))
+	if err != nil {
+		t.Fatalf("Load failed: %v", err)
+	}
+
+	get, ok := doc.Paths["/pets"].Operations["get"]
+	if !ok {
+		t.Fatalf("expected GET /pets operation")
+	}
+	if get.ID != "listPets" {
+		t.Errorf("expected operationId listPets, got %q", get.ID)
+	}
+
+	resp, ok := get.Responses["200"]
+	if !ok {
+		t.Fatalf("expected numeric response code key to be normalized to \"200\"")
+	}
+	items := resp.Content["application/json"].Schema.Items
+	if items == nil || items.Type != "object" {
+		t.Fatalf("expected array items to resolve to Pet object, got %+v", items)
+	}
+	name := items.Properties["name"]
+	if !name.Nullable || name.MaxLength == nil || *name.MaxLength != 64 {
+		t.Errorf("expected nullable name with maxLength 64, got %+v", name)
+	}
+	if parent := items.Properties["parent"]; parent.Ref != "#/components/schemas/Pet" {
+		t.Errorf("expected circular ref to be preserved, got %+v", parent)
+	}
+
+	if len(get.Security) != 1 {
+		t.Errorf("expected GET to inherit global security, got %v", get.Security)
+	}
+	if post := doc.Paths["/pets"].Operations["post"]; post.Security == nil || len(post.Security) != 0 {
+		t.Errorf("expected POST to keep explicit anonymous security, got %v", post.Security)
+	}
+	if doc.Components.SecuritySchemes["bearerAuth"].Type != "http" {
+		t.Errorf("expected bearerAuth security scheme to be loaded")
+	}
+}
+
+func TestLoad_OpenAPI31TypeArrays(t *testing.T) {
+	spec := `{
+		"openapi": "3.1.0",
+		"paths": {},
+		"components": {"schemas": {"Name": {"type": ["string", "null"], "minLength": 1}}}
+	}`
+	doc, err := Load([]byte(spec))
+	if err != nil {
+		t.Fatalf("Load failed: %v", err)
+	}
+	s := doc.Components.Schemas["Name"]
+	if s.Type != "string" || !s.Nullable {
+		t.Errorf("expected nullable string, got type=%q nullable=%v", s.Type, s.Nullable)
+	}
+}
+
+func TestLoad_UnresolvableRefs(t *testing.T) {
+	spec := `
+openapi: 3.1.0
+paths:
+  /a:
+    get:
+      responses:
+        "200":
+          content:
+            application/json:
+              schema:
+                $ref: '#/components/schemas/Missing'
+        "404":
+          content:
+            application/json:
+              schema:
+                $ref: 'errors.yaml#/Error'
+`
+	_, err := Load([]byte(spec))
+	var refErr *UnresolvedRefsError
+	if !errors.As(err, &refErr) {
+		t.Fatalf("expected UnresolvedRefsError, got %v", err)
+	}
+	if len(refErr.Refs) != 2 {
+		t.Fatalf("expected 2 unresolved refs, got %d: %v", len(refErr.Refs), refErr.Refs)
+	}
+}
+
+func TestLoadFile_RelativeFileRefs(t *testing.T) {
+	dir := t.TempDir()
+	main := `
+openapi: 3.0.0
+paths:
+  /users:
+    $ref: 'paths/users.yaml'
+`
+	users := `
+get:
+  responses:
+    "200":
+      content:
+        application/json:
+          schema:
+            $ref: '../schemas.json#/User'
+`
+	schemas := `{"User": {"type": "object", "properties": {"email": {"type": "string", "format": "email"}}}}`
+
+	mustWrite(t, filepath.Join(dir, "openapi.yaml"), main)
+	mustWrite(t, filepath.Join(dir, "paths", "users.yaml"), users)
+	mustWrite(t, filepath.Join(dir, "schemas.json"), schemas)
+
+	doc, err := LoadFile(filepath.Join(dir, "openapi.yaml"))
+	if err != nil {
+		t.Fatalf("LoadFile failed: %v", err)
+	}
+	schema := doc.Paths["/users"].Operations["get"].Responses["200"].Content["application/json"].Schema
+	if schema.Properties["email"] == nil || schema.Properties["email"].Format != "email" {
+		t.Errorf("expected email property resolved from schemas.json, got %+v", schema)
+	}
+}
+
+func TestLoad_FeedsDriftEngine(t *testing.T) {
+	baseline, err := Load([]byte(petstore))
+	if err != nil {
+		t.Fatalf("Load baseline failed: %v", err)
+	}
+	proposed, err := Load([]byte(petstore))
+	if err != nil {
+		t.Fatalf("Load proposed failed: %v", err)
+	}
+	delete(proposed.Paths, "/pets")
+
+	report := drift.DetectDrift(drift.DriftInput{Baseline: *baseline, Proposed: *proposed})
+	if report.CriticalChanges == 0 {
+		t.Errorf("expected path removal to be reported as critical")
+	}
+}
+
+func TestLoad_RejectsSwagger2(t *testing.T) {
+	if _, err := Load([]byte("swagger: '2.0'\npaths: {}\n")); err == nil {
+		t.Error("expected swagger 2.0 document to be rejected")
+	}
+}
+
+func mustWrite(t *testing.T, path, content string) {
+	t.Helper()
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(path, []byte(content), 0o644); err != nil {
+		t.Fatal(err)
+	}
+}
@@ -0,0 +1,261 @@
+package openapi
+
+import (
+	"sort"
+	"strings"
+
+	"github.com/SpecForgeVC/SpecForge/internal/domain/drift"
+)
+
+var httpMethods = []string{"get", "put", "post", "delete", "options", "head", "patch", "trace"}
+
+// toDocument maps a fully resolved OpenAPI tree onto the drift engine's model.
+func toDocument(root map[string]any) drift.OpenAPIDocument {
+	doc := drift.OpenAPIDocument{
+		Paths: make(map[string]drift.PathItem),
+		Components: drift.Components{
+			Schemas:         make(map[string]drift.Schema),
+			SecuritySchemes: make(map[string]drift.SecurityScheme),
+		},
+	}
+
+	globalSecurity, hasGlobalSecurity := toSecurity(root["security"])
+
+	paths, _ := root["paths"].(map[string]any)
+	for path, rawItem := range paths {
+		item, ok := rawItem.(map[string]any)
+		if !ok {
+			continue
+		}
+		pathItem := drift.PathItem{Operations: make(map[string]drift.Operation)}
+		for _, method := range httpMethods {
+			rawOp, ok := item[method].(map[string]any)
+			if !ok {
+				continue
+			}
+			op := toOperation(rawOp)
+			if _, explicit := rawOp["security"]; !explicit && hasGlobalSecurity {
+				op.Security = globalSecurity
+			}
+			pathItem.Operations[method] = op
+		}
+		doc.Paths[path] = pathItem
+	}
+
+	components, _ := root["components"].(map[string]any)
+	if schemas, ok := components["schemas"].(map[string]any); ok {
+		for name, raw := range schemas {
+			if m, ok := raw.(map[string]any); ok {
+				doc.Components.Schemas[name] = ToSchema(m)
+			}
+		}
+	}
+	if schemes, ok := components["securitySchemes"].(map[string]any); ok {
+		for name, raw := range schemes {
+			if m, ok := raw.(map[string]any); ok {
+				doc.Components.SecuritySchemes[name] = toSecurityScheme(m)
+			}
+		}
+	}
+
+	return doc
+}
+
+func toOperation(raw map[string]any) drift.Operation {
+	op := drift.Operation{
+		ID:          stringField(raw, "operationId"),
+		Summary:     stringField(raw, "summary"),
+		Description: stringField(raw, "description"),
+		Responses:   make(map[string]drift.Response),
+	}
+
+	if body, ok := raw["requestBody"].(map[string]any); ok {
+		op.RequestBody = &drift.RequestBody{Content: toContent(body["content"])}
+	}
+
+	if responses, ok := raw["responses"].(map[string]any); ok {
+		for code, rawResp := range responses {
+			resp, ok := rawResp.(map[string]any)
+			if !ok {
+				continue
+			}
+			op.Responses[code] = drift.Response{Content: toContent(resp["content"])}
+		}
+	}
+
+	op.Security, _ = toSecurity(raw["security"])
+	return op
+}
+
+func toContent(raw any) map[string]drift.MediaType {
+	content := make(map[string]drift.MediaType)
+	m, _ := raw.(map[string]any)
+	for mediaType, rawMT := range m {
+		mt, _ := rawMT.(map[string]any)
+		schema, _ := mt["schema"].(map[string]any)
+		content[mediaType] = drift.MediaType{Schema: ToSchema(schema)}
+	}
+	return content
+}
+
+// toSecurity returns the security requirements and whether the field was present at all.
+// An explicit empty list means the operation is anonymous.
+func toSecurity(raw any) ([]drift.SecurityRequirement, bool) {
+	list, ok := raw.([]any)
+	if !ok {
+		return nil, false
+	}
+	reqs := make([]drift.SecurityRequirement, 0, len(list))
+	for _, rawReq := range list {
+		m, ok := rawReq.(map[string]any)
+		if !ok {
+			continue
+		}
+		req := make(drift.SecurityRequirement, len(m))
+		for scheme, rawScopes := range m {
+			scopes := stringSlice(rawScopes)
+			sort.Strings(scopes)
+			req[scheme] = scopes
+		}
+		reqs = append(reqs, req)
+	}
+	return reqs, true
+}
+
+func toSecurityScheme(raw map[string]any) drift.SecurityScheme {
+	return drift.SecurityScheme{
+		Type:   stringField(raw, "type"),
+		Scheme: strings.ToLower(stringField(raw, "scheme")),
+		In:     stringField(raw, "in"),
+		Name:   stringField(raw, "name"),
+	}
+}
+
+// ToSchema converts a resolved JSON Schema / OpenAPI schema object into the drift model.
+// It accepts both the 3.0 'nullable' keyword and 3.1 type arrays containing "null".
+func ToSchema(raw map[string]any) drift.Schema {
+	if raw == nil {
+		return drift.Schema{}
+	}
+
+	s := drift.Schema{
+		Format:      stringField(raw, "format"),
+		Description: stringField(raw, "description"),
+		Ref:         stringField(raw, "$ref"),
+		Default:     raw["default"],
+	}
+
+	switch t := raw["type"].(type) {
+	case string:
+		s.Type = t
+	case []any:
+		var types []string
+		for _, v := range t {
+			name, _ := v.(string)
+			if name == "null" {
+				s.Nullable = true
+				continue
+			}
+			if name != "" {
+				types = append(types, name)
+			}
+		}
+		sort.Strings(types)
+		s.Type = strings.Join(types, "|")
+	}
+	if nullable, ok := raw["nullable"].(bool); ok && nullable {
+		s.Nullable = true
+	}
+
+	if props, ok := raw["properties"].(map[string]any); ok {
+		s.Properties = make(map[string]*drift.Schema, len(props))
+		for name, rawProp := range props {
+			prop := ToSchema(asMap(rawProp))
+			s.Properties[name] = &prop
+		}
+	}
+	if items, ok := raw["items"].(map[string]any); ok {
+		child := ToSchema(items)
+		s.Items = &child
+	}
+	switch ap := raw["additionalProperties"].(type) {
+	case map[string]any:
+		child := ToSchema(ap)
+		s.AdditionalProperties = &child
+	case bool:
+		s.AdditionalPropertiesDenied = !ap
+	}
+
+	s.Required = stringSlice(raw["required"])
+	if enum, ok := raw["enum"].([]any); ok {
+		s.Enum = enum
+	}
+
+	s.MinLength = intField(raw, "minLength")
+	s.MaxLength = intField(raw, "maxLength")
+	s.MinItems = intField(raw, "minItems")
+	s.MaxItems = intField(raw, "maxItems")
+	s.Minimum = floatField(raw, "minimum")
+	s.Maximum = floatField(raw, "maximum")
+	s.Pattern = stringField(raw, "pattern")
+
+	s.OneOf = schemaList(raw["oneOf"])
+	s.AnyOf = schemaList(raw["anyOf"])
+	s.AllOf = schemaList(raw["allOf"])
+
+	return s
+}
+
+func schemaList(raw any) []*drift.Schema {
+	list, ok := raw.([]any)
+	if !ok {
+		return nil
+	}
+	out := make([]*drift.Schema, 0, len(list))
+	for _, item := range list {
+		s := ToSchema(asMap(item))
+		out = append(out, &s)
+	}
+	return out
+}
+
+func asMap(v any) map[string]any {
+	m, _ := v.(map[string]any)
+	return m
+}
+
+func stringField(m map[string]any, key string) string {
+	s, _ := m[key].(string)
+	return s
+}
+
+func stringSlice(raw any) []string {
+	list, ok := raw.([]any)
+	if !ok {
+		return nil
+	}
+	out := make([]string, 0, len(list))
+	for _, v := range list {
+		if s, ok := v.(string); ok {
+			out = append(out, s)
+		}
+	}
+	return out
+}
+
+func intField(m map[string]any, key string) *int {
+	f, ok := m[key].(float64)
+	if !ok {
+		return nil
+	}
+	v := int(f)
+	return &v
+}
+
+func floatField(m map[string]any, key string) *float64 {
+	f, ok := m[key].(float64)
+	if !ok {
+		return nil
+	}
+	return &f
+}
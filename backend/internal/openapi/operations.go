@@ -0,0 +1,297 @@
+package openapi
+
+import (
+	"encoding/json"
+	"net/url"
+	"sort"
+	"strings"
+)
+
+// Spec is an OpenAPI document flattened into its operations, as imported
+// into contracts.
+type Spec struct {
+	Title      string
+	Version    string
+	Operations []Operation
+}
+
+// Operation is one method/path of a document with self-contained JSON
+// schemas: component $refs are inlined, and recursive ones are rewritten to
+// point into the schema's own $defs.
+type Operation struct {
+	Method      string // upper case
+	Path        string // prefixed with the base path of the first server
+	OperationID string
+	Summary     string
+	Description string
+	Tags        []string
+	Deprecated  bool
+
+	// Request is the JSON request body schema, Response the first 2XX JSON
+	// response schema. Error combines the 4XX, 5XX and default JSON response
+	// schemas, as a oneOf when they differ.
+	Request  map[string]any
+	Response map[string]any
+	Error    map[string]any
+
+	// Parameters are the path, query, header and cookie parameters followed
+	// by the response headers, which have In set to "response_header".
+	Parameters []Parameter
+}
+
+// Parameter is an operation parameter or response header.
+type Parameter struct {
+	Name        string
+	In          string
+	Required    bool
+	Description string
+	Schema      map[string]any
+}
+
+// Key identifies the operation within its document.
+func (o Operation) Key() string {
+	return o.Method + " " + o.Path
+}
+
+// LoadSpec parses an in-memory OpenAPI 3.0/3.1 document (YAML or JSON) into
+// its operations, ordered by path and method.
+func LoadSpec(data []byte) (*Spec, error) {
+	raw, err := Parse(data)
+	if err != nil {
+		return nil, err
+	}
+	if err := checkVersion(raw); err != nil {
+		return nil, err
+	}
+	root, err := ResolveRefs(raw)
+	if err != nil {
+		return nil, err
+	}
+
+	spec := &Spec{}
+	info, _ := root["info"].(map[string]any)
+	spec.Title = stringField(info, "title")
+	spec.Version = stringField(info, "version")
+
+	components, _ := root["components"].(map[string]any)
+	defs, _ := components["schemas"].(map[string]any)
+	base := ServerBasePath(root)
+
+	paths, _ := root["paths"].(map[string]any)
+	for _, path := range sortedKeys(paths) {
+		item, _ := paths[path].(map[string]any)
+		shared, _ := item["parameters"].([]any)
+		for _, method := range httpMethods {
+			rawOp, ok := item[method].(map[string]any)
+			if !ok {
+				continue
+			}
+			spec.Operations = append(spec.Operations, toImportOperation(strings.ToUpper(method), base+path, rawOp, shared, defs))
+		}
+	}
+	return spec, nil
+}
+
+func toImportOperation(method, path string, raw map[string]any, shared []any, defs map[string]any) Operation {
+	op := Operation{
+		Method:      method,
+		Path:        path,
+		OperationID: stringField(raw, "operationId"),
+		Summary:     stringField(raw, "summary"),
+		Description: stringField(raw, "description"),
+		Tags:        stringSlice(raw["tags"]),
+	}
+	op.Deprecated, _ = raw["deprecated"].(bool)
+
+	if body, ok := raw["requestBody"].(map[string]any); ok {
+		op.Request = selfContained(JSONContentSchema(body["content"]), defs)
+	}
+
+	responses, _ := raw["responses"].(map[string]any)
+	var errSchemas []map[string]any
+	var headers []Parameter
+	for _, status := range sortedKeys(responses) {
+		resp, _ := responses[status].(map[string]any)
+		schema := selfContained(JSONContentSchema(resp["content"]), defs)
+		switch code := strings.ToUpper(status); {
+		case strings.HasPrefix(code, "2"):
+			if op.Response == nil {
+				op.Response = schema
+			}
+		case strings.HasPrefix(code, "4"), strings.HasPrefix(code, "5"), code == "DEFAULT":
+			if schema != nil && !containsSchema(errSchemas, schema) {
+				errSchemas = append(errSchemas, schema)
+			}
+		}
+		h, _ := resp["headers"].(map[string]any)
+		for _, name := range sortedKeys(h) {
+			header, _ := h[name].(map[string]any)
+			headers = append(headers, toParameter(name, "response_header", header, defs))
+		}
+	}
+	switch len(errSchemas) {
+	case 0:
+	case 1:
+		op.Error = errSchemas[0]
+	default:
+		list := make([]any, len(errSchemas))
+		for i, e := range errSchemas {
+			list[i] = e
+		}
+		op.Error = map[string]any{"oneOf": list}
+	}
+
+	// Operation parameters override path-level ones with the same name and location.
+	params := make(map[string]Parameter)
+	var order []string
+	for _, list := range [][]any{shared, asSlice(raw["parameters"])} {
+		for _, p := range list {
+			m, _ := p.(map[string]any)
+			name, in := stringField(m, "name"), stringField(m, "in")
+			if name == "" || in == "" {
+				continue
+			}
+			key := in + ":" + name
+			if _, seen := params[key]; !seen {
+				order = append(order, key)
+			}
+			params[key] = toParameter(name, in, m, defs)
+		}
+	}
+	for _, key := range order {
+		op.Parameters = append(op.Parameters, params[key])
+	}
+	seen := make(map[string]bool, len(headers))
+	for _, h := range headers {
+		if !seen[h.Name] {
+			seen[h.Name] = true
+			op.Parameters = append(op.Parameters, h)
+		}
+	}
+	return op
+}
+
+func toParameter(name, in string, raw map[string]any, defs map[string]any) Parameter {
+	p := Parameter{Name: name, In: in, Description: stringField(raw, "description")}
+	p.Required, _ = raw["required"].(bool)
+	if schema, ok := raw["schema"].(map[string]any); ok {
+		p.Schema = selfContained(schema, defs)
+	} else {
+		p.Schema = selfContained(JSONContentSchema(raw["content"]), defs)
+	}
+	return p
+}
+
+// selfContained rewrites the $refs ResolveRefs left in place on recursive
+// component schemas to point into the schema's own $defs, and adds the
+// referenced components there.
+func selfContained(schema map[string]any, components map[string]any) map[string]any {
+	if schema == nil {
+		return nil
+	}
+	defs := make(map[string]any)
+	var rewrite func(node any) any
+	rewrite = func(node any) any {
+		switch v := node.(type) {
+		case map[string]any:
+			out := make(map[string]any, len(v))
+			for k, val := range v {
+				out[k] = rewrite(val)
+			}
+			if ref, ok := v["$ref"].(string); ok && strings.HasPrefix(ref, "#/components/schemas/") {
+				name := unescapePointer(strings.TrimPrefix(ref, "#/components/schemas/"))
+				out["$ref"] = "#/$defs/" + escapePointer(name)
+				if _, done := defs[name]; !done {
+					defs[name] = nil // claimed, so the recursion ends here
+					defs[name] = rewrite(components[name])
+				}
+			}
+			return out
+		case []any:
+			out := make([]any, len(v))
+			for i, val := range v {
+				out[i] = rewrite(val)
+			}
+			return out
+		}
+		return node
+	}
+	out := rewrite(schema).(map[string]any)
+	if len(defs) > 0 {
+		merged, _ := out["$defs"].(map[string]any)
+		if merged == nil {
+			merged = make(map[string]any, len(defs))
+		}
+		for name, def := range defs {
+			merged[name] = def
+		}
+		out["$defs"] = merged
+	}
+	return out
+}
+
+// JSONContentSchema picks the schema of the first JSON media type, in name
+// order, of an OpenAPI content map.
+func JSONContentSchema(raw any) map[string]any {
+	content, _ := raw.(map[string]any)
+	for _, mt := range sortedKeys(content) {
+		if !IsJSONMediaType(mt) {
+			continue
+		}
+		media, _ := content[mt].(map[string]any)
+		if schema, ok := media["schema"].(map[string]any); ok {
+			return schema
+		}
+	}
+	return nil
+}
+
+// IsJSONMediaType reports whether a media type or Content-Type header value
+// is application/json or a +json structured syntax.
+func IsJSONMediaType(contentType string) bool {
+	mt := strings.ToLower(strings.TrimSpace(strings.SplitN(contentType, ";", 2)[0]))
+	return mt == "application/json" || strings.HasSuffix(mt, "+json")
+}
+
+// ServerBasePath returns the path of the document's first server URL,
+// without a trailing slash.
+func ServerBasePath(root map[string]any) string {
+	servers, _ := root["servers"].([]any)
+	if len(servers) == 0 {
+		return ""
+	}
+	server, _ := servers[0].(map[string]any)
+	raw, _ := server["url"].(string)
+	u, err := url.Parse(raw)
+	if err != nil {
+		return ""
+	}
+	return strings.TrimRight(u.Path, "/")
+}
+
+func containsSchema(list []map[string]any, schema map[string]any) bool {
+	want, err := json.Marshal(schema)
+	if err != nil {
+		return false
+	}
+	for _, s := range list {
+		if got, err := json.Marshal(s); err == nil && string(got) == string(want) {
+			return true
+		}
+	}
+	return false
+}
+
+func asSlice(v any) []any {
+	s, _ := v.([]any)
+	return s
+}
+
+func sortedKeys(m map[string]any) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
@@ -0,0 +1,153 @@
+package openapi
+
+import (
+	"reflect"
+	"testing"
+)
+
+const usersAPI = `
+openapi: 3.1.0
+info:
+  title: Users
+  version: 2.1.0
+servers:
+  - url: https://api.example.com/v1/
+paths:
+  /users/{id}:
+    parameters:
+      - name: id
+        in: path
+        required: true
+        schema: {type: string}
+      - name: X-Trace
+        in: header
+        schema: {type: string}
+    get:
+      operationId: getUser
+      tags: [users]
+      parameters:
+        - name: X-Trace
+          in: header
+          required: true
+          schema: {type: string}
+        - name: expand
+          in: query
+          schema: {type: boolean, default: false}
+      responses:
+        "200":
+          description: ok
+          headers:
+            X-Rate-Limit:
+              schema: {type: integer}
+          content:
+            application/json:
+              schema: {$ref: "#/components/schemas/User"}
+        "404":
+          content:
+            application/problem+json:
+              schema: {$ref: "#/components/schemas/Problem"}
+        default:
+          content:
+            application/json:
+              schema: {$ref: "#/components/schemas/Problem"}
+    put:
+      requestBody:
+        content:
+          text/plain:
+            schema: {type: string}
+          application/json:
+            schema: {$ref: "#/components/schemas/User"}
+      responses:
+        "204":
+          description: updated
+        "400":
+          content:
+            application/json:
+              schema: {$ref: "#/components/schemas/ValidationError"}
+        "500":
+          content:
+            application/json:
+              schema: {$ref: "#/components/schemas/Problem"}
+components:
+  schemas:
+    User:
+      type: object
+      properties:
+        id: {type: string}
+        manager: {$ref: "#/components/schemas/User"}
+    Problem:
+      type: object
+      properties:
+        title: {type: string}
+    ValidationError:
+      type: object
+      properties:
+        fields: {type: array, items: {type: string}}
+`
+
+func TestLoadSpec(t *testing.T) {
+	spec, err := LoadSpec([]byte(usersAPI))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if spec.Title != "Users" || spec.Version != "2.1.0" {
+		t.Errorf("unexpected info %q %q", spec.Title, spec.Version)
+	}
+	if len(spec.Operations) != 2 {
+		t.Fatalf("expected 2 operations, got %d", len(spec.Operations))
+	}
+
+	get, put := spec.Operations[0], spec.Operations[1]
+	if get.Key() != "GET /v1/users/{id}" || put.Key() != "PUT /v1/users/{id}" {
+		t.Errorf("unexpected operations %s, %s", get.Key(), put.Key())
+	}
+	if get.OperationID != "getUser" || !reflect.DeepEqual(get.Tags, []string{"users"}) {
+		t.Errorf("unexpected get metadata %+v", get)
+	}
+
+	// The recursive User schema points into its own $defs.
+	manager := get.Response["properties"].(map[string]any)["manager"].(map[string]any)
+	if manager["$ref"] != "#/$defs/User" {
+		t.Errorf("recursive ref should point into $defs, got %v", manager)
+	}
+	user, _ := get.Response["$defs"].(map[string]any)["User"].(map[string]any)
+	if user["type"] != "object" {
+		t.Errorf("expected User in $defs, got %v", get.Response["$defs"])
+	}
+
+	// 404 and default share the Problem schema.
+	if get.Error["properties"] == nil || get.Error["oneOf"] != nil {
+		t.Errorf("identical error schemas should not be combined, got %v", get.Error)
+	}
+	if list, _ := put.Error["oneOf"].([]any); len(list) != 2 {
+		t.Errorf("expected a oneOf of two error schemas, got %v", put.Error)
+	}
+	if put.Request["type"] != "object" || put.Response != nil {
+		t.Errorf("unexpected put schemas %v / %v", put.Request, put.Response)
+	}
+
+	want := []struct {
+		name, in string
+		required bool
+	}{
+		{"id", "path", true},
+		{"X-Trace", "header", true},
+		{"expand", "query", false},
+		{"X-Rate-Limit", "response_header", false},
+	}
+	if len(get.Parameters) != len(want) {
+		t.Fatalf("expected %d parameters, got %+v", len(want), get.Parameters)
+	}
+	for i, w := range want {
+		p := get.Parameters[i]
+		if p.Name != w.name || p.In != w.in || p.Required != w.required {
+			t.Errorf("parameter %d: expected %s in %s (required %v), got %+v", i, w.name, w.in, w.required, p)
+		}
+	}
+}
+
+func TestLoadSpec_RejectsSwagger(t *testing.T) {
+	if _, err := LoadSpec([]byte("swagger: '2.0'\ninfo: {title: x, version: 1}\npaths: {}")); err == nil {
+		t.Error("expected swagger 2.0 to be rejected")
+	}
+}
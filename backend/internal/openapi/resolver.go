@@ -0,0 +1,178 @@
+package openapi
+
+import (
+	"net/url"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+)
+
+// resolver inlines $refs across the root document and any relative files it points to.
+// Circular references are left in place as {"$ref": ...} so the normalized model stays finite.
+type resolver struct {
+	docs   map[string]map[string]any
+	errors []RefError
+}
+
+func newResolver(file string, root map[string]any) *resolver {
+	return &resolver{
+		docs: map[string]map[string]any{file: root},
+	}
+}
+
+func (r *resolver) resolve(node any, file, pointer string, stack []string) any {
+	switch v := node.(type) {
+	case map[string]any:
+		if ref, ok := v["$ref"].(string); ok {
+			return r.resolveRef(v, ref, file, pointer, stack)
+		}
+		out := make(map[string]any, len(v))
+		for k, val := range v {
+			out[k] = r.resolve(val, file, pointer+"/"+escapePointer(k), stack)
+		}
+		return out
+	case []any:
+		out := make([]any, len(v))
+		for i, val := range v {
+			out[i] = r.resolve(val, file, pointer+"/"+strconv.Itoa(i), stack)
+		}
+		return out
+	default:
+		return v
+	}
+}
+
+func (r *resolver) resolveRef(node map[string]any, ref, file, pointer string, stack []string) any {
+	targetFile, fragment, err := r.splitRef(ref, file)
+	if err != "" {
+		r.fail(ref, pointer, file, err)
+		return node
+	}
+
+	key := targetFile + "#" + fragment
+	for _, seen := range stack {
+		if seen == key {
+			return map[string]any{"$ref": ref}
+		}
+	}
+
+	root, reason := r.document(targetFile)
+	if reason != "" {
+		r.fail(ref, pointer, file, reason)
+		return node
+	}
+	target, reason := lookupPointer(root, fragment)
+	if reason != "" {
+		r.fail(ref, pointer, file, reason)
+		return node
+	}
+
+	resolved := r.resolve(target, targetFile, "#"+fragment, append(stack, key))
+
+	// OpenAPI 3.1 allows siblings next to $ref (e.g. description); they override the target.
+	if m, ok := resolved.(map[string]any); ok && len(node) > 1 {
+		merged := make(map[string]any, len(m)+len(node))
+		for k, v := range m {
+			merged[k] = v
+		}
+		for k, v := range node {
+			if k != "$ref" {
+				merged[k] = r.resolve(v, file, pointer+"/"+escapePointer(k), stack)
+			}
+		}
+		return merged
+	}
+	return resolved
+}
+
+// splitRef returns the absolute target file and JSON pointer fragment for a $ref.
+func (r *resolver) splitRef(ref, file string) (string, string, string) {
+	location, fragment, _ := strings.Cut(ref, "#")
+	if location == "" {
+		return file, fragment, ""
+	}
+	if strings.HasPrefix(location, "http://") || strings.HasPrefix(location, "https://") {
+		return "", "", "remote references are not supported"
+	}
+	if file == "" {
+		return "", "", "relative file references require the document to be loaded from a file"
+	}
+	location, err := url.PathUnescape(location)
+	if err != nil {
+		return "", "", "invalid reference path"
+	}
+	if !filepath.IsAbs(location) {
+		location = filepath.Join(filepath.Dir(file), location)
+	}
+	return filepath.Clean(location), fragment, ""
+}
+
+func (r *resolver) document(file string) (map[string]any, string) {
+	if doc, ok := r.docs[file]; ok {
+		return doc, ""
+	}
+	data, err := os.ReadFile(file)
+	if err != nil {
+		return nil, "referenced file could not be read"
+	}
+	doc, err := Parse(data)
+	if err != nil {
+		return nil, "referenced file is not a valid YAML or JSON object"
+	}
+	r.docs[file] = doc
+	return doc, ""
+}
+
+func (r *resolver) fail(ref, pointer, file, reason string) {
+	r.errors = append(r.errors, RefError{
+		Ref:      ref,
+		Location: pointer,
+		File:     file,
+		Reason:   reason,
+	})
+}
+
+// lookupPointer walks an RFC 6901 JSON pointer (without the leading '#').
+func lookupPointer(root map[string]any, fragment string) (any, string) {
+	if fragment == "" || fragment == "/" {
+		return root, ""
+	}
+	if !strings.HasPrefix(fragment, "/") {
+		return nil, "reference fragment must be a JSON pointer"
+	}
+	fragment, err := url.PathUnescape(fragment)
+	if err != nil {
+		return nil, "invalid JSON pointer encoding"
+	}
+
+	var current any = root
+	for _, token := range strings.Split(fragment[1:], "/") {
+		token = unescapePointer(token)
+		switch v := current.(type) {
+		case map[string]any:
+			next, ok := v[token]
+			if !ok {
+				return nil, "target '" + token + "' not found"
+			}
+			current = next
+		case []any:
+			idx, err := strconv.Atoi(token)
+			if err != nil || idx < 0 || idx >= len(v) {
+				return nil, "array index '" + token + "' out of range"
+			}
+			current = v[idx]
+		default:
+			return nil, "cannot descend into scalar at '" + token + "'"
+		}
+	}
+	return current, ""
+}
+
+func escapePointer(s string) string {
+	return strings.ReplaceAll(strings.ReplaceAll(s, "~", "~0"), "/", "~1")
+}
+
+func unescapePointer(s string) string {
+	return strings.ReplaceAll(strings.ReplaceAll(s, "~1", "/"), "~0", "~")
+}
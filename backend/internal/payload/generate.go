@@ -0,0 +1,655 @@
+package payload
+
+import (
+	"fmt"
+	"math"
+	"reflect"
+	"regexp"
+	"regexp/syntax"
+	"sort"
+	"strings"
+	"unicode/utf8"
+)
+
+// Sample is a generated payload. Invalid samples name the one constraint
+// they were built to break and the JSON Pointer of the value breaking it.
+type Sample struct {
+	Name        string      `json:"name"`
+	Payload     interface{} `json:"payload"`
+	Pointer     string      `json:"pointer,omitempty"`
+	Constraint  string      `json:"constraint,omitempty"`
+	Description string      `json:"description,omitempty"`
+}
+
+// Examples are the samples generated for one schema.
+type Examples struct {
+	Valid   []Sample `json:"valid"`
+	Invalid []Sample `json:"invalid"`
+}
+
+// Generated strings and arrays stop at these lengths, so bounds such as
+// maxLength: 2147483647 cannot make a sample allocate without limit. Samples
+// a capped value makes invalid are dropped by the validity check.
+const (
+	maxSampleLength = 256
+	maxSampleItems  = 16
+)
+
+// formatExamples are values accepted by the validator's format checkers.
+var formatExamples = map[string]string{
+	"date-time":     "2024-01-15T09:30:00Z",
+	"date":          "2024-01-15",
+	"time":          "09:30:00Z",
+	"email":         "user@example.com",
+	"idn-email":     "user@example.com",
+	"hostname":      "api.example.com",
+	"ipv4":          "192.0.2.1",
+	"ipv6":          "2001:db8::1",
+	"uri":           "https://example.com/resource",
+	"iri":           "https://example.com/resource",
+	"uri-reference": "/resource",
+	"iri-reference": "/resource",
+	"uuid":          "123e4567-e89b-42d3-a456-426614174000",
+	"regex":         "^[a-z]+$",
+	"json-pointer":  "/resource/0",
+	"byte":          "ZXhhbXBsZQ==",
+}
+
+// Generate derives example payloads from a schema. The output depends only
+// on the schema, so it can be regenerated and compared, and used as a fixed
+// seed corpus. Two valid samples are built: "minimal" with only required
+// properties and values at the lower bounds, and "full" with every property
+// and values at the upper bounds, up to maxSampleLength characters and
+// maxSampleItems items. Invalid samples each break one constraint of the full
+// sample; bounds above those limits get no invalid sample. Every valid sample
+// passes Validate and every invalid one fails it; candidates that do not are
+// dropped.
+func Generate(schema map[string]interface{}) (*Examples, error) {
+	v, err := Compile(schema)
+	if err != nil {
+		return nil, err
+	}
+	root, _ := ToJSONSchema(schema).(map[string]interface{})
+	ex := &Examples{Valid: []Sample{}, Invalid: []Sample{}}
+
+	// The full sample is mutated when valid, as it reaches every property.
+	var base interface{}
+	for _, s := range []Sample{{Name: "minimal", Payload: generate(root, false)}, {Name: "full", Payload: generate(root, true)}} {
+		if !v.Validate(s.Payload).Valid {
+			continue
+		}
+		if len(ex.Valid) > 0 && reflect.DeepEqual(base, s.Payload) {
+			continue
+		}
+		base = s.Payload
+		ex.Valid = append(ex.Valid, s)
+	}
+	// Without a valid payload to start from, a mutation could not be said to
+	// break only the constraint it targets.
+	if len(ex.Valid) == 0 {
+		return ex, nil
+	}
+
+	for _, m := range mutate(root, base, "") {
+		if v.Validate(m.Payload).Valid {
+			continue
+		}
+		ex.Invalid = append(ex.Invalid, m)
+	}
+	return ex, nil
+}
+
+// generate builds a value satisfying s, at its lower bounds or, when full,
+// its upper bounds.
+func generate(s map[string]interface{}, full bool) interface{} {
+	s = flatten(s)
+	if c, ok := s["const"]; ok {
+		return c
+	}
+	if enum, ok := s["enum"].([]interface{}); ok && len(enum) > 0 {
+		if full {
+			return enum[len(enum)-1]
+		}
+		return enum[0]
+	}
+
+	switch schemaType(s) {
+	case "object":
+		props, _ := s["properties"].(map[string]interface{})
+		obj := make(map[string]interface{})
+		names := requiredNames(s)
+		if full {
+			names = sortedKeys(props)
+		}
+		for _, name := range names {
+			ps, _ := props[name].(map[string]interface{})
+			obj[name] = generate(ps, full)
+		}
+		return obj
+	case "array":
+		items, _ := s["items"].(map[string]interface{})
+		n, _ := number(s, "minItems")
+		count := int(n)
+		if full && count == 0 {
+			count = 1
+		}
+		if maxItems, ok := number(s, "maxItems"); ok && float64(count) > maxItems {
+			count = int(maxItems)
+		}
+		count = min(count, maxSampleItems)
+		arr := make([]interface{}, count)
+		for i := range arr {
+			arr[i] = generate(items, full)
+		}
+		return arr
+	case "integer":
+		return numeric(s, full, true)
+	case "number":
+		return numeric(s, full, false)
+	case "boolean":
+		return full
+	case "null":
+		return nil
+	default:
+		return stringValue(s, full)
+	}
+}
+
+// flatten merges allOf members into the schema, and the first oneOf or anyOf
+// branch with it.
+func flatten(s map[string]interface{}) map[string]interface{} {
+	var parts []map[string]interface{}
+	if all, ok := s["allOf"].([]interface{}); ok {
+		for _, p := range all {
+			if m, ok := p.(map[string]interface{}); ok {
+				parts = append(parts, m)
+			}
+		}
+	}
+	for _, key := range []string{"oneOf", "anyOf"} {
+		if branches, ok := s[key].([]interface{}); ok && len(branches) > 0 {
+			if m, ok := branches[0].(map[string]interface{}); ok {
+				parts = append(parts, m)
+			}
+			break
+		}
+	}
+	if len(parts) == 0 {
+		return s
+	}
+
+	merged := make(map[string]interface{}, len(s))
+	for k, v := range s {
+		if k != "allOf" && k != "oneOf" && k != "anyOf" {
+			merged[k] = v
+		}
+	}
+	for _, p := range parts {
+		for k, v := range flatten(p) {
+			switch k {
+			case "properties":
+				props, _ := merged[k].(map[string]interface{})
+				combined := make(map[string]interface{}, len(props))
+				for name, ps := range props {
+					combined[name] = ps
+				}
+				for name, ps := range v.(map[string]interface{}) {
+					if _, ok := combined[name]; !ok {
+						combined[name] = ps
+					}
+				}
+				merged[k] = combined
+			case "required":
+				existing, _ := merged[k].([]interface{})
+				extra, _ := v.([]interface{})
+				merged[k] = append(append([]interface{}{}, existing...), extra...)
+			default:
+				if _, ok := merged[k]; !ok {
+					merged[k] = v
+				}
+			}
+		}
+	}
+	return merged
+}
+
+// schemaType returns the first non-null type of s, inferring one from the
+// keywords present when none is declared.
+func schemaType(s map[string]interface{}) string {
+	switch t := s["type"].(type) {
+	case string:
+		return t
+	case []interface{}:
+		for _, v := range t {
+			if name, ok := v.(string); ok && name != "null" {
+				return name
+			}
+		}
+		if len(t) > 0 {
+			return "null"
+		}
+	}
+	for _, k := range []string{"properties", "required", "additionalProperties"} {
+		if _, ok := s[k]; ok {
+			return "object"
+		}
+	}
+	if _, ok := s["items"]; ok {
+		return "array"
+	}
+	for _, k := range []string{"minimum", "maximum", "exclusiveMinimum", "exclusiveMaximum", "multipleOf"} {
+		if _, ok := s[k]; ok {
+			return "number"
+		}
+	}
+	return "string"
+}
+
+// declaredTypes lists the types s allows, nil when it does not constrain them.
+func declaredTypes(s map[string]interface{}) []string {
+	switch t := s["type"].(type) {
+	case string:
+		return []string{t}
+	case []interface{}:
+		var out []string
+		for _, v := range t {
+			if name, ok := v.(string); ok {
+				out = append(out, name)
+			}
+		}
+		return out
+	}
+	return nil
+}
+
+// bounds returns the inclusive range of legal values of a numeric schema,
+// reading both the boolean (OpenAPI 3.0) and numeric forms of the exclusive
+// keywords. Exclusive bounds are moved inwards by one, or by a quarter of
+// the range for narrow non-integer ranges.
+func bounds(s map[string]interface{}, integer bool) (lo, hi float64, hasLo, hasHi bool) {
+	lo, hasLo = number(s, "minimum")
+	hi, hasHi = number(s, "maximum")
+	exclLo, exclHi := false, false
+	if e, ok := number(s, "exclusiveMinimum"); ok {
+		lo, hasLo, exclLo = e, true, true
+	} else if e, _ := s["exclusiveMinimum"].(bool); e && hasLo {
+		exclLo = true
+	}
+	if e, ok := number(s, "exclusiveMaximum"); ok {
+		hi, hasHi, exclHi = e, true, true
+	} else if e, _ := s["exclusiveMaximum"].(bool); e && hasHi {
+		exclHi = true
+	}
+
+	step := 1.0
+	if !integer && hasLo && hasHi && hi-lo <= 2 {
+		step = (hi - lo) / 4
+	}
+	if exclLo {
+		lo += step
+	}
+	if exclHi {
+		hi -= step
+	}
+	if integer {
+		lo, hi = math.Ceil(lo), math.Floor(hi)
+	}
+	return lo, hi, hasLo, hasHi
+}
+
+func numeric(s map[string]interface{}, full, integer bool) float64 {
+	lo, hi, hasLo, hasHi := bounds(s, integer)
+	var v float64
+	switch {
+	case full && hasHi:
+		v = hi
+	case full:
+		v = math.Max(42, lo)
+	case hasLo:
+		v = lo
+	case hasHi:
+		v = math.Min(0, hi)
+	}
+	if k, ok := number(s, "multipleOf"); ok && k > 0 {
+		if full && hasHi {
+			v = math.Floor(v/k) * k
+		} else {
+			v = math.Ceil(v/k) * k
+		}
+	}
+	return v
+}
+
+func stringValue(s map[string]interface{}, full bool) string {
+	if format, _ := s["format"].(string); formatExamples[format] != "" {
+		return formatExamples[format]
+	}
+	value := "example"
+	if pattern, ok := s["pattern"].(string); ok {
+		if matched, ok := matchingString(pattern); ok {
+			value = matched
+		}
+	}
+	minLen, hasMin := number(s, "minLength")
+	maxLen, hasMax := number(s, "maxLength")
+	n := utf8.RuneCountInString(value)
+	switch {
+	case full && hasMax:
+		n = int(maxLen)
+	case hasMin && float64(n) < minLen:
+		n = int(minLen)
+	case hasMax && float64(n) > maxLen:
+		n = int(maxLen)
+	}
+	return resize(value, min(n, maxSampleLength))
+}
+
+// resize truncates value to n runes or pads it by repeating it.
+func resize(value string, n int) string {
+	runes := []rune(value)
+	if len(runes) == 0 {
+		runes = []rune{'x'}
+	}
+	out := make([]rune, n)
+	for i := range out {
+		out[i] = runes[i%len(runes)]
+	}
+	return string(out)
+}
+
+// matchingString builds the shortest string the pattern matches, taking the
+// first alternative and the lowest character of every class.
+func matchingString(pattern string) (string, bool) {
+	re, err := syntax.Parse(pattern, syntax.Perl)
+	if err != nil {
+		return "", false
+	}
+	var b strings.Builder
+	if !writeMatch(&b, re.Simplify()) {
+		return "", false
+	}
+	if ok, _ := regexp.MatchString(pattern, b.String()); !ok {
+		return "", false
+	}
+	return b.String(), true
+}
+
+func writeMatch(b *strings.Builder, re *syntax.Regexp) bool {
+	switch re.Op {
+	case syntax.OpLiteral:
+		b.WriteString(string(re.Rune))
+	case syntax.OpCharClass:
+		if len(re.Rune) == 0 {
+			return false
+		}
+		r := re.Rune[0]
+		// Prefer a letter or digit in the class over punctuation.
+		for i := 0; i+1 < len(re.Rune); i += 2 {
+			if re.Rune[i] <= 'z' && re.Rune[i+1] >= '0' {
+				r = max(re.Rune[i], '0')
+				for _, start := range []rune{'a', 'A', '0'} {
+					if re.Rune[i] <= start && re.Rune[i+1] >= start {
+						r = start
+						break
+					}
+				}
+				break
+			}
+		}
+		b.WriteRune(r)
+	case syntax.OpAnyChar, syntax.OpAnyCharNotNL:
+		b.WriteRune('a')
+	case syntax.OpCapture:
+		return writeMatch(b, re.Sub[0])
+	case syntax.OpConcat:
+		for _, sub := range re.Sub {
+			if !writeMatch(b, sub) {
+				return false
+			}
+		}
+	case syntax.OpAlternate:
+		return writeMatch(b, re.Sub[0])
+	case syntax.OpPlus:
+		return writeMatch(b, re.Sub[0])
+	case syntax.OpRepeat:
+		for i := 0; i < re.Min; i++ {
+			if !writeMatch(b, re.Sub[0]) {
+				return false
+			}
+		}
+	case syntax.OpStar, syntax.OpQuest, syntax.OpEmptyMatch,
+		syntax.OpBeginLine, syntax.OpEndLine, syntax.OpBeginText, syntax.OpEndText,
+		syntax.OpWordBoundary, syntax.OpNoWordBoundary:
+	default:
+		return false
+	}
+	return true
+}
+
+// mutate returns copies of value, which satisfies s, that each break one
+// constraint of s or of a schema nested in it.
+func mutate(s map[string]interface{}, value interface{}, pointer string) []Sample {
+	s = flatten(s)
+	var out []Sample
+	location := pointer
+	if location == "" {
+		location = "(root)"
+	}
+	add := func(v interface{}, constraint, format string, args ...interface{}) {
+		out = append(out, Sample{
+			Name:        constraint + " at " + location,
+			Payload:     v,
+			Pointer:     pointer,
+			Constraint:  constraint,
+			Description: fmt.Sprintf(format, args...),
+		})
+	}
+
+	if types := declaredTypes(s); len(types) > 0 {
+		for _, wrong := range []interface{}{"not-a-" + types[0], 12345.5, true, []interface{}{}} {
+			if !allowsType(types, wrong) {
+				add(wrong, "type", "value is not of type %s", strings.Join(types, " or "))
+				break
+			}
+		}
+	}
+	if enum, ok := s["enum"].([]interface{}); ok && len(enum) > 0 {
+		for _, candidate := range []interface{}{"NOT_AN_ENUM_VALUE", -987654321.0} {
+			if !containsValue(enum, candidate) && (declaredTypes(s) == nil || allowsType(declaredTypes(s), candidate)) {
+				add(candidate, "enum", "value is not one of the %d allowed values", len(enum))
+				break
+			}
+		}
+	}
+	if c, ok := s["const"]; ok {
+		if c == "NOT_THE_CONSTANT" {
+			add("NOT_THE_CONSTANT_EITHER", "const", "value differs from the constant")
+		} else {
+			add("NOT_THE_CONSTANT", "const", "value differs from the constant")
+		}
+	}
+
+	switch v := value.(type) {
+	case map[string]interface{}:
+		for _, name := range requiredNames(s) {
+			if _, ok := v[name]; ok {
+				c := copyObject(v)
+				delete(c, name)
+				add(c, "required", "required property %q is missing", name)
+				out[len(out)-1].Name = fmt.Sprintf("required %q at %s", name, location)
+			}
+		}
+		if extra, ok := s["additionalProperties"].(bool); ok && !extra {
+			c := copyObject(v)
+			c["unexpectedProperty"] = "unexpected"
+			add(c, "additionalProperties", "property %q is not allowed", "unexpectedProperty")
+		}
+		props, _ := s["properties"].(map[string]interface{})
+		for _, name := range sortedKeys(v) {
+			ps, ok := props[name].(map[string]interface{})
+			if !ok {
+				continue
+			}
+			for _, m := range mutate(ps, v[name], pointer+"/"+escapeToken(name)) {
+				c := copyObject(v)
+				c[name] = m.Payload
+				m.Payload = c
+				out = append(out, m)
+			}
+		}
+	case []interface{}:
+		items, _ := s["items"].(map[string]interface{})
+		if n, ok := number(s, "minItems"); ok && n > 0 && len(v) >= int(n) {
+			add(append([]interface{}{}, v[:int(n)-1]...), "minItems", "array has fewer than %v items", n)
+		}
+		if n, ok := number(s, "maxItems"); ok && n < maxSampleItems {
+			longer := append([]interface{}{}, v...)
+			for len(longer) <= int(n) {
+				longer = append(longer, generate(items, true))
+			}
+			add(longer, "maxItems", "array has more than %v items", n)
+		}
+		if unique, _ := s["uniqueItems"].(bool); unique && len(v) > 0 {
+			if n, ok := number(s, "maxItems"); !ok || int(n) > len(v) {
+				add(append(append([]interface{}{}, v...), v[0]), "uniqueItems", "array contains a duplicate item")
+			}
+		}
+		if len(v) > 0 && items != nil {
+			for _, m := range mutate(items, v[0], pointer+"/0") {
+				c := append([]interface{}{}, v...)
+				c[0] = m.Payload
+				m.Payload = c
+				out = append(out, m)
+			}
+		}
+	case string:
+		if n, ok := number(s, "minLength"); ok && n > 0 && n <= maxSampleLength {
+			add(resize(v, int(n)-1), "minLength", "string is shorter than %v characters", n)
+		}
+		if n, ok := number(s, "maxLength"); ok && n < maxSampleLength {
+			add(resize(v+"x", int(n)+1), "maxLength", "string is longer than %v characters", n)
+		}
+		if pattern, ok := s["pattern"].(string); ok {
+			if re, err := regexp.Compile(pattern); err == nil {
+				for _, candidate := range []string{"!", "", " ", "not matching", "0"} {
+					if !re.MatchString(candidate) {
+						add(candidate, "pattern", "string does not match %s", pattern)
+						break
+					}
+				}
+			}
+		}
+		if format, _ := s["format"].(string); formatExamples[format] != "" && format != "byte" {
+			add("not-a-"+format, "format", "string is not a valid %s", format)
+		}
+	case float64:
+		integer := schemaType(s) == "integer"
+		if lo, ok := number(s, "minimum"); ok {
+			add(lo-1, "minimum", "value is below the minimum %v", lo)
+		}
+		if hi, ok := number(s, "maximum"); ok {
+			add(hi+1, "maximum", "value is above the maximum %v", hi)
+		}
+		if lo, ok := number(s, "exclusiveMinimum"); ok {
+			add(lo, "exclusiveMinimum", "value is not above %v", lo)
+		}
+		if hi, ok := number(s, "exclusiveMaximum"); ok {
+			add(hi, "exclusiveMaximum", "value is not below %v", hi)
+		}
+		if k, ok := number(s, "multipleOf"); ok && k > 0 && !(integer && k <= 1) {
+			add(v+k/2, "multipleOf", "value is not a multiple of %v", k)
+		}
+	}
+	return out
+}
+
+func allowsType(types []string, v interface{}) bool {
+	for _, t := range types {
+		switch t {
+		case "string":
+			if _, ok := v.(string); ok {
+				return true
+			}
+		case "number":
+			if _, ok := v.(float64); ok {
+				return true
+			}
+		case "integer":
+			if f, ok := v.(float64); ok && f == math.Trunc(f) {
+				return true
+			}
+		case "boolean":
+			if _, ok := v.(bool); ok {
+				return true
+			}
+		case "array":
+			if _, ok := v.([]interface{}); ok {
+				return true
+			}
+		case "object":
+			if _, ok := v.(map[string]interface{}); ok {
+				return true
+			}
+		case "null":
+			if v == nil {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func containsValue(values []interface{}, v interface{}) bool {
+	for _, candidate := range values {
+		if reflect.DeepEqual(candidate, v) {
+			return true
+		}
+	}
+	return false
+}
+
+func requiredNames(s map[string]interface{}) []string {
+	list, _ := s["required"].([]interface{})
+	var names []string
+	seen := make(map[string]bool, len(list))
+	for _, r := range list {
+		if name, ok := r.(string); ok && !seen[name] {
+			seen[name] = true
+			names = append(names, name)
+		}
+	}
+	return names
+}
+
+func number(s map[string]interface{}, key string) (float64, bool) {
+	switch n := s[key].(type) {
+	case float64:
+		return n, true
+	case int:
+		return float64(n), true
+	case int64:
+		return float64(n), true
+	}
+	return 0, false
+}
+
+func sortedKeys(m map[string]interface{}) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+func copyObject(m map[string]interface{}) map[string]interface{} {
+	c := make(map[string]interface{}, len(m))
+	for k, v := range m {
+		c[k] = v
+	}
+	return c
+}
+
+func escapeToken(token string) string {
+	return strings.NewReplacer("~", "~0", "/", "~1").Replace(token)
+}
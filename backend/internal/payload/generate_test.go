@@ -0,0 +1,116 @@
+package payload
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+)
+
+const orderSchema = `{
+	"type": "object",
+	"required": ["id", "status", "items"],
+	"additionalProperties": false,
+	"properties": {
+		"id": {"type": "string", "format": "uuid"},
+		"status": {"type": "string", "enum": ["OPEN", "PAID"]},
+		"reference": {"type": "string", "pattern": "^ORD-[0-9]{4}$"},
+		"note": {"type": "string", "minLength": 2, "maxLength": 20, "nullable": true},
+		"total": {"type": "number", "minimum": 0, "exclusiveMaximum": 1000},
+		"items": {
+			"type": "array", "minItems": 1, "maxItems": 5,
+			"items": {
+				"type": "object", "required": ["sku", "qty"],
+				"properties": {"sku": {"type": "string"}, "qty": {"type": "integer", "minimum": 1, "maximum": 99}}
+			}
+		}
+	}
+}`
+
+func TestGenerate(t *testing.T) {
+	schema := decode(t, orderSchema).(map[string]interface{})
+	ex, err := Generate(schema)
+	if err != nil {
+		t.Fatalf("generate failed: %v", err)
+	}
+	v := compile(t, orderSchema)
+
+	if len(ex.Valid) != 2 || ex.Valid[0].Name != "minimal" || ex.Valid[1].Name != "full" {
+		t.Fatalf("expected minimal and full samples, got %+v", ex.Valid)
+	}
+	full := ex.Valid[1].Payload.(map[string]interface{})
+	if full["reference"] != "ORD-0000" || full["total"] != 999.0 || len(full["note"].(string)) != 20 {
+		t.Errorf("expected the full sample at the upper bounds, got %v", full)
+	}
+	for _, s := range ex.Valid {
+		if r := v.Validate(s.Payload); !r.Valid {
+			t.Errorf("%s: expected a valid sample, got %+v", s.Name, r.Errors)
+		}
+	}
+
+	labels := make(map[string]bool)
+	for _, s := range ex.Invalid {
+		labels[s.Name] = true
+		if v.Validate(s.Payload).Valid {
+			t.Errorf("%s: expected an invalid sample", s.Name)
+		}
+	}
+	for _, want := range []string{
+		`required "status" at (root)`, "additionalProperties at (root)", "enum at /status", "format at /id",
+		"pattern at /reference", "maxLength at /note", "exclusiveMaximum at /total", "minItems at /items",
+		"maxItems at /items", `required "qty" at /items/0`, "maximum at /items/0/qty", "type at /items/0/qty",
+	} {
+		if !labels[want] {
+			t.Errorf("missing invalid sample %q in %v", want, labels)
+		}
+	}
+
+	again, _ := Generate(decode(t, orderSchema).(map[string]interface{}))
+	a, _ := json.Marshal(ex)
+	b, _ := json.Marshal(again)
+	if !reflect.DeepEqual(a, b) {
+		t.Error("expected generation to be deterministic")
+	}
+}
+
+func TestGenerate_LargeBounds(t *testing.T) {
+	schema := decode(t, `{
+		"type": "object",
+		"required": ["name"],
+		"properties": {
+			"name": {"type": "string", "maxLength": 2147483647},
+			"tags": {"type": "array", "items": {"type": "string"}, "minItems": 1, "maxItems": 2147483647}
+		}
+	}`).(map[string]interface{})
+	ex, err := Generate(schema)
+	if err != nil {
+		t.Fatalf("generate failed: %v", err)
+	}
+	if len(ex.Valid) != 2 {
+		t.Fatalf("expected minimal and full samples, got %+v", ex.Valid)
+	}
+	full := ex.Valid[1].Payload.(map[string]interface{})
+	if n := len(full["name"].(string)); n != maxSampleLength {
+		t.Errorf("expected the full name capped at %d characters, got %d", maxSampleLength, n)
+	}
+	for _, s := range ex.Invalid {
+		if s.Constraint == "maxLength" || s.Constraint == "maxItems" {
+			t.Errorf("expected no sample past a bound above the limits, got %q", s.Name)
+		}
+	}
+}
+
+func TestGenerate_Composition(t *testing.T) {
+	ex, err := Generate(decode(t, `{
+		"allOf": [
+			{"type": "object", "required": ["kind"], "properties": {"kind": {"const": "card"}}},
+			{"required": ["last4"], "properties": {"last4": {"type": "string", "pattern": "^[0-9]{4}$"}}}
+		]
+	}`).(map[string]interface{}))
+	if err != nil {
+		t.Fatalf("generate failed: %v", err)
+	}
+	want := map[string]interface{}{"kind": "card", "last4": "0000"}
+	if len(ex.Valid) != 1 || !reflect.DeepEqual(ex.Valid[0].Payload, want) {
+		t.Errorf("expected a single sample %v, got %+v", want, ex.Valid)
+	}
+}
@@ -0,0 +1,142 @@
+// Package payload checks JSON payloads against contract schemas, reporting
+// violations located by JSON Pointer, and generates sample payloads from them.
+package payload
+
+import (
+	"sort"
+	"strings"
+
+	"github.com/xeipuuv/gojsonschema"
+)
+
+// Error is one schema violation. Pointer locates the offending value in the
+// payload ("" is the whole payload); Field is the same location in
+// gojsonschema's dotted notation. Required-property errors point at the
+// object missing the property and name it in Details.
+type Error struct {
+	Pointer string                 `json:"pointer"`
+	Field   string                 `json:"field"`
+	Type    string                 `json:"type"`
+	Message string                 `json:"message"`
+	Details map[string]interface{} `json:"details,omitempty"`
+}
+
+// Result is the outcome for one payload of a batch.
+type Result struct {
+	Index  int     `json:"index"`
+	Valid  bool    `json:"valid"`
+	Errors []Error `json:"errors"`
+}
+
+// Validator checks payloads against one compiled schema.
+type Validator struct {
+	schema *gojsonschema.Schema
+}
+
+// Compile prepares a contract schema for validation. OpenAPI dialect is
+// accepted, see ToJSONSchema.
+func Compile(schema map[string]interface{}) (*Validator, error) {
+	compiled, err := gojsonschema.NewSchema(gojsonschema.NewGoLoader(ToJSONSchema(schema)))
+	if err != nil {
+		return nil, err
+	}
+	return &Validator{schema: compiled}, nil
+}
+
+// Validate checks a decoded JSON value. Errors are ordered by pointer.
+func (v *Validator) Validate(value interface{}) Result {
+	result := Result{Valid: true, Errors: []Error{}}
+	res, err := v.schema.Validate(gojsonschema.NewGoLoader(value))
+	if err != nil {
+		result.Valid = false
+		result.Errors = append(result.Errors, Error{Field: "(root)", Type: "invalid_json", Message: err.Error()})
+		return result
+	}
+	for _, e := range res.Errors() {
+		result.Errors = append(result.Errors, Error{
+			Pointer: Pointer(e.Context()),
+			Field:   e.Field(),
+			Type:    e.Type(),
+			Message: e.Description(),
+			Details: details(e.Details()),
+		})
+	}
+	sort.SliceStable(result.Errors, func(i, j int) bool { return result.Errors[i].Pointer < result.Errors[j].Pointer })
+	result.Valid = res.Valid()
+	return result
+}
+
+// ValidateAll checks a batch, numbering results by position.
+func (v *Validator) ValidateAll(values []interface{}) []Result {
+	results := make([]Result, len(values))
+	for i, value := range values {
+		results[i] = v.Validate(value)
+		results[i].Index = i
+	}
+	return results
+}
+
+// Pointer renders a gojsonschema context as an RFC 6901 JSON Pointer.
+func Pointer(ctx *gojsonschema.JsonContext) string {
+	if ctx == nil {
+		return ""
+	}
+	// Object keys containing NUL are not expected in contract payloads, which
+	// makes it a safe separator for splitting the context back into tokens.
+	tokens := strings.Split(ctx.String("\x00"), "\x00")
+	var b strings.Builder
+	for _, token := range tokens[1:] {
+		b.WriteByte('/')
+		b.WriteString(escapeToken(token))
+	}
+	return b.String()
+}
+
+// details drops the context and field entries gojsonschema repeats from the
+// error itself.
+func details(d gojsonschema.ErrorDetails) map[string]interface{} {
+	out := make(map[string]interface{}, len(d))
+	for k, v := range d {
+		if k == "context" || k == "field" {
+			continue
+		}
+		out[k] = v
+	}
+	if len(out) == 0 {
+		return nil
+	}
+	return out
+}
+
+// ToJSONSchema adapts OpenAPI schema dialect for the JSON Schema validator:
+// "nullable: true" becomes a type union with null, and circular $refs left by
+// the resolver accept any value.
+func ToJSONSchema(node interface{}) interface{} {
+	switch v := node.(type) {
+	case map[string]interface{}:
+		if _, ok := v["$ref"].(string); ok {
+			return map[string]interface{}{}
+		}
+		out := make(map[string]interface{}, len(v))
+		for k, val := range v {
+			if _, flag := val.(bool); flag && k == "nullable" {
+				continue
+			}
+			out[k] = ToJSONSchema(val)
+		}
+		if nullable, _ := v["nullable"].(bool); nullable {
+			if t, ok := v["type"].(string); ok {
+				out["type"] = []interface{}{t, "null"}
+			}
+		}
+		return out
+	case []interface{}:
+		out := make([]interface{}, len(v))
+		for i, val := range v {
+			out[i] = ToJSONSchema(val)
+		}
+		return out
+	default:
+		return v
+	}
+}
@@ -0,0 +1,81 @@
+package payload
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func decode(t *testing.T, s string) interface{} {
+	t.Helper()
+	var v interface{}
+	if err := json.Unmarshal([]byte(s), &v); err != nil {
+		t.Fatalf("bad fixture %s: %v", s, err)
+	}
+	return v
+}
+
+func compile(t *testing.T, schema string) *Validator {
+	t.Helper()
+	v, err := Compile(decode(t, schema).(map[string]interface{}))
+	if err != nil {
+		t.Fatalf("compile failed: %v", err)
+	}
+	return v
+}
+
+func TestValidate_ReportsPointers(t *testing.T) {
+	v := compile(t, `{
+		"type": "object",
+		"required": ["id"],
+		"properties": {
+			"id": {"type": "string"},
+			"a/b": {"type": "integer"},
+			"items": {"type": "array", "items": {"type": "object", "properties": {"qty": {"type": "integer", "minimum": 1}}}},
+			"note": {"type": "string", "nullable": true}
+		}
+	}`)
+
+	r := v.Validate(decode(t, `{"a/b": "x", "items": [{"qty": 1}, {"qty": 0}], "note": null}`))
+	if r.Valid {
+		t.Fatal("expected the payload to be invalid")
+	}
+	want := []struct{ pointer, field, kind string }{
+		{"", "(root)", "required"},
+		{"/a~1b", "a/b", "invalid_type"},
+		{"/items/1/qty", "items.1.qty", "number_gte"},
+	}
+	if len(r.Errors) != len(want) {
+		t.Fatalf("expected %d errors, got %+v", len(want), r.Errors)
+	}
+	for i, w := range want {
+		e := r.Errors[i]
+		if e.Pointer != w.pointer || e.Field != w.field || e.Type != w.kind {
+			t.Errorf("error %d: got %q %q %s, want %q %q %s", i, e.Pointer, e.Field, e.Type, w.pointer, w.field, w.kind)
+		}
+	}
+	if r.Errors[0].Details["property"] != "id" {
+		t.Errorf("expected the required error to name the missing property, got %v", r.Errors[0].Details)
+	}
+}
+
+func TestValidateAll(t *testing.T) {
+	v := compile(t, `{"type": "integer"}`)
+	results := v.ValidateAll([]interface{}{decode(t, `1`), decode(t, `"1"`), nil})
+	if len(results) != 3 {
+		t.Fatalf("expected 3 results, got %d", len(results))
+	}
+	for i, valid := range []bool{true, false, false} {
+		if results[i].Index != i || results[i].Valid != valid {
+			t.Errorf("result %d: got %+v, want valid=%v", i, results[i], valid)
+		}
+	}
+	if results[0].Errors == nil {
+		t.Error("valid results should carry an empty error list")
+	}
+}
+
+func TestCompile_RejectsInvalidSchema(t *testing.T) {
+	if _, err := Compile(map[string]interface{}{"type": "integr"}); err == nil {
+		t.Error("expected an unknown type to be rejected")
+	}
+}
@@ -0,0 +1,475 @@
+// Package rules compiles project validation rules into executable checks and
+// evaluates them against sample values and decoded JSON payloads.
+//
+// Every rule type reads its own settings from the rule config:
+//
+//	REGEX   {"pattern": "^[a-z]+$"}
+//	RANGE   {"min": 0, "max": 100, "exclusive_min": false, "exclusive_max": false, "length": false}
+//	ENUM    {"values": ["LOW", "HIGH"], "case_insensitive": false}
+//	CUSTOM  {"expression": "value.amount > 0 && value.currency in ['EUR', 'USD']"}
+//
+// and may bind the rule to payload locations and set the failure message:
+//
+//	"paths":     JSON Pointers into a payload; a "*" token matches every array
+//	             element or object member, e.g. "/items/*/sku"
+//	"variables": variable names, each bound to the top-level field of that name
+//	"message":   replaces the generated failure message
+package rules
+
+import (
+	"fmt"
+	"math"
+	"reflect"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/SpecForgeVC/SpecForge/internal/domain"
+	"github.com/SpecForgeVC/SpecForge/internal/domain/governance"
+	"github.com/google/uuid"
+)
+
+const (
+	TypeRegex  = "REGEX"
+	TypeRange  = "RANGE"
+	TypeEnum   = "ENUM"
+	TypeCustom = "CUSTOM"
+)
+
+// Types lists the rule types the engine can execute.
+var Types = []string{TypeRegex, TypeRange, TypeEnum, TypeCustom}
+
+// Result is the outcome of one rule at one location. Path is a JSON Pointer
+// into the evaluated payload, empty for the value itself.
+type Result struct {
+	RuleID   uuid.UUID `json:"rule_id"`
+	Rule     string    `json:"rule"`
+	RuleType string    `json:"rule_type"`
+	Path     string    `json:"path"`
+	Passed   bool      `json:"passed"`
+	Message  string    `json:"message,omitempty"`
+}
+
+// Check is a compiled rule.
+type Check struct {
+	Rule domain.ValidationRule
+	// Paths are the JSON Pointers the rule is bound to, variables included.
+	Paths   []string
+	message string
+	test    func(value interface{}) (failure string)
+}
+
+// Compile checks the rule's config and builds its check. The error describes
+// the first problem with the config.
+func Compile(rule domain.ValidationRule) (*Check, error) {
+	cfg := config(rule.RuleConfig)
+	c := &Check{Rule: rule}
+	var err error
+	switch strings.ToUpper(rule.RuleType) {
+	case TypeRegex:
+		c.test, err = compileRegex(cfg)
+	case TypeRange:
+		c.test, err = compileRange(cfg)
+	case TypeEnum:
+		c.test, err = compileEnum(cfg)
+	case TypeCustom:
+		c.test, err = compileCustom(cfg)
+	default:
+		return nil, fmt.Errorf("unknown rule type %q (expected one of %s)", rule.RuleType, strings.Join(Types, ", "))
+	}
+	if err != nil {
+		return nil, err
+	}
+	if c.message, err = cfg.string("message", false); err != nil {
+		return nil, err
+	}
+	paths, err := cfg.strings("paths")
+	if err != nil {
+		return nil, err
+	}
+	for _, p := range paths {
+		if p != "" && !strings.HasPrefix(p, "/") {
+			return nil, fmt.Errorf("paths: %q is not a JSON Pointer", p)
+		}
+		c.Paths = append(c.Paths, p)
+	}
+	variables, err := cfg.strings("variables")
+	if err != nil {
+		return nil, err
+	}
+	for _, v := range variables {
+		if v == "" {
+			return nil, fmt.Errorf("variables: names must not be empty")
+		}
+		c.Paths = append(c.Paths, "/"+escape(v))
+	}
+	return c, nil
+}
+
+// Test evaluates the rule against value itself, ignoring its bindings.
+func (c *Check) Test(value interface{}) Result {
+	return c.result("", value)
+}
+
+// Evaluate runs the rule at every bound location present in payload. Bound
+// locations the payload does not contain are skipped: whether a field must be
+// present is for the contract's schema to say.
+func (c *Check) Evaluate(payload interface{}) []Result {
+	var results []Result
+	for _, path := range c.Paths {
+		for _, m := range resolve(payload, path) {
+			results = append(results, c.result(m.path, m.value))
+		}
+	}
+	return results
+}
+
+func (c *Check) result(path string, value interface{}) Result {
+	r := Result{RuleID: c.Rule.ID, Rule: c.Rule.Name, RuleType: strings.ToUpper(c.Rule.RuleType), Path: path, Passed: true}
+	if failure := c.test(value); failure != "" {
+		r.Passed = false
+		r.Message = failure
+		if c.message != "" {
+			r.Message = c.message
+		}
+	}
+	return r
+}
+
+// --- Rule types ----------------------------------------------------------
+
+func compileRegex(cfg config) (func(interface{}) string, error) {
+	pattern, err := cfg.string("pattern", true)
+	if err != nil {
+		return nil, err
+	}
+	re, err := regexp.Compile(pattern)
+	if err != nil {
+		return nil, fmt.Errorf("pattern: %v", err)
+	}
+	return func(v interface{}) string {
+		s, ok := v.(string)
+		if !ok {
+			return fmt.Sprintf("expected a string, got %s", typeName(v))
+		}
+		if !re.MatchString(s) {
+			return fmt.Sprintf("%q does not match %s", s, pattern)
+		}
+		return ""
+	}, nil
+}
+
+func compileRange(cfg config) (func(interface{}) string, error) {
+	minimum, hasMin, err := cfg.number("min")
+	if err != nil {
+		return nil, err
+	}
+	maximum, hasMax, err := cfg.number("max")
+	if err != nil {
+		return nil, err
+	}
+	exclusiveMin, err := cfg.bool("exclusive_min")
+	if err != nil {
+		return nil, err
+	}
+	exclusiveMax, err := cfg.bool("exclusive_max")
+	if err != nil {
+		return nil, err
+	}
+	length, err := cfg.bool("length")
+	if err != nil {
+		return nil, err
+	}
+	if !hasMin && !hasMax {
+		return nil, fmt.Errorf("at least one of min and max is required")
+	}
+	if hasMin && hasMax && (minimum > maximum || (minimum == maximum && (exclusiveMin || exclusiveMax))) {
+		return nil, fmt.Errorf("min %s must be below max %s", formatNumber(minimum), formatNumber(maximum))
+	}
+
+	return func(v interface{}) string {
+		what := "value"
+		var n float64
+		if length {
+			what = "length"
+			switch v := v.(type) {
+			case string:
+				n = float64(utf8.RuneCountInString(v))
+			case []interface{}:
+				n = float64(len(v))
+			case map[string]interface{}:
+				n = float64(len(v))
+			default:
+				return fmt.Sprintf("expected a string, array or object, got %s", typeName(v))
+			}
+		} else {
+			f, ok := v.(float64)
+			if !ok {
+				return fmt.Sprintf("expected a number, got %s", typeName(v))
+			}
+			n = f
+		}
+		switch {
+		case hasMin && (n < minimum || (exclusiveMin && n == minimum)):
+			return fmt.Sprintf("%s %s is below the minimum %s", what, formatNumber(n), bound(minimum, exclusiveMin))
+		case hasMax && (n > maximum || (exclusiveMax && n == maximum)):
+			return fmt.Sprintf("%s %s is above the maximum %s", what, formatNumber(n), bound(maximum, exclusiveMax))
+		}
+		return ""
+	}, nil
+}
+
+func compileEnum(cfg config) (func(interface{}) string, error) {
+	raw, ok := cfg["values"]
+	if !ok {
+		return nil, fmt.Errorf("values is required")
+	}
+	values, ok := raw.([]interface{})
+	if !ok {
+		return nil, fmt.Errorf("values must be an array")
+	}
+	if len(values) == 0 {
+		return nil, fmt.Errorf("values must not be empty")
+	}
+	caseInsensitive, err := cfg.bool("case_insensitive")
+	if err != nil {
+		return nil, err
+	}
+	allowed := make([]string, len(values))
+	for i, v := range values {
+		allowed[i] = render(v)
+	}
+
+	return func(v interface{}) string {
+		for _, want := range values {
+			if reflect.DeepEqual(v, want) {
+				return ""
+			}
+			if caseInsensitive {
+				s, ok1 := v.(string)
+				w, ok2 := want.(string)
+				if ok1 && ok2 && strings.EqualFold(s, w) {
+					return ""
+				}
+			}
+		}
+		return fmt.Sprintf("%s is not one of %s", render(v), strings.Join(allowed, ", "))
+	}, nil
+}
+
+// compileCustom compiles the expression in the governance policy language.
+// It reads the value as `value`, its members as `value.<name>` (nested
+// members as `value.<name>.<name>`) and the length of a string, array or
+// object as `length`.
+func compileCustom(cfg config) (func(interface{}) string, error) {
+	src, err := cfg.string("expression", true)
+	if err != nil {
+		return nil, err
+	}
+	expr, err := governance.Compile(src)
+	if err != nil {
+		return nil, fmt.Errorf("expression: %v", err)
+	}
+	for _, name := range expr.Facts() {
+		if name != "value" && name != "length" && !strings.HasPrefix(name, "value.") {
+			return nil, fmt.Errorf("expression: unknown name %q (use value, value.<field> or length)", name)
+		}
+	}
+
+	return func(v interface{}) string {
+		facts := governance.Facts{"value": v}
+		switch v := v.(type) {
+		case string:
+			facts["length"] = utf8.RuneCountInString(v)
+		case []interface{}:
+			facts["length"] = len(v)
+		case map[string]interface{}:
+			facts["length"] = len(v)
+			flatten("value", v, facts)
+		}
+		ok, err := expr.EvalBool(facts)
+		if err != nil {
+			return fmt.Sprintf("%s could not be evaluated: %v", src, err)
+		}
+		if !ok {
+			return fmt.Sprintf("%s does not hold", src)
+		}
+		return ""
+	}, nil
+}
+
+func flatten(prefix string, obj map[string]interface{}, facts governance.Facts) {
+	for k, v := range obj {
+		name := prefix + "." + k
+		facts[name] = v
+		if child, ok := v.(map[string]interface{}); ok {
+			flatten(name, child, facts)
+		}
+	}
+}
+
+// --- Payload locations ---------------------------------------------------
+
+type match struct {
+	path  string
+	value interface{}
+}
+
+// resolve returns the values at pointer in doc, expanding "*" tokens.
+func resolve(doc interface{}, pointer string) []match {
+	if pointer == "" {
+		return []match{{"", doc}}
+	}
+	tokens := strings.Split(pointer[1:], "/")
+	matches := []match{{"", doc}}
+	for _, raw := range tokens {
+		tok := unescape(raw)
+		var next []match
+		for _, m := range matches {
+			switch node := m.value.(type) {
+			case map[string]interface{}:
+				if raw == "*" {
+					keys := make([]string, 0, len(node))
+					for k := range node {
+						keys = append(keys, k)
+					}
+					sort.Strings(keys)
+					for _, k := range keys {
+						next = append(next, match{m.path + "/" + escape(k), node[k]})
+					}
+				} else if v, ok := node[tok]; ok {
+					next = append(next, match{m.path + "/" + raw, v})
+				}
+			case []interface{}:
+				if raw == "*" {
+					for i, v := range node {
+						next = append(next, match{m.path + "/" + strconv.Itoa(i), v})
+					}
+				} else if i, err := strconv.Atoi(tok); err == nil && i >= 0 && i < len(node) {
+					next = append(next, match{m.path + "/" + raw, node[i]})
+				}
+			}
+		}
+		matches = next
+	}
+	return matches
+}
+
+func escape(token string) string {
+	return strings.ReplaceAll(strings.ReplaceAll(token, "~", "~0"), "/", "~1")
+}
+
+func unescape(token string) string {
+	return strings.ReplaceAll(strings.ReplaceAll(token, "~1", "/"), "~0", "~")
+}
+
+// --- Config access -------------------------------------------------------
+
+type config map[string]interface{}
+
+func (c config) string(key string, required bool) (string, error) {
+	v, ok := c[key]
+	if !ok || v == nil {
+		if required {
+			return "", fmt.Errorf("%s is required", key)
+		}
+		return "", nil
+	}
+	s, ok := v.(string)
+	if !ok {
+		return "", fmt.Errorf("%s must be a string", key)
+	}
+	if required && strings.TrimSpace(s) == "" {
+		return "", fmt.Errorf("%s must not be empty", key)
+	}
+	return s, nil
+}
+
+func (c config) strings(key string) ([]string, error) {
+	v, ok := c[key]
+	if !ok || v == nil {
+		return nil, nil
+	}
+	list, ok := v.([]interface{})
+	if !ok {
+		return nil, fmt.Errorf("%s must be an array of strings", key)
+	}
+	out := make([]string, len(list))
+	for i, item := range list {
+		s, ok := item.(string)
+		if !ok {
+			return nil, fmt.Errorf("%s must be an array of strings", key)
+		}
+		out[i] = s
+	}
+	return out, nil
+}
+
+func (c config) number(key string) (float64, bool, error) {
+	v, ok := c[key]
+	if !ok || v == nil {
+		return 0, false, nil
+	}
+	f, ok := v.(float64)
+	if !ok || math.IsNaN(f) || math.IsInf(f, 0) {
+		return 0, false, fmt.Errorf("%s must be a number", key)
+	}
+	return f, true, nil
+}
+
+func (c config) bool(key string) (bool, error) {
+	v, ok := c[key]
+	if !ok || v == nil {
+		return false, nil
+	}
+	b, ok := v.(bool)
+	if !ok {
+		return false, fmt.Errorf("%s must be a boolean", key)
+	}
+	return b, nil
+}
+
+// --- Formatting ----------------------------------------------------------
+
+func bound(n float64, exclusive bool) string {
+	if exclusive {
+		return formatNumber(n) + " (exclusive)"
+	}
+	return formatNumber(n)
+}
+
+func formatNumber(f float64) string {
+	return strconv.FormatFloat(f, 'f', -1, 64)
+}
+
+func render(v interface{}) string {
+	switch v := v.(type) {
+	case string:
+		return strconv.Quote(v)
+	case float64:
+		return formatNumber(v)
+	case nil:
+		return "null"
+	}
+	return fmt.Sprint(v)
+}
+
+func typeName(v interface{}) string {
+	switch v.(type) {
+	case nil:
+		return "null"
+	case bool:
+		return "boolean"
+	case float64:
+		return "number"
+	case string:
+		return "string"
+	case []interface{}:
+		return "array"
+	case map[string]interface{}:
+		return "object"
+	}
+	return fmt.Sprintf("%T", v)
+}
@@ -0,0 +1,127 @@
+package rules
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/SpecForgeVC/SpecForge/internal/domain"
+)
+
+func rule(t *testing.T, ruleType, cfg string) domain.ValidationRule {
+	t.Helper()
+	var config map[string]interface{}
+	if err := json.Unmarshal([]byte(cfg), &config); err != nil {
+		t.Fatalf("bad fixture %s: %v", cfg, err)
+	}
+	return domain.ValidationRule{Name: ruleType + " rule", RuleType: ruleType, RuleConfig: config}
+}
+
+func value(t *testing.T, s string) interface{} {
+	t.Helper()
+	var v interface{}
+	if err := json.Unmarshal([]byte(s), &v); err != nil {
+		t.Fatalf("bad fixture %s: %v", s, err)
+	}
+	return v
+}
+
+func TestCompile_RejectsInvalidConfigs(t *testing.T) {
+	cases := map[string]domain.ValidationRule{
+		"unknown type":       rule(t, "LUHN", `{}`),
+		"missing pattern":    rule(t, "REGEX", `{}`),
+		"bad regex":          rule(t, "REGEX", `{"pattern": "([a-z"}`),
+		"no bounds":          rule(t, "RANGE", `{}`),
+		"min above max":      rule(t, "RANGE", `{"min": 10, "max": 1}`),
+		"empty exclusive":    rule(t, "RANGE", `{"min": 1, "max": 1, "exclusive_max": true}`),
+		"non-numeric bound":  rule(t, "RANGE", `{"min": "1"}`),
+		"empty enum":         rule(t, "ENUM", `{"values": []}`),
+		"enum not a list":    rule(t, "ENUM", `{"values": "A,B"}`),
+		"bad expression":     rule(t, "CUSTOM", `{"expression": "value >"}`),
+		"unknown name":       rule(t, "CUSTOM", `{"expression": "score.overall > 1"}`),
+		"relative path":      rule(t, "REGEX", `{"pattern": "x", "paths": ["email"]}`),
+		"non-string message": rule(t, "ENUM", `{"values": [1], "message": 5}`),
+	}
+	for name, r := range cases {
+		if _, err := Compile(r); err == nil {
+			t.Errorf("%s: expected Compile to fail", name)
+		}
+	}
+}
+
+func TestCheck_Test(t *testing.T) {
+	cases := []struct {
+		ruleType, cfg, value string
+		passed               bool
+		message              string
+	}{
+		{"REGEX", `{"pattern": "^v[0-9]+$"}`, `"v12"`, true, ""},
+		{"REGEX", `{"pattern": "^v[0-9]+$"}`, `"12"`, false, `"12" does not match ^v[0-9]+$`},
+		{"REGEX", `{"pattern": "^v"}`, `12`, false, "expected a string, got number"},
+		{"RANGE", `{"min": 0, "max": 100}`, `100`, true, ""},
+		{"RANGE", `{"min": 0, "max": 100, "exclusive_max": true}`, `100`, false, "value 100 is above the maximum 100 (exclusive)"},
+		{"RANGE", `{"min": 0.5}`, `0.25`, false, "value 0.25 is below the minimum 0.5"},
+		{"RANGE", `{"max": 3, "length": true}`, `"héllo"`, false, "length 5 is above the maximum 3"},
+		{"ENUM", `{"values": ["LOW", "HIGH", 3]}`, `3`, true, ""},
+		{"ENUM", `{"values": ["LOW", "HIGH"], "case_insensitive": true}`, `"low"`, true, ""},
+		{"ENUM", `{"values": ["LOW", "HIGH"]}`, `"low"`, false, `"low" is not one of "LOW", "HIGH"`},
+		{"CUSTOM", `{"expression": "value.amount > 0 && value.currency in ['EUR', 'USD']"}`, `{"amount": 5, "currency": "EUR"}`, true, ""},
+		{"CUSTOM", `{"expression": "length <= 2", "message": "at most two tags"}`, `["a", "b", "c"]`, false, "at most two tags"},
+		{"CUSTOM", `{"expression": "value.amount > 0"}`, `{}`, false, `value.amount > 0 could not be evaluated: unknown fact "value.amount"`},
+	}
+	for _, tc := range cases {
+		check, err := Compile(rule(t, tc.ruleType, tc.cfg))
+		if err != nil {
+			t.Fatalf("%s %s: compile failed: %v", tc.ruleType, tc.cfg, err)
+		}
+		got := check.Test(value(t, tc.value))
+		if got.Passed != tc.passed || got.Message != tc.message {
+			t.Errorf("%s %s on %s: got passed=%v %q, want passed=%v %q", tc.ruleType, tc.cfg, tc.value, got.Passed, got.Message, tc.passed, tc.message)
+		}
+	}
+}
+
+func TestCheck_Evaluate(t *testing.T) {
+	check, err := Compile(rule(t, "REGEX", `{"pattern": "^[A-Z]{3}-[0-9]+$", "paths": ["/items/*/sku", "/missing"], "variables": ["order_id"]}`))
+	if err != nil {
+		t.Fatalf("compile failed: %v", err)
+	}
+	payload := value(t, `{"order_id": "ORD-1", "items": [{"sku": "ABC-1"}, {"sku": "abc-2"}, {"name": "no sku"}]}`)
+
+	var got []string
+	for _, r := range check.Evaluate(payload) {
+		got = append(got, r.Path)
+		if r.Passed != (r.Path != "/items/1/sku") {
+			t.Errorf("%s: unexpected outcome %+v", r.Path, r)
+		}
+	}
+	want := []string{"/items/0/sku", "/items/1/sku", "/order_id"}
+	if len(got) != len(want) {
+		t.Fatalf("expected results at %v, got %v", want, got)
+	}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Errorf("result %d: expected path %s, got %s", i, want[i], got[i])
+		}
+	}
+}
+
+func TestSet(t *testing.T) {
+	set := CompileAll([]domain.ValidationRule{
+		rule(t, "RANGE", `{"min": 1, "variables": ["quantity"]}`),
+		rule(t, "ENUM", `{"values": ["EUR"], "paths": ["/currency"]}`),
+		rule(t, "REGEX", `{"pattern": "("}`),
+	})
+	if len(set.Checks) != 2 || len(set.Invalid) != 1 {
+		t.Fatalf("expected 2 checks and 1 invalid rule, got %d and %d", len(set.Checks), len(set.Invalid))
+	}
+
+	report := set.Evaluate(value(t, `{"quantity": 0, "currency": "EUR"}`))
+	if report.Passed || len(report.Results) != 2 || report.Results[0].Path != "/quantity" || report.Results[0].Passed {
+		t.Errorf("expected the quantity rule to fail, got %+v", report)
+	}
+
+	report = set.TestVariable("quantity", 3.0)
+	if !report.Passed || len(report.Results) != 1 || report.Results[0].RuleType != TypeRange {
+		t.Errorf("expected only the bound RANGE rule to run and pass, got %+v", report)
+	}
+}
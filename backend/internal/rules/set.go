@@ -0,0 +1,86 @@
+package rules
+
+import (
+	"github.com/SpecForgeVC/SpecForge/internal/domain"
+	"github.com/google/uuid"
+)
+
+// InvalidRule is a stored rule whose config does not compile.
+type InvalidRule struct {
+	RuleID uuid.UUID `json:"rule_id"`
+	Rule   string    `json:"rule"`
+	Error  string    `json:"error"`
+}
+
+// Report collects the results of a rule set. Passed is true when every
+// evaluated rule passed; rules that do not compile are listed in Invalid and
+// do not affect it.
+type Report struct {
+	Passed  bool          `json:"passed"`
+	Results []Result      `json:"results"`
+	Invalid []InvalidRule `json:"invalid_rules,omitempty"`
+}
+
+// Set is a compiled collection of rules, typically a project's.
+type Set struct {
+	Checks  []*Check
+	Invalid []InvalidRule
+}
+
+// CompileAll compiles every rule, setting aside those that do not compile.
+func CompileAll(rs []domain.ValidationRule) *Set {
+	s := &Set{}
+	for _, r := range rs {
+		c, err := Compile(r)
+		if err != nil {
+			s.Invalid = append(s.Invalid, InvalidRule{RuleID: r.ID, Rule: r.Name, Error: err.Error()})
+			continue
+		}
+		s.Checks = append(s.Checks, c)
+	}
+	return s
+}
+
+// Test evaluates every rule against value.
+func (s *Set) Test(value interface{}) Report {
+	var results []Result
+	for _, c := range s.Checks {
+		results = append(results, c.Test(value))
+	}
+	return s.report(results)
+}
+
+// TestVariable evaluates the rules bound to the variable against value.
+func (s *Set) TestVariable(name string, value interface{}) Report {
+	path := "/" + escape(name)
+	var results []Result
+	for _, c := range s.Checks {
+		for _, p := range c.Paths {
+			if p == path {
+				results = append(results, c.result(path, value))
+				break
+			}
+		}
+	}
+	return s.report(results)
+}
+
+// Evaluate runs every rule at its bound locations in payload.
+func (s *Set) Evaluate(payload interface{}) Report {
+	var results []Result
+	for _, c := range s.Checks {
+		results = append(results, c.Evaluate(payload)...)
+	}
+	return s.report(results)
+}
+
+func (s *Set) report(results []Result) Report {
+	r := Report{Passed: true, Results: []Result{}, Invalid: s.Invalid}
+	for _, res := range results {
+		r.Results = append(r.Results, res)
+		if !res.Passed {
+			r.Passed = false
+		}
+	}
+	return r
+}
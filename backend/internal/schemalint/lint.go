@@ -0,0 +1,307 @@
+package schemalint
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+)
+
+// Severity is how a finding affects a save. ERROR findings block it; OFF
+// disables a rule.
+type Severity string
+
+const (
+	Error   Severity = "ERROR"
+	Warning Severity = "WARNING"
+	Info    Severity = "INFO"
+	Off     Severity = "OFF"
+)
+
+// Rule names. MetaSchema findings are always errors and cannot be configured.
+const (
+	RuleMetaSchema          = "meta-schema"
+	RuleUnknownKeyword      = "unknown-keyword"
+	RuleLegacyDialect       = "legacy-dialect"
+	RuleUnenforcedKeyword   = "unenforced-keyword"
+	RuleDescriptionRequired = "description-required"
+	RulePropertyNaming      = "property-naming"
+	RuleErrorSchemaRequired = "error-schema-required"
+	RuleNoEmptyObjects      = "no-empty-objects"
+	RuleMaxNestingDepth     = "max-nesting-depth"
+)
+
+// Naming styles for the property-naming rule.
+var namingStyles = map[string]*regexp.Regexp{
+	"camelCase":  regexp.MustCompile(`^[a-z][a-zA-Z0-9]*$`),
+	"snake_case": regexp.MustCompile(`^[a-z][a-z0-9]*(_[a-z0-9]+)*$`),
+	"PascalCase": regexp.MustCompile(`^[A-Z][a-zA-Z0-9]*$`),
+	"kebab-case": regexp.MustCompile(`^[a-z][a-z0-9]*(-[a-z0-9]+)*$`),
+}
+
+// RuleConfig sets a rule's severity and, for the rules that take one, its
+// option: Style for property-naming, Max for max-nesting-depth.
+type RuleConfig struct {
+	Severity Severity `json:"severity"`
+	Style    string   `json:"style,omitempty"`
+	Max      int      `json:"max,omitempty"`
+}
+
+// Ruleset configures the lint rules of a project. Rules it leaves out keep
+// their default configuration.
+type Ruleset struct {
+	Rules map[string]RuleConfig `json:"rules"`
+}
+
+var defaults = map[string]RuleConfig{
+	RuleUnknownKeyword:      {Severity: Warning},
+	RuleLegacyDialect:       {Severity: Info},
+	RuleUnenforcedKeyword:   {Severity: Warning},
+	RuleDescriptionRequired: {Severity: Warning},
+	RulePropertyNaming:      {Severity: Off, Style: "camelCase"},
+	RuleErrorSchemaRequired: {Severity: Warning},
+	RuleNoEmptyObjects:      {Severity: Warning},
+	RuleMaxNestingDepth:     {Severity: Warning, Max: 8},
+}
+
+// DefaultRuleset returns the configuration used by projects that have not
+// set their own.
+func DefaultRuleset() Ruleset {
+	rules := make(map[string]RuleConfig, len(defaults))
+	for name, cfg := range defaults {
+		rules[name] = cfg
+	}
+	return Ruleset{Rules: rules}
+}
+
+// Rule returns the effective configuration of a rule.
+func (r Ruleset) Rule(name string) RuleConfig {
+	cfg, ok := r.Rules[name]
+	if !ok {
+		return defaults[name]
+	}
+	def := defaults[name]
+	if cfg.Style == "" {
+		cfg.Style = def.Style
+	}
+	if cfg.Max == 0 {
+		cfg.Max = def.Max
+	}
+	return cfg
+}
+
+// Validate checks that the ruleset names known rules, severities and options.
+func (r Ruleset) Validate() error {
+	for _, name := range sortedRuleNames(r.Rules) {
+		cfg := r.Rules[name]
+		if _, ok := defaults[name]; !ok {
+			return fmt.Errorf("unknown rule %q", name)
+		}
+		switch cfg.Severity {
+		case Error, Warning, Info, Off:
+		default:
+			return fmt.Errorf("rule %s: unknown severity %q", name, cfg.Severity)
+		}
+		if cfg.Style != "" {
+			if name != RulePropertyNaming {
+				return fmt.Errorf("rule %s takes no style", name)
+			}
+			if _, ok := namingStyles[cfg.Style]; !ok {
+				return fmt.Errorf("rule %s: unknown style %q, expected camelCase, snake_case, PascalCase or kebab-case", name, cfg.Style)
+			}
+		}
+		if cfg.Max != 0 && name != RuleMaxNestingDepth {
+			return fmt.Errorf("rule %s takes no max", name)
+		}
+		if cfg.Max < 0 {
+			return fmt.Errorf("rule %s: max must be positive", name)
+		}
+	}
+	return nil
+}
+
+// Finding is one problem in one of a contract's schemas. Pointer locates the
+// offending keyword or subschema within that schema.
+type Finding struct {
+	Rule     string   `json:"rule"`
+	Severity Severity `json:"severity"`
+	Schema   string   `json:"schema"`
+	Pointer  string   `json:"pointer"`
+	Message  string   `json:"message"`
+}
+
+// Report lists the findings of a lint run. Passed is false when any finding
+// is an error.
+type Report struct {
+	Passed   bool      `json:"passed"`
+	Errors   int       `json:"errors"`
+	Warnings int       `json:"warnings"`
+	Findings []Finding `json:"findings"`
+}
+
+// Schema is one named schema of a contract, such as "input_schema".
+type Schema struct {
+	Name     string
+	Document map[string]interface{}
+}
+
+// Contract is what Lint checks: the contract's JSON schemas, and whether it
+// is a REST contract, for which an error schema is expected.
+type Contract struct {
+	REST           bool
+	HasErrorSchema bool
+	Schemas        []Schema
+}
+
+// Lint meta-validates every schema of the contract and applies the ruleset.
+func Lint(c Contract, rules Ruleset) Report {
+	report := Report{Findings: []Finding{}}
+	add := func(rule string, severity Severity, schema, pointer, message string) {
+		if severity == Off || severity == "" {
+			return
+		}
+		report.Findings = append(report.Findings, Finding{Rule: rule, Severity: severity, Schema: schema, Pointer: pointer, Message: message})
+		switch severity {
+		case Error:
+			report.Errors++
+		case Warning:
+			report.Warnings++
+		}
+	}
+
+	if cfg := rules.Rule(RuleErrorSchemaRequired); c.REST && !c.HasErrorSchema {
+		add(RuleErrorSchemaRequired, cfg.Severity, "error_schema", "", "REST contracts should describe their error responses")
+	}
+	for _, s := range c.Schemas {
+		metaValidate(s.Document, "", func(pointer, format string, args ...interface{}) {
+			add(RuleMetaSchema, Error, s.Name, pointer, fmt.Sprintf(format, args...))
+		})
+		l := &linter{rules: rules, emit: func(rule, pointer, message string) {
+			add(rule, rules.Rule(rule).Severity, s.Name, pointer, message)
+		}}
+		l.walk(s.Document, "", 1)
+	}
+
+	sort.SliceStable(report.Findings, func(i, j int) bool {
+		a, b := report.Findings[i], report.Findings[j]
+		if a.Schema != b.Schema {
+			return a.Schema < b.Schema
+		}
+		return a.Pointer < b.Pointer
+	})
+	report.Passed = report.Errors == 0
+	return report
+}
+
+// linter applies the configurable rules while walking a schema.
+type linter struct {
+	rules Ruleset
+	emit  func(rule, pointer, message string)
+}
+
+// walk visits a schema at depth levels of object and array nesting.
+func (l *linter) walk(node interface{}, pointer string, depth int) {
+	schema, ok := node.(map[string]interface{})
+	if !ok {
+		return
+	}
+	if max := l.rules.Rule(RuleMaxNestingDepth).Max; max > 0 && depth > max {
+		l.emit(RuleMaxNestingDepth, pointer, fmt.Sprintf("schema is nested %d levels deep, more than the maximum of %d", depth, max))
+		return
+	}
+
+	for _, key := range sortedKeys(schema) {
+		if _, known := keywords[key]; !known && !strings.HasPrefix(key, "x-") && nearestKeyword(key) == "" {
+			l.emit(RuleUnknownKeyword, pointer+"/"+escape(key), fmt.Sprintf("%q is not a JSON Schema keyword; prefix extensions with x-", key))
+		}
+	}
+	for _, key := range unenforcedKeywords {
+		if _, ok := schema[key]; ok {
+			l.emit(RuleUnenforcedKeyword, pointer+"/"+escape(key), fmt.Sprintf("%s is not enforced by payload validation or traffic checks, which use JSON Schema draft-07", key))
+		}
+	}
+	if _, ok := schema["nullable"].(bool); ok {
+		l.emit(RuleLegacyDialect, pointer+"/nullable", `nullable is OpenAPI 3.0; in OpenAPI 3.1 add "null" to type`)
+	}
+	for _, key := range []string{"exclusiveMinimum", "exclusiveMaximum"} {
+		if _, ok := schema[key].(bool); ok {
+			l.emit(RuleLegacyDialect, pointer+"/"+key, fmt.Sprintf("boolean %s is OpenAPI 3.0; in OpenAPI 3.1 it holds the bound itself", key))
+		}
+	}
+	if isEmptyObject(schema) {
+		l.emit(RuleNoEmptyObjects, pointer, "object schema declares no properties")
+	}
+
+	if props, ok := schema["properties"].(map[string]interface{}); ok {
+		style := l.rules.Rule(RulePropertyNaming).Style
+		for _, name := range sortedKeys(props) {
+			at := pointer + "/properties/" + escape(name)
+			if re := namingStyles[style]; re != nil && !re.MatchString(name) {
+				l.emit(RulePropertyNaming, at, fmt.Sprintf("property %q is not %s", name, style))
+			}
+			if sub, ok := props[name].(map[string]interface{}); ok && !described(sub) {
+				l.emit(RuleDescriptionRequired, at, fmt.Sprintf("property %q has no description", name))
+			}
+			l.walk(props[name], at, depth+1)
+		}
+	}
+	for _, key := range []string{"items", "additionalProperties", "contains", "unevaluatedProperties", "unevaluatedItems"} {
+		l.walk(schema[key], pointer+"/"+key, depth+1)
+	}
+	for _, key := range []string{"patternProperties", "$defs", "definitions"} {
+		m, _ := schema[key].(map[string]interface{})
+		next := depth + 1
+		if key != "patternProperties" {
+			next = 1
+		}
+		for _, name := range sortedKeys(m) {
+			l.walk(m[name], pointer+"/"+key+"/"+escape(name), next)
+		}
+	}
+	for _, key := range []string{"allOf", "anyOf", "oneOf", "prefixItems"} {
+		list, _ := schema[key].([]interface{})
+		next := depth
+		if key == "prefixItems" {
+			next = depth + 1
+		}
+		for i, sub := range list {
+			l.walk(sub, fmt.Sprintf("%s/%s/%d", pointer, key, i), next)
+		}
+	}
+	for _, key := range []string{"not", "if", "then", "else"} {
+		l.walk(schema[key], pointer+"/"+key, depth)
+	}
+}
+
+// described reports whether a property schema documents itself, directly or
+// through the schema it references.
+func described(s map[string]interface{}) bool {
+	if d, _ := s["description"].(string); strings.TrimSpace(d) != "" {
+		return true
+	}
+	_, ref := s["$ref"]
+	return ref
+}
+
+// isEmptyObject reports an object schema that says nothing about its members.
+func isEmptyObject(s map[string]interface{}) bool {
+	if t, _ := s["type"].(string); t != "object" {
+		return false
+	}
+	for _, k := range []string{"additionalProperties", "patternProperties", "unevaluatedProperties", "propertyNames", "$ref", "allOf", "anyOf", "oneOf", "if"} {
+		if _, ok := s[k]; ok {
+			return false
+		}
+	}
+	props, _ := s["properties"].(map[string]interface{})
+	return len(props) == 0
+}
+
+func sortedRuleNames(m map[string]RuleConfig) []string {
+	names := make([]string, 0, len(m))
+	for k := range m {
+		names = append(names, k)
+	}
+	sort.Strings(names)
+	return names
+}
@@ -0,0 +1,165 @@
+package schemalint
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func decode(t *testing.T, s string) map[string]interface{} {
+	t.Helper()
+	var m map[string]interface{}
+	if err := json.Unmarshal([]byte(s), &m); err != nil {
+		t.Fatal(err)
+	}
+	return m
+}
+
+func findings(r Report, rule string) []Finding {
+	var out []Finding
+	for _, f := range r.Findings {
+		if f.Rule == rule {
+			out = append(out, f)
+		}
+	}
+	return out
+}
+
+func TestLint_MetaSchema(t *testing.T) {
+	schema := decode(t, `{
+		"type": "object",
+		"properties": {
+			"id": {"type": "strng", "description": "Identifier"},
+			"tags": {"type": "array", "items": [{"type": "string"}], "description": "Tags"},
+			"code": {"type": "string", "pattern": "([a-z]", "description": "Code"},
+			"size": {"type": "integer", "minimum": "1", "maxLenght": 3, "description": "Size"}
+		},
+		"required": ["id", "id"]
+	}`)
+	r := Lint(Contract{Schemas: []Schema{{Name: "input_schema", Document: schema}}}, DefaultRuleset())
+
+	want := map[string]bool{
+		"/properties/id/type":        true,
+		"/properties/tags/items":     true,
+		"/properties/code/pattern":   true,
+		"/properties/size/minimum":   true,
+		"/properties/size/maxLenght": true,
+		"/required/1":                true,
+	}
+	for _, f := range findings(r, RuleMetaSchema) {
+		if f.Severity != Error || f.Schema != "input_schema" {
+			t.Errorf("unexpected meta-schema finding %+v", f)
+		}
+		delete(want, f.Pointer)
+	}
+	for p := range want {
+		t.Errorf("missing meta-schema error at %s", p)
+	}
+	if r.Passed || r.Errors != 6 {
+		t.Errorf("expected 6 errors and a failed report, got %d (passed=%v)", r.Errors, r.Passed)
+	}
+}
+
+func TestLint_VendorExtensions(t *testing.T) {
+	schema := decode(t, `{
+		"type": "object",
+		"x-nullable": true,
+		"x-type": "Order",
+		"properties": {
+			"status": {"type": "string", "description": "Status", "x-enum": ["OPEN"], "x-example": "OPEN", "x-ref": "#/Status"}
+		}
+	}`)
+	r := Lint(Contract{Schemas: []Schema{{Name: "output_schema", Document: schema}}}, DefaultRuleset())
+	if !r.Passed || len(r.Findings) != 0 {
+		t.Errorf("x- extensions should not be reported, got %+v", r.Findings)
+	}
+}
+
+func TestLint_LegacyDraftAllowsItemsArray(t *testing.T) {
+	schema := decode(t, `{"$schema": "http://json-schema.org/draft-07/schema#", "type": "array", "items": [{"type": "string"}]}`)
+	r := Lint(Contract{Schemas: []Schema{{Name: "output_schema", Document: schema}}}, DefaultRuleset())
+	if !r.Passed {
+		t.Errorf("draft-07 tuple items should pass, got %+v", r.Findings)
+	}
+}
+
+func TestLint_Rules(t *testing.T) {
+	schema := decode(t, `{
+		"type": "object",
+		"properties": {
+			"user_id": {"type": "string"},
+			"meta": {"type": "object", "description": "Free-form metadata"},
+			"price": {"type": "number", "minimum": 0, "exclusiveMinimum": true, "description": "Price"},
+			"owner": {"$ref": "#/$defs/user"},
+			"color": {"type": "string", "widget": "picker", "x-ui": "swatch", "description": "Color"},
+			"pair": {"type": "array", "prefixItems": [{"type": "string"}], "description": "Pair"}
+		},
+		"unevaluatedProperties": false,
+		"$defs": {"user": {"type": "object", "properties": {"name": {"type": "string", "description": "Name"}}}}
+	}`)
+	rules := DefaultRuleset()
+	rules.Rules[RulePropertyNaming] = RuleConfig{Severity: Error, Style: "camelCase"}
+	r := Lint(Contract{REST: true, Schemas: []Schema{{Name: "input_schema", Document: schema}}}, rules)
+
+	expect := []struct {
+		rule, pointer string
+		severity      Severity
+	}{
+		{RuleErrorSchemaRequired, "", Warning},
+		{RuleDescriptionRequired, "/properties/user_id", Warning},
+		{RulePropertyNaming, "/properties/user_id", Error},
+		{RuleNoEmptyObjects, "/properties/meta", Warning},
+		{RuleLegacyDialect, "/properties/price/exclusiveMinimum", Info},
+		{RuleUnknownKeyword, "/properties/color/widget", Warning},
+		{RuleUnenforcedKeyword, "/properties/pair/prefixItems", Warning},
+		{RuleUnenforcedKeyword, "/unevaluatedProperties", Warning},
+	}
+	if len(r.Findings) != len(expect) {
+		t.Fatalf("expected %d findings, got %+v", len(expect), r.Findings)
+	}
+	for _, e := range expect {
+		found := false
+		for _, f := range findings(r, e.rule) {
+			found = found || (f.Pointer == e.pointer && f.Severity == e.severity)
+		}
+		if !found {
+			t.Errorf("missing %s %s finding at %q in %+v", e.severity, e.rule, e.pointer, r.Findings)
+		}
+	}
+	if r.Passed || r.Errors != 1 || r.Warnings != 6 {
+		t.Errorf("expected 1 error and 6 warnings, got %d and %d", r.Errors, r.Warnings)
+	}
+}
+
+func TestLint_MaxNestingDepth(t *testing.T) {
+	schema := decode(t, `{"type": "object", "properties": {"a": {"type": "object", "description": "A",
+		"properties": {"b": {"type": "array", "description": "B", "items": {"type": "string"}}}}}}`)
+	rules := Ruleset{Rules: map[string]RuleConfig{RuleMaxNestingDepth: {Severity: Error, Max: 4}}}
+	if r := Lint(Contract{Schemas: []Schema{{Name: "output_schema", Document: schema}}}, rules); !r.Passed {
+		t.Errorf("schema 4 levels deep should pass a maximum of 4, got %+v", r.Findings)
+	}
+
+	rules.Rules[RuleMaxNestingDepth] = RuleConfig{Severity: Error, Max: 2}
+	r := Lint(Contract{Schemas: []Schema{{Name: "output_schema", Document: schema}}}, rules)
+	got := findings(r, RuleMaxNestingDepth)
+	if len(got) != 1 || got[0].Pointer != "/properties/a/properties/b" {
+		t.Errorf("expected one depth finding at b, got %+v", got)
+	}
+}
+
+func TestRuleset_Validate(t *testing.T) {
+	if err := DefaultRuleset().Validate(); err != nil {
+		t.Errorf("default ruleset: %v", err)
+	}
+	invalid := []Ruleset{
+		{Rules: map[string]RuleConfig{"no-tabs": {Severity: Error}}},
+		{Rules: map[string]RuleConfig{RuleNoEmptyObjects: {Severity: "FATAL"}}},
+		{Rules: map[string]RuleConfig{RulePropertyNaming: {Severity: Error, Style: "Title Case"}}},
+		{Rules: map[string]RuleConfig{RuleNoEmptyObjects: {Severity: Error, Max: 3}}},
+		{Rules: map[string]RuleConfig{RuleMaxNestingDepth: {Severity: Error, Max: -1}}},
+	}
+	for i, rs := range invalid {
+		if err := rs.Validate(); err == nil {
+			t.Errorf("ruleset %d: expected an error", i)
+		}
+	}
+}
@@ -0,0 +1,362 @@
+// Package schemalint checks contract schemas before they are saved: against
+// the keywords and value types of JSON Schema 2020-12, in the OpenAPI 3.1
+// schema dialect, and against a configurable ruleset of style rules. The
+// keyword table below stands in for the meta-schemas; it is not the
+// meta-schema documents themselves.
+package schemalint
+
+import (
+	"fmt"
+	"math"
+	"regexp"
+	"slices"
+	"sort"
+	"strings"
+)
+
+// valueKind is what a keyword's value must be per the meta-schemas.
+type valueKind int
+
+const (
+	kindSchema valueKind = iota
+	kindSchemaMap
+	kindSchemaList
+	kindNonNegativeInt
+	kindNumber
+	kindPositiveNumber
+	kindString
+	kindRegex
+	kindBool
+	kindUniqueStrings
+	kindArray
+	kindAny
+	kindObject
+	kindType
+	kindDependentRequired
+	kindItems
+	kindExclusiveBound
+)
+
+// keywords are the 2020-12 core, applicator, unevaluated, validation,
+// meta-data, format-annotation and content vocabularies, the OpenAPI 3.1
+// dialect additions, and the draft-07 and OpenAPI 3.0 keywords still
+// understood by the validator.
+var keywords = map[string]valueKind{
+	"$schema": kindString, "$id": kindString, "$ref": kindString, "$anchor": kindString,
+	"$dynamicRef": kindString, "$dynamicAnchor": kindString, "$comment": kindString,
+	"$defs": kindSchemaMap, "$vocabulary": kindObject,
+
+	"allOf": kindSchemaList, "anyOf": kindSchemaList, "oneOf": kindSchemaList, "not": kindSchema,
+	"if": kindSchema, "then": kindSchema, "else": kindSchema, "dependentSchemas": kindSchemaMap,
+	"prefixItems": kindSchemaList, "items": kindItems, "contains": kindSchema,
+	"properties": kindSchemaMap, "patternProperties": kindSchemaMap, "additionalProperties": kindSchema,
+	"propertyNames": kindSchema, "unevaluatedItems": kindSchema, "unevaluatedProperties": kindSchema,
+
+	"type": kindType, "enum": kindArray, "const": kindAny,
+	"multipleOf": kindPositiveNumber, "maximum": kindNumber, "minimum": kindNumber,
+	"exclusiveMaximum": kindExclusiveBound, "exclusiveMinimum": kindExclusiveBound,
+	"maxLength": kindNonNegativeInt, "minLength": kindNonNegativeInt, "pattern": kindRegex,
+	"maxItems": kindNonNegativeInt, "minItems": kindNonNegativeInt, "uniqueItems": kindBool,
+	"maxContains": kindNonNegativeInt, "minContains": kindNonNegativeInt,
+	"maxProperties": kindNonNegativeInt, "minProperties": kindNonNegativeInt,
+	"required": kindUniqueStrings, "dependentRequired": kindDependentRequired,
+
+	"title": kindString, "description": kindString, "default": kindAny, "deprecated": kindBool,
+	"readOnly": kindBool, "writeOnly": kindBool, "examples": kindArray,
+	"format":           kindString,
+	"contentEncoding":  kindString,
+	"contentMediaType": kindString,
+	"contentSchema":    kindSchema,
+
+	// OpenAPI 3.1 dialect.
+	"discriminator": kindObject, "xml": kindObject, "externalDocs": kindObject, "example": kindAny,
+
+	// draft-07 and OpenAPI 3.0.
+	"id": kindString, "definitions": kindSchemaMap, "dependencies": kindObject,
+	"additionalItems": kindSchema, "nullable": kindBool,
+}
+
+// unenforcedKeywords are 2019-09 and 2020-12 keywords that the draft-07
+// validator behind payload validation and traffic checks ignores.
+var unenforcedKeywords = []string{
+	"$dynamicAnchor", "$dynamicRef", "dependentRequired", "dependentSchemas",
+	"maxContains", "minContains", "prefixItems", "unevaluatedItems", "unevaluatedProperties",
+}
+
+var typeNames = []string{"array", "boolean", "integer", "null", "number", "object", "string"}
+
+// metaValidate reports where node breaks the meta-schemas. Keywords that are
+// near-misses of a known keyword are errors; other unknown keywords are left
+// to the unknown-keyword rule. x- vendor extensions are never checked.
+func metaValidate(node interface{}, pointer string, emit func(pointer, format string, args ...interface{})) {
+	schema, ok := node.(map[string]interface{})
+	if !ok {
+		if _, isBool := node.(bool); !isBool {
+			emit(pointer, "a schema must be an object or a boolean, got %s", typeName(node))
+		}
+		return
+	}
+
+	for _, key := range sortedKeys(schema) {
+		if strings.HasPrefix(key, "x-") {
+			continue
+		}
+		value := schema[key]
+		at := pointer + "/" + escape(key)
+		kind, known := keywords[key]
+		if !known {
+			if suggestion := nearestKeyword(key); suggestion != "" {
+				emit(at, "unknown keyword %q, did you mean %q?", key, suggestion)
+			}
+			continue
+		}
+		switch kind {
+		case kindSchema:
+			metaValidate(value, at, emit)
+		case kindSchemaMap:
+			m, ok := value.(map[string]interface{})
+			if !ok {
+				emit(at, "%s must be an object of schemas, got %s", key, typeName(value))
+				continue
+			}
+			for _, name := range sortedKeys(m) {
+				if key == "patternProperties" {
+					if _, err := regexp.Compile(name); err != nil {
+						emit(at+"/"+escape(name), "pattern property %q is not a valid regular expression: %v", name, err)
+					}
+				}
+				metaValidate(m[name], at+"/"+escape(name), emit)
+			}
+		case kindSchemaList:
+			list, ok := value.([]interface{})
+			if !ok || len(list) == 0 {
+				emit(at, "%s must be a non-empty array of schemas", key)
+				continue
+			}
+			for i, sub := range list {
+				metaValidate(sub, fmt.Sprintf("%s/%d", at, i), emit)
+			}
+		case kindItems:
+			if list, ok := value.([]interface{}); ok {
+				if !legacyDraft(schema) {
+					emit(at, "items must be a single schema in JSON Schema 2020-12; use prefixItems for tuples")
+					continue
+				}
+				for i, sub := range list {
+					metaValidate(sub, fmt.Sprintf("%s/%d", at, i), emit)
+				}
+				continue
+			}
+			metaValidate(value, at, emit)
+		case kindNonNegativeInt:
+			if n, ok := value.(float64); !ok || n < 0 || n != math.Trunc(n) {
+				emit(at, "%s must be a non-negative integer, got %s", key, render(value))
+			}
+		case kindNumber:
+			if _, ok := value.(float64); !ok {
+				emit(at, "%s must be a number, got %s", key, typeName(value))
+			}
+		case kindPositiveNumber:
+			if n, ok := value.(float64); !ok || n <= 0 {
+				emit(at, "%s must be a number greater than 0, got %s", key, render(value))
+			}
+		case kindExclusiveBound:
+			// OpenAPI 3.0's boolean form is reported by the legacy-dialect rule.
+			switch value.(type) {
+			case float64, bool:
+			default:
+				emit(at, "%s must be a number, got %s", key, typeName(value))
+			}
+		case kindString:
+			if _, ok := value.(string); !ok {
+				emit(at, "%s must be a string, got %s", key, typeName(value))
+			}
+		case kindRegex:
+			s, ok := value.(string)
+			if !ok {
+				emit(at, "pattern must be a string, got %s", typeName(value))
+			} else if _, err := regexp.Compile(s); err != nil {
+				emit(at, "pattern is not a valid regular expression: %v", err)
+			}
+		case kindBool:
+			if _, ok := value.(bool); !ok {
+				emit(at, "%s must be a boolean, got %s", key, typeName(value))
+			}
+		case kindUniqueStrings:
+			checkUniqueStrings(value, at, key, emit)
+		case kindArray:
+			if _, ok := value.([]interface{}); !ok {
+				emit(at, "%s must be an array, got %s", key, typeName(value))
+			}
+		case kindObject:
+			if _, ok := value.(map[string]interface{}); !ok {
+				emit(at, "%s must be an object, got %s", key, typeName(value))
+			}
+		case kindType:
+			checkType(value, at, emit)
+		case kindDependentRequired:
+			m, ok := value.(map[string]interface{})
+			if !ok {
+				emit(at, "dependentRequired must be an object, got %s", typeName(value))
+				continue
+			}
+			for _, name := range sortedKeys(m) {
+				checkUniqueStrings(m[name], at+"/"+escape(name), "dependentRequired entries", emit)
+			}
+		}
+	}
+}
+
+func checkType(value interface{}, at string, emit func(pointer, format string, args ...interface{})) {
+	check := func(v interface{}, at string) {
+		name, ok := v.(string)
+		if !ok {
+			emit(at, "type must be a string or an array of strings, got %s", typeName(v))
+			return
+		}
+		if slices.Contains(typeNames, name) {
+			return
+		}
+		if suggestion := nearest(name, typeNames); suggestion != "" {
+			emit(at, "unknown type %q, did you mean %q?", name, suggestion)
+			return
+		}
+		emit(at, "unknown type %q, expected one of %s", name, strings.Join(typeNames, ", "))
+	}
+	list, ok := value.([]interface{})
+	if !ok {
+		check(value, at)
+		return
+	}
+	if len(list) == 0 {
+		emit(at, "type must not be an empty array")
+	}
+	seen := make(map[string]bool, len(list))
+	for i, v := range list {
+		check(v, fmt.Sprintf("%s/%d", at, i))
+		if name, ok := v.(string); ok {
+			if seen[name] {
+				emit(fmt.Sprintf("%s/%d", at, i), "type lists %q more than once", name)
+			}
+			seen[name] = true
+		}
+	}
+}
+
+func checkUniqueStrings(value interface{}, at, what string, emit func(pointer, format string, args ...interface{})) {
+	list, ok := value.([]interface{})
+	if !ok {
+		emit(at, "%s must be an array of strings, got %s", what, typeName(value))
+		return
+	}
+	seen := make(map[string]bool, len(list))
+	for i, v := range list {
+		s, ok := v.(string)
+		if !ok {
+			emit(fmt.Sprintf("%s/%d", at, i), "%s must be an array of strings, got %s", what, typeName(v))
+			continue
+		}
+		if seen[s] {
+			emit(fmt.Sprintf("%s/%d", at, i), "%s lists %q more than once", what, s)
+		}
+		seen[s] = true
+	}
+}
+
+// legacyDraft reports whether the schema declares a pre-2019 draft, where
+// items may be an array.
+func legacyDraft(schema map[string]interface{}) bool {
+	uri, _ := schema["$schema"].(string)
+	for _, draft := range []string{"draft-04", "draft-06", "draft-07"} {
+		if strings.Contains(uri, draft) {
+			return true
+		}
+	}
+	return false
+}
+
+// nearestKeyword returns the known keyword a typo most likely meant.
+func nearestKeyword(key string) string {
+	names := make([]string, 0, len(keywords))
+	for k := range keywords {
+		names = append(names, k)
+	}
+	sort.Strings(names)
+	return nearest(key, names)
+}
+
+// nearest returns the candidate within edit distance 2 of s (1 for short
+// words), ignoring case, or "" when none is that close.
+func nearest(s string, candidates []string) string {
+	limit := 2
+	if len(s) <= 4 {
+		limit = 1
+	}
+	best, bestDist := "", limit+1
+	for _, c := range candidates {
+		if d := distance(strings.ToLower(s), strings.ToLower(c)); d < bestDist {
+			best, bestDist = c, d
+		}
+	}
+	return best
+}
+
+// distance is the Levenshtein distance between a and b.
+func distance(a, b string) int {
+	ra, rb := []rune(a), []rune(b)
+	prev := make([]int, len(rb)+1)
+	for j := range prev {
+		prev[j] = j
+	}
+	for i := 1; i <= len(ra); i++ {
+		cur := make([]int, len(rb)+1)
+		cur[0] = i
+		for j := 1; j <= len(rb); j++ {
+			cost := 1
+			if ra[i-1] == rb[j-1] {
+				cost = 0
+			}
+			cur[j] = min(prev[j]+1, cur[j-1]+1, prev[j-1]+cost)
+		}
+		prev = cur
+	}
+	return prev[len(rb)]
+}
+
+func typeName(v interface{}) string {
+	switch v.(type) {
+	case nil:
+		return "null"
+	case bool:
+		return "boolean"
+	case float64:
+		return "number"
+	case string:
+		return "string"
+	case []interface{}:
+		return "array"
+	case map[string]interface{}:
+		return "object"
+	}
+	return fmt.Sprintf("%T", v)
+}
+
+func render(v interface{}) string {
+	if s, ok := v.(string); ok {
+		return fmt.Sprintf("%q", s)
+	}
+	return fmt.Sprintf("%v", v)
+}
+
+func sortedKeys(m map[string]interface{}) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+func escape(token string) string {
+	return strings.NewReplacer("~", "~0", "/", "~1").Replace(token)
+}
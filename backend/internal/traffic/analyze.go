@@ -0,0 +1,232 @@
+package traffic
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/SpecForgeVC/SpecForge/internal/domain"
+	"github.com/SpecForgeVC/SpecForge/internal/openapi"
+	"github.com/SpecForgeVC/SpecForge/internal/payload"
+	"github.com/xeipuuv/gojsonschema"
+)
+
+// Analyze matches every exchange to a route and validates its JSON bodies.
+// Non-JSON and empty bodies are not validated.
+func Analyze(exchanges []Exchange, routes []Route) domain.TrafficDriftReport {
+	report := domain.TrafficDriftReport{TotalExchanges: len(exchanges)}
+	a := &analyzer{
+		endpoints: make(map[string]*domain.UndocumentedEndpoint),
+		fields:    make(map[string]*domain.TrafficFinding),
+		violation: make(map[string]*domain.TrafficFinding),
+	}
+
+	for _, ex := range exchanges {
+		route := match(routes, ex)
+		if route == nil {
+			a.undocumentedEndpoint(ex)
+			continue
+		}
+		report.MatchedExchanges++
+
+		if route.Request != nil {
+			a.checkBody(route, ex, "request", 0, ex.RequestContentType, ex.RequestBody, route.Request)
+		}
+		if schema, ok := route.responseSchema(ex.Status); ok && schema != nil {
+			a.checkBody(route, ex, "response", ex.Status, ex.ResponseContentType, ex.ResponseBody, schema)
+		}
+	}
+
+	report.UndocumentedEndpoints = a.sortedEndpoints()
+	report.UndocumentedFields = sortedFindings(a.fields)
+	report.SchemaViolations = sortedFindings(a.violation)
+	return report
+}
+
+func match(routes []Route, ex Exchange) *Route {
+	for i := range routes {
+		if routes[i].matches(ex.Method, ex.Path) {
+			return &routes[i]
+		}
+	}
+	return nil
+}
+
+type analyzer struct {
+	endpoints map[string]*domain.UndocumentedEndpoint
+	fields    map[string]*domain.TrafficFinding
+	violation map[string]*domain.TrafficFinding
+}
+
+func (a *analyzer) undocumentedEndpoint(ex Exchange) {
+	path := collapseIDs(ex.Path)
+	key := ex.Method + " " + path
+	if e, ok := a.endpoints[key]; ok {
+		e.Occurrences++
+		return
+	}
+	a.endpoints[key] = &domain.UndocumentedEndpoint{Method: ex.Method, Path: path, Occurrences: 1}
+}
+
+func (a *analyzer) checkBody(route *Route, ex Exchange, direction string, status int, contentType string, body []byte, schema map[string]interface{}) {
+	if len(strings.TrimSpace(string(body))) == 0 || (contentType != "" && !openapi.IsJSONMediaType(contentType)) {
+		return
+	}
+	var value interface{}
+	if err := json.Unmarshal(body, &value); err != nil {
+		if contentType == "" {
+			return
+		}
+		a.record(a.violation, route, ex, direction, status, "(root)", "body is not valid JSON: "+err.Error())
+		return
+	}
+
+	jsonSchema := payload.ToJSONSchema(schema)
+	result, err := gojsonschema.Validate(gojsonschema.NewGoLoader(jsonSchema), gojsonschema.NewGoLoader(value))
+	if err != nil {
+		a.record(a.violation, route, ex, direction, status, "(root)", "contract schema could not be compiled: "+err.Error())
+		return
+	}
+	for _, e := range result.Errors() {
+		a.record(a.violation, route, ex, direction, status, e.Field(), e.Description())
+	}
+
+	for _, field := range undocumentedFields(schema, value, "") {
+		a.record(a.fields, route, ex, direction, status, field, "field is not described by the contract")
+	}
+}
+
+func (a *analyzer) record(into map[string]*domain.TrafficFinding, route *Route, ex Exchange, direction string, status int, field, message string) {
+	key := fmt.Sprintf("%s|%s|%s|%s|%d|%s|%s", route.ContractID, route.Method, route.Path, direction, status, field, message)
+	if f, ok := into[key]; ok {
+		f.Occurrences++
+		return
+	}
+	method := route.Method
+	if method == "" {
+		method = ex.Method
+	}
+	into[key] = &domain.TrafficFinding{
+		ContractID:  route.ContractID,
+		Method:      method,
+		Path:        route.Path,
+		Direction:   direction,
+		Status:      status,
+		Field:       field,
+		Message:     message,
+		Occurrences: 1,
+	}
+}
+
+// undocumentedFields lists object keys present in the value but absent from the
+// schema. Objects without declared properties, or that set additionalProperties,
+// are treated as free-form.
+func undocumentedFields(schema map[string]interface{}, value interface{}, prefix string) []string {
+	var out []string
+	switch v := value.(type) {
+	case map[string]interface{}:
+		props, open := objectProperties(schema)
+		keys := make([]string, 0, len(v))
+		for k := range v {
+			keys = append(keys, k)
+		}
+		sort.Strings(keys)
+		for _, k := range keys {
+			field := k
+			if prefix != "" {
+				field = prefix + "." + k
+			}
+			sub, ok := props[k]
+			if !ok {
+				if !open {
+					out = append(out, field)
+				}
+				continue
+			}
+			out = append(out, undocumentedFields(sub, v[k], field)...)
+		}
+	case []interface{}:
+		items := itemsSchema(schema)
+		if items == nil {
+			return nil
+		}
+		seen := make(map[string]bool)
+		for _, elem := range v {
+			for _, f := range undocumentedFields(items, elem, prefix+"[]") {
+				if !seen[f] {
+					seen[f] = true
+					out = append(out, f)
+				}
+			}
+		}
+	}
+	return out
+}
+
+// objectProperties merges the properties of a schema and its composition branches.
+// open is true when undeclared keys are acceptable or cannot be judged.
+func objectProperties(schema map[string]interface{}) (map[string]map[string]interface{}, bool) {
+	props := make(map[string]map[string]interface{})
+	open := false
+	var collect func(s map[string]interface{})
+	collect = func(s map[string]interface{}) {
+		if raw, ok := s["properties"].(map[string]interface{}); ok {
+			for k, v := range raw {
+				if sub, ok := v.(map[string]interface{}); ok {
+					props[k] = sub
+				}
+			}
+		}
+		// additionalProperties: false is reported by the validator; true or a
+		// schema makes every extra key documented.
+		switch s["additionalProperties"].(type) {
+		case bool, map[string]interface{}:
+			open = true
+		}
+		for _, key := range []string{"allOf", "anyOf", "oneOf"} {
+			branches, _ := s[key].([]interface{})
+			for _, b := range branches {
+				if sub, ok := b.(map[string]interface{}); ok {
+					collect(sub)
+				}
+			}
+		}
+	}
+	collect(schema)
+	return props, open || len(props) == 0
+}
+
+func itemsSchema(schema map[string]interface{}) map[string]interface{} {
+	if items, ok := schema["items"].(map[string]interface{}); ok {
+		return items
+	}
+	return nil
+}
+
+func (a *analyzer) sortedEndpoints() []domain.UndocumentedEndpoint {
+	out := make([]domain.UndocumentedEndpoint, 0, len(a.endpoints))
+	for _, e := range a.endpoints {
+		out = append(out, *e)
+	}
+	sort.Slice(out, func(i, j int) bool {
+		if out[i].Path != out[j].Path {
+			return out[i].Path < out[j].Path
+		}
+		return out[i].Method < out[j].Method
+	})
+	return out
+}
+
+func sortedFindings(m map[string]*domain.TrafficFinding) []domain.TrafficFinding {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	out := make([]domain.TrafficFinding, 0, len(keys))
+	for _, k := range keys {
+		out = append(out, *m[k])
+	}
+	return out
+}
@@ -0,0 +1,173 @@
+package traffic
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"strings"
+)
+
+// Exchange is one recorded request/response pair.
+type Exchange struct {
+	Method              string
+	Path                string
+	RequestContentType  string
+	RequestBody         []byte
+	Status              int
+	ResponseContentType string
+	ResponseBody        []byte
+}
+
+// Parse reads a HAR document or a JSONL recording and reports which it was.
+func Parse(data []byte) ([]Exchange, string, error) {
+	trimmed := bytes.TrimSpace(data)
+	if len(trimmed) == 0 {
+		return nil, "", fmt.Errorf("recording is empty")
+	}
+	var probe struct {
+		Log json.RawMessage `json:"log"`
+	}
+	if json.Unmarshal(trimmed, &probe) == nil && len(probe.Log) > 0 {
+		exchanges, err := ParseHAR(trimmed)
+		return exchanges, "har", err
+	}
+	exchanges, err := ParseJSONL(trimmed)
+	return exchanges, "jsonl", err
+}
+
+type harFile struct {
+	Log struct {
+		Entries []struct {
+			Request struct {
+				Method   string `json:"method"`
+				URL      string `json:"url"`
+				PostData *struct {
+					MimeType string `json:"mimeType"`
+					Text     string `json:"text"`
+				} `json:"postData"`
+			} `json:"request"`
+			Response struct {
+				Status  int `json:"status"`
+				Content struct {
+					MimeType string `json:"mimeType"`
+					Text     string `json:"text"`
+					Encoding string `json:"encoding"`
+				} `json:"content"`
+			} `json:"response"`
+		} `json:"entries"`
+	} `json:"log"`
+}
+
+// ParseHAR reads the entries of a HAR 1.2 document.
+func ParseHAR(data []byte) ([]Exchange, error) {
+	var har harFile
+	if err := json.Unmarshal(data, &har); err != nil {
+		return nil, fmt.Errorf("invalid HAR document: %w", err)
+	}
+	exchanges := make([]Exchange, 0, len(har.Log.Entries))
+	for i, e := range har.Log.Entries {
+		path, err := requestPath(e.Request.URL)
+		if err != nil {
+			return nil, fmt.Errorf("entry %d: %w", i, err)
+		}
+		ex := Exchange{
+			Method:              strings.ToUpper(e.Request.Method),
+			Path:                path,
+			Status:              e.Response.Status,
+			ResponseContentType: e.Response.Content.MimeType,
+			ResponseBody:        []byte(e.Response.Content.Text),
+		}
+		if e.Request.PostData != nil {
+			ex.RequestContentType = e.Request.PostData.MimeType
+			ex.RequestBody = []byte(e.Request.PostData.Text)
+		}
+		if e.Response.Content.Encoding == "base64" {
+			decoded, err := base64.StdEncoding.DecodeString(e.Response.Content.Text)
+			if err != nil {
+				return nil, fmt.Errorf("entry %d: invalid base64 response body: %w", i, err)
+			}
+			ex.ResponseBody = decoded
+		}
+		exchanges = append(exchanges, ex)
+	}
+	return exchanges, nil
+}
+
+// jsonlExchange is one line of a JSONL recording. Bodies may be inline JSON
+// values or raw strings; "url" may be absolute or a bare path.
+type jsonlExchange struct {
+	Method              string          `json:"method"`
+	URL                 string          `json:"url"`
+	Path                string          `json:"path"`
+	Status              int             `json:"status"`
+	RequestContentType  string          `json:"request_content_type"`
+	RequestBody         json.RawMessage `json:"request_body"`
+	ResponseContentType string          `json:"response_content_type"`
+	ResponseBody        json.RawMessage `json:"response_body"`
+}
+
+// ParseJSONL reads one exchange per line; blank lines are skipped.
+func ParseJSONL(data []byte) ([]Exchange, error) {
+	var exchanges []Exchange
+	scanner := bufio.NewScanner(bytes.NewReader(data))
+	scanner.Buffer(make([]byte, 0, 64*1024), 16*1024*1024)
+	for line := 1; scanner.Scan(); line++ {
+		text := bytes.TrimSpace(scanner.Bytes())
+		if len(text) == 0 {
+			continue
+		}
+		var rec jsonlExchange
+		if err := json.Unmarshal(text, &rec); err != nil {
+			return nil, fmt.Errorf("line %d: %w", line, err)
+		}
+		raw := rec.URL
+		if raw == "" {
+			raw = rec.Path
+		}
+		if rec.Method == "" || raw == "" {
+			return nil, fmt.Errorf("line %d: method and url are required", line)
+		}
+		path, err := requestPath(raw)
+		if err != nil {
+			return nil, fmt.Errorf("line %d: %w", line, err)
+		}
+		exchanges = append(exchanges, Exchange{
+			Method:              strings.ToUpper(rec.Method),
+			Path:                path,
+			Status:              rec.Status,
+			RequestContentType:  rec.RequestContentType,
+			RequestBody:         rawBody(rec.RequestBody),
+			ResponseContentType: rec.ResponseContentType,
+			ResponseBody:        rawBody(rec.ResponseBody),
+		})
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	return exchanges, nil
+}
+
+func requestPath(raw string) (string, error) {
+	u, err := url.Parse(raw)
+	if err != nil {
+		return "", fmt.Errorf("invalid url %q: %w", raw, err)
+	}
+	if u.Path == "" {
+		return "/", nil
+	}
+	return u.Path, nil
+}
+
+func rawBody(raw json.RawMessage) []byte {
+	if len(raw) == 0 || string(raw) == "null" {
+		return nil
+	}
+	var text string
+	if json.Unmarshal(raw, &text) == nil {
+		return []byte(text)
+	}
+	return raw
+}
@@ -0,0 +1,160 @@
+package traffic
+
+import (
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/SpecForgeVC/SpecForge/internal/domain"
+	"github.com/SpecForgeVC/SpecForge/internal/openapi"
+	"github.com/google/uuid"
+)
+
+// Route is one documented method/path with the schemas its bodies must satisfy.
+// Responses are keyed by status code, "2XX"-style ranges or "default".
+type Route struct {
+	ContractID uuid.UUID
+	Method     string // empty matches any method
+	Path       string
+	Request    map[string]interface{}
+	Responses  map[string]map[string]interface{}
+
+	pattern *regexp.Regexp
+	params  int
+}
+
+var httpMethods = []string{"get", "put", "post", "delete", "options", "head", "patch", "trace"}
+
+// RoutesFromContracts collects the routes of a project's REST contracts.
+// Contracts embedding an OpenAPI document contribute every operation; plain
+// contracts contribute a single route when their input schema carries a "path"
+// (or "endpoint") hint and optionally a "method", with the output schema
+// covering 2XX responses and the error schema covering the rest.
+func RoutesFromContracts(contracts []domain.ContractDefinition) []Route {
+	var routes []Route
+	for _, c := range contracts {
+		if c.ContractType != domain.REST {
+			continue
+		}
+		if _, embedded := c.InputSchema["openapi"]; embedded {
+			routes = append(routes, openAPIRoutes(c)...)
+			continue
+		}
+		path, _ := c.InputSchema["path"].(string)
+		if path == "" {
+			path, _ = c.InputSchema["endpoint"].(string)
+		}
+		if path == "" {
+			continue
+		}
+		method, _ := c.InputSchema["method"].(string)
+		request := make(map[string]interface{}, len(c.InputSchema))
+		for k, v := range c.InputSchema {
+			if k != "path" && k != "endpoint" && k != "method" {
+				request[k] = v
+			}
+		}
+		route := Route{
+			ContractID: c.ID,
+			Method:     strings.ToUpper(method),
+			Path:       path,
+			Request:    request,
+			Responses:  map[string]map[string]interface{}{"2XX": c.OutputSchema},
+		}
+		if len(c.ErrorSchema) > 0 {
+			route.Responses["default"] = c.ErrorSchema
+		}
+		routes = append(routes, route)
+	}
+	for i := range routes {
+		routes[i].compile()
+	}
+	// Literal paths win over templated ones ("/users/me" before "/users/{id}").
+	sort.SliceStable(routes, func(i, j int) bool { return routes[i].params < routes[j].params })
+	return routes
+}
+
+func openAPIRoutes(c domain.ContractDefinition) []Route {
+	root, err := openapi.ResolveRefs(c.InputSchema)
+	if err != nil {
+		return nil
+	}
+	base := openapi.ServerBasePath(root)
+	paths, _ := root["paths"].(map[string]interface{})
+
+	var routes []Route
+	for path, rawItem := range paths {
+		item, _ := rawItem.(map[string]interface{})
+		for _, method := range httpMethods {
+			op, ok := item[method].(map[string]interface{})
+			if !ok {
+				continue
+			}
+			route := Route{
+				ContractID: c.ID,
+				Method:     strings.ToUpper(method),
+				Path:       base + path,
+				Responses:  make(map[string]map[string]interface{}),
+			}
+			if body, ok := op["requestBody"].(map[string]interface{}); ok {
+				route.Request = openapi.JSONContentSchema(body["content"])
+			}
+			responses, _ := op["responses"].(map[string]interface{})
+			for status, rawResp := range responses {
+				resp, _ := rawResp.(map[string]interface{})
+				if schema := openapi.JSONContentSchema(resp["content"]); schema != nil {
+					route.Responses[strings.ToUpper(status)] = schema
+				}
+			}
+			routes = append(routes, route)
+		}
+	}
+	return routes
+}
+
+var templateParam = regexp.MustCompile(`\{[^/{}]+\}`)
+
+func (r *Route) compile() {
+	var expr strings.Builder
+	last := 0
+	for _, loc := range templateParam.FindAllStringIndex(r.Path, -1) {
+		expr.WriteString(regexp.QuoteMeta(r.Path[last:loc[0]]))
+		expr.WriteString(`[^/]+`)
+		last = loc[1]
+		r.params++
+	}
+	expr.WriteString(regexp.QuoteMeta(strings.TrimRight(r.Path[last:], "/")))
+	r.pattern = regexp.MustCompile("^" + expr.String() + "/?$")
+}
+
+func (r *Route) matches(method, path string) bool {
+	return (r.Method == "" || r.Method == method) && r.pattern.MatchString(path)
+}
+
+// responseSchema resolves the schema for a status: exact code, then range, then default.
+func (r *Route) responseSchema(status int) (map[string]interface{}, bool) {
+	code := strconv.Itoa(status)
+	for _, key := range []string{code, code[:1] + "XX", "default"} {
+		if schema, ok := r.Responses[key]; ok {
+			return schema, true
+		}
+	}
+	return nil, false
+}
+
+var (
+	numericSegment = regexp.MustCompile(`^[0-9]+$`)
+	uuidSegment    = regexp.MustCompile(`^[0-9a-fA-F]{8}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{12}$`)
+)
+
+// collapseIDs groups undocumented paths that only differ by identifiers.
+func collapseIDs(path string) string {
+	segments := strings.Split(path, "/")
+	for i, s := range segments {
+		if numericSegment.MatchString(s) || uuidSegment.MatchString(s) {
+			segments[i] = "{id}"
+		}
+	}
+	return strings.Join(segments, "/")
+}
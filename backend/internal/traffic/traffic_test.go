@@ -0,0 +1,144 @@
+package traffic
+
+import (
+	"testing"
+
+	"github.com/SpecForgeVC/SpecForge/internal/domain"
+	"github.com/google/uuid"
+)
+
+var usersContract = domain.ContractDefinition{
+	ID:           uuid.MustParse("11111111-1111-1111-1111-111111111111"),
+	ContractType: domain.REST,
+	InputSchema: map[string]interface{}{
+		"openapi": "3.0.3",
+		"info":    map[string]interface{}{"title": "Users", "version": "1.0.0"},
+		"servers": []interface{}{map[string]interface{}{"url": "https://api.example.com/v1"}},
+		"paths": map[string]interface{}{
+			"/users/{id}": map[string]interface{}{
+				"get": map[string]interface{}{
+					"responses": map[string]interface{}{
+						"200": map[string]interface{}{
+							"content": map[string]interface{}{
+								"application/json": map[string]interface{}{
+									"schema": map[string]interface{}{"$ref": "#/components/schemas/User"},
+								},
+							},
+						},
+					},
+				},
+			},
+			"/users/me": map[string]interface{}{
+				"get": map[string]interface{}{"responses": map[string]interface{}{"200": map[string]interface{}{}}},
+			},
+		},
+		"components": map[string]interface{}{
+			"schemas": map[string]interface{}{
+				"User": map[string]interface{}{
+					"type":     "object",
+					"required": []interface{}{"id", "email"},
+					"properties": map[string]interface{}{
+						"id":       map[string]interface{}{"type": "string"},
+						"email":    map[string]interface{}{"type": "string"},
+						"nickname": map[string]interface{}{"type": "string", "nullable": true},
+					},
+				},
+			},
+		},
+	},
+}
+
+var ordersContract = domain.ContractDefinition{
+	ID:           uuid.MustParse("22222222-2222-2222-2222-222222222222"),
+	ContractType: domain.REST,
+	InputSchema: map[string]interface{}{
+		"path":     "/v1/orders",
+		"method":   "POST",
+		"type":     "object",
+		"required": []interface{}{"sku"},
+		"properties": map[string]interface{}{
+			"sku":   map[string]interface{}{"type": "string"},
+			"items": map[string]interface{}{"type": "array", "items": map[string]interface{}{"type": "object", "properties": map[string]interface{}{"qty": map[string]interface{}{"type": "integer"}}}},
+		},
+	},
+	OutputSchema: map[string]interface{}{"type": "object", "properties": map[string]interface{}{"id": map[string]interface{}{"type": "string"}}},
+}
+
+const har = `{"log": {"version": "1.2", "entries": [
+  {"request": {"method": "GET", "url": "https://api.example.com/v1/users/42"},
+   "response": {"status": 200, "content": {"mimeType": "application/json", "text": "{\"id\": \"42\", \"nickname\": null, \"role\": \"admin\"}"}}},
+  {"request": {"method": "GET", "url": "https://api.example.com/v1/users/me"},
+   "response": {"status": 200, "content": {"mimeType": "application/json", "text": "{}"}}},
+  {"request": {"method": "DELETE", "url": "https://api.example.com/v1/users/7"},
+   "response": {"status": 204, "content": {"mimeType": "", "text": ""}}},
+  {"request": {"method": "DELETE", "url": "https://api.example.com/v1/users/8"},
+   "response": {"status": 204, "content": {"mimeType": "", "text": ""}}}
+]}}`
+
+const jsonl = `
+{"method": "POST", "url": "/v1/orders", "status": 201, "request_body": {"sku": 12, "items": [{"qty": 1, "gift": true}]}, "response_body": {"id": "o-1"}}
+{"method": "POST", "path": "/v1/orders", "status": 201, "request_body": "{\"sku\": \"A\"}", "request_content_type": "application/json", "response_body": {"id": "o-2"}}
+`
+
+func findFinding(list []domain.TrafficFinding, direction, field string) *domain.TrafficFinding {
+	for i := range list {
+		if list[i].Direction == direction && list[i].Field == field {
+			return &list[i]
+		}
+	}
+	return nil
+}
+
+func TestAnalyze_HAR(t *testing.T) {
+	exchanges, format, err := Parse([]byte(har))
+	if err != nil || format != "har" {
+		t.Fatalf("expected HAR, got %s: %v", format, err)
+	}
+	report := Analyze(exchanges, RoutesFromContracts([]domain.ContractDefinition{usersContract}))
+
+	if report.TotalExchanges != 4 || report.MatchedExchanges != 2 {
+		t.Errorf("expected 2 of 4 exchanges matched, got %+v", report)
+	}
+	if len(report.UndocumentedEndpoints) != 1 || report.UndocumentedEndpoints[0].Path != "/v1/users/{id}" || report.UndocumentedEndpoints[0].Occurrences != 2 {
+		t.Errorf("expected DELETE /v1/users/{id} x2 to be undocumented, got %+v", report.UndocumentedEndpoints)
+	}
+	if f := findFinding(report.SchemaViolations, "response", "(root)"); f == nil || f.Path != "/v1/users/{id}" {
+		t.Errorf("expected missing required email on /users/{id}, got %+v", report.SchemaViolations)
+	}
+	if findFinding(report.SchemaViolations, "response", "nickname") != nil {
+		t.Errorf("expected nullable field to accept null, got %+v", report.SchemaViolations)
+	}
+	if findFinding(report.UndocumentedFields, "response", "role") == nil {
+		t.Errorf("expected undocumented 'role' field, got %+v", report.UndocumentedFields)
+	}
+}
+
+func TestAnalyze_JSONL(t *testing.T) {
+	exchanges, format, err := Parse([]byte(jsonl))
+	if err != nil || format != "jsonl" {
+		t.Fatalf("expected JSONL, got %s: %v", format, err)
+	}
+	report := Analyze(exchanges, RoutesFromContracts([]domain.ContractDefinition{ordersContract}))
+
+	if report.MatchedExchanges != 2 || len(report.UndocumentedEndpoints) != 0 {
+		t.Errorf("expected both exchanges to match, got %+v", report)
+	}
+	if f := findFinding(report.SchemaViolations, "request", "sku"); f == nil || f.Occurrences != 1 {
+		t.Errorf("expected a single sku type violation, got %+v", report.SchemaViolations)
+	}
+	if findFinding(report.UndocumentedFields, "request", "items[].gift") == nil {
+		t.Errorf("expected undocumented nested array field, got %+v", report.UndocumentedFields)
+	}
+	if len(report.UndocumentedFields) != 1 {
+		t.Errorf("expected routing hints not to be treated as fields, got %+v", report.UndocumentedFields)
+	}
+}
+
+func TestParse_Errors(t *testing.T) {
+	if _, _, err := Parse([]byte("  ")); err == nil {
+		t.Errorf("expected empty recording to be rejected")
+	}
+	if _, _, err := Parse([]byte(`{"method": "GET"}`)); err == nil {
+		t.Errorf("expected exchange without url to be rejected")
+	}
+}
@@ -0,0 +1,94 @@
+package ui_roadmap
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/SpecForgeVC/SpecForge/internal/app"
+	"github.com/google/uuid"
+)
+
+// fixedFields identify the item or are derived from it, so patches cannot
+// set them.
+var fixedFields = []string{"id", "project_id", "intelligence_score", "version", "created_at", "updated_at"}
+
+type documentRepository struct {
+	repo Repository
+}
+
+// NewDocumentRepository lets JSON_PATCH proposals target UI roadmap items.
+func NewDocumentRepository(repo Repository) app.DocumentRepository {
+	return &documentRepository{repo: repo}
+}
+
+func (d *documentRepository) GetDocument(ctx context.Context, id uuid.UUID) (map[string]interface{}, uuid.UUID, error) {
+	item, err := d.repo.Get(ctx, id)
+	if err != nil {
+		return nil, uuid.Nil, err
+	}
+	data, err := json.Marshal(item)
+	if err != nil {
+		return nil, uuid.Nil, err
+	}
+	var doc map[string]interface{}
+	if err := json.Unmarshal(data, &doc); err != nil {
+		return nil, uuid.Nil, err
+	}
+	for _, f := range fixedFields {
+		delete(doc, f)
+	}
+	return doc, item.ProjectID, nil
+}
+
+func (d *documentRepository) ValidateDocument(ctx context.Context, id uuid.UUID, doc map[string]interface{}) error {
+	_, err := d.decode(ctx, id, doc)
+	return err
+}
+
+func (d *documentRepository) SaveDocument(ctx context.Context, id uuid.UUID, doc map[string]interface{}) error {
+	item, err := d.decode(ctx, id, doc)
+	if err != nil {
+		return err
+	}
+	item.IntelligenceScore = CalculateScores(item).AggregateReadinessScore
+	return d.repo.Update(ctx, item)
+}
+
+// decode overlays doc on the stored item's fixed fields and runs the same
+// validation as SaveItem.
+func (d *documentRepository) decode(ctx context.Context, id uuid.UUID, doc map[string]interface{}) (*UIRoadmapItem, error) {
+	for _, f := range fixedFields {
+		if _, ok := doc[f]; ok {
+			return nil, fmt.Errorf("%s cannot be patched", f)
+		}
+	}
+	current, err := d.repo.Get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	data, err := json.Marshal(doc)
+	if err != nil {
+		return nil, err
+	}
+	item := UIRoadmapItem{}
+	dec := json.NewDecoder(bytes.NewReader(data))
+	dec.DisallowUnknownFields()
+	if err := dec.Decode(&item); err != nil {
+		return nil, fmt.Errorf("patched UI roadmap item: %v", err)
+	}
+	item.ID = current.ID
+	item.ProjectID = current.ProjectID
+	item.IntelligenceScore = current.IntelligenceScore
+	item.Version = current.Version
+	item.CreatedAt = current.CreatedAt
+	item.UpdatedAt = current.UpdatedAt
+
+	if res := ValidateUIRoadmapItem(&item); !res.Valid {
+		return nil, fmt.Errorf("patched UI roadmap item: %s", strings.Join(res.Errors, "; "))
+	}
+	return &item, nil
+}
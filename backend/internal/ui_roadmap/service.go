@@ -2,13 +2,13 @@ package ui_roadmap
 
 import (
 	"context"
-	"database/sql"
 	"encoding/json"
 	"fmt"
 	"time"
 
 	"github.com/SpecForgeVC/SpecForge/internal/app"
 	"github.com/SpecForgeVC/SpecForge/internal/domain"
+	"github.com/SpecForgeVC/SpecForge/internal/infra/db"
 	"github.com/google/uuid"
 )
 
@@ -22,11 +22,12 @@ type Repository interface {
 }
 
 type repo struct {
-	db *sql.DB
+	db db.DBTX
 }
 
-func NewRepository(db *sql.DB) Repository {
-	return &repo{db: db}
+// NewRepository accepts the connection pool or a transaction.
+func NewRepository(conn db.DBTX) Repository {
+	return &repo{db: conn}
 }
 
 func (r *repo) Get(ctx context.Context, id uuid.UUID) (*UIRoadmapItem, error) {
@@ -0,0 +1,14 @@
+CREATE TABLE IF NOT EXISTS spec_drift_checks (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    project_id UUID NOT NULL REFERENCES projects(id) ON DELETE CASCADE,
+    baseline_source TEXT NOT NULL,
+    proposed_source TEXT NOT NULL,
+    policy JSONB NOT NULL DEFAULT '{}',
+    report JSONB NOT NULL,
+    blocked BOOLEAN NOT NULL DEFAULT false,
+    created_by UUID,
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX IF NOT EXISTS idx_spec_drift_checks_project_id ON spec_drift_checks(project_id);
+CREATE INDEX IF NOT EXISTS idx_spec_drift_checks_created_at ON spec_drift_checks(created_at);
@@ -0,0 +1,3 @@
+DROP TRIGGER IF EXISTS contract_revisions_immutable ON contract_revisions;
+DROP FUNCTION IF EXISTS prevent_contract_revision_update();
+DROP TABLE IF EXISTS contract_revisions;
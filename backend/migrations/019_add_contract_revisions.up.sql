@@ -0,0 +1,34 @@
+CREATE TABLE IF NOT EXISTS contract_revisions (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    contract_id UUID NOT NULL REFERENCES contract_definitions(id) ON DELETE CASCADE,
+    revision INTEGER NOT NULL,
+    contract_type contract_type NOT NULL,
+    version TEXT NOT NULL,
+    input_schema JSONB DEFAULT '{}',
+    output_schema JSONB DEFAULT '{}',
+    error_schema JSONB DEFAULT '{}',
+    deprecated_fields JSONB DEFAULT '[]',
+    bump TEXT NOT NULL DEFAULT 'NONE',
+    created_by UUID,
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+    UNIQUE (contract_id, revision)
+);
+
+CREATE INDEX IF NOT EXISTS idx_contract_revisions_contract_id ON contract_revisions(contract_id);
+
+-- Revisions are immutable once written.
+CREATE OR REPLACE FUNCTION prevent_contract_revision_update() RETURNS TRIGGER AS $$
+BEGIN
+    RAISE EXCEPTION 'contract revisions are immutable';
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER contract_revisions_immutable
+    BEFORE UPDATE ON contract_revisions
+    FOR EACH ROW EXECUTE FUNCTION prevent_contract_revision_update();
+
+-- Existing contracts start their history at revision 1.
+INSERT INTO contract_revisions (contract_id, revision, contract_type, version, input_schema, output_schema, error_schema, deprecated_fields, created_at)
+SELECT id, 1, contract_type, version, input_schema, output_schema, error_schema, deprecated_fields, created_at
+FROM contract_definitions
+ON CONFLICT (contract_id, revision) DO NOTHING;
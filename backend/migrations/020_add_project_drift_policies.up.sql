@@ -0,0 +1,6 @@
+CREATE TABLE IF NOT EXISTS project_drift_policies (
+    project_id UUID PRIMARY KEY REFERENCES projects(id) ON DELETE CASCADE,
+    policy JSONB NOT NULL DEFAULT '{}',
+    updated_by UUID,
+    updated_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP
+);
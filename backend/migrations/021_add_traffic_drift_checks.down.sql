@@ -0,0 +1 @@
+DROP TABLE IF EXISTS traffic_drift_checks;
@@ -0,0 +1,11 @@
+CREATE TABLE IF NOT EXISTS traffic_drift_checks (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    project_id UUID NOT NULL REFERENCES projects(id) ON DELETE CASCADE,
+    source TEXT NOT NULL,
+    report JSONB NOT NULL,
+    created_by UUID,
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX IF NOT EXISTS idx_traffic_drift_checks_project_id ON traffic_drift_checks(project_id);
+CREATE INDEX IF NOT EXISTS idx_traffic_drift_checks_created_at ON traffic_drift_checks(created_at);
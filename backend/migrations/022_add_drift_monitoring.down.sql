@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS drift_timeline_entries;
+DROP TABLE IF EXISTS drift_monitor_schedules;
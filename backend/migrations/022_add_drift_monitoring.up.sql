@@ -0,0 +1,29 @@
+CREATE TABLE IF NOT EXISTS drift_monitor_schedules (
+    project_id UUID PRIMARY KEY REFERENCES projects(id) ON DELETE CASCADE,
+    cron_expression TEXT NOT NULL,
+    enabled BOOLEAN NOT NULL DEFAULT true,
+    next_run_at TIMESTAMP WITH TIME ZONE,
+    last_run_at TIMESTAMP WITH TIME ZONE,
+    locked_by TEXT,
+    locked_until TIMESTAMP WITH TIME ZONE,
+    updated_by UUID,
+    updated_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX IF NOT EXISTS idx_drift_monitor_schedules_due ON drift_monitor_schedules(next_run_at) WHERE enabled;
+
+CREATE TABLE IF NOT EXISTS drift_timeline_entries (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    project_id UUID NOT NULL REFERENCES projects(id) ON DELETE CASCADE,
+    roadmap_item_id UUID NOT NULL,
+    contract_id UUID NOT NULL REFERENCES contract_definitions(id) ON DELETE CASCADE,
+    snapshot_id UUID NOT NULL,
+    breaking_changes INT NOT NULL DEFAULT 0,
+    non_breaking_changes INT NOT NULL DEFAULT 0,
+    new_breaking_changes INT NOT NULL DEFAULT 0,
+    changes JSONB NOT NULL DEFAULT '[]',
+    checked_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX IF NOT EXISTS idx_drift_timeline_project_checked ON drift_timeline_entries(project_id, checked_at DESC);
+CREATE INDEX IF NOT EXISTS idx_drift_timeline_contract_checked ON drift_timeline_entries(contract_id, checked_at DESC);
@@ -0,0 +1,8 @@
+CREATE TABLE IF NOT EXISTS governance_policies (
+    project_id UUID NOT NULL REFERENCES projects(id) ON DELETE CASCADE,
+    version INTEGER NOT NULL,
+    policy JSONB NOT NULL DEFAULT '{"rules": []}',
+    created_by UUID,
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY (project_id, version)
+);
@@ -0,0 +1 @@
+DROP TABLE IF EXISTS roadmap_status_transitions;
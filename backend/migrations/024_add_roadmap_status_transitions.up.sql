@@ -0,0 +1,12 @@
+CREATE TABLE IF NOT EXISTS roadmap_status_transitions (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    roadmap_item_id UUID NOT NULL REFERENCES roadmap_items(id) ON DELETE CASCADE,
+    from_status VARCHAR(50) NOT NULL,
+    to_status VARCHAR(50) NOT NULL,
+    reason TEXT,
+    transitioned_by UUID,
+    role VARCHAR(50),
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX IF NOT EXISTS idx_roadmap_status_transitions_item ON roadmap_status_transitions(roadmap_item_id, created_at);
@@ -0,0 +1,6 @@
+DROP TABLE IF EXISTS proposal_reviews;
+
+ALTER TABLE ai_proposals DROP COLUMN IF EXISTS created_by;
+
+-- Postgres cannot drop enum values; CHANGES_REQUESTED stays in proposal_status.
+UPDATE ai_proposals SET status = 'PENDING' WHERE status = 'CHANGES_REQUESTED';
@@ -0,0 +1,16 @@
+ALTER TYPE proposal_status ADD VALUE IF NOT EXISTS 'CHANGES_REQUESTED';
+
+ALTER TABLE ai_proposals ADD COLUMN IF NOT EXISTS created_by UUID;
+
+CREATE TABLE IF NOT EXISTS proposal_reviews (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    proposal_id UUID NOT NULL REFERENCES ai_proposals(id) ON DELETE CASCADE,
+    reviewer_id UUID NOT NULL,
+    role VARCHAR(50) NOT NULL,
+    decision VARCHAR(50) NOT NULL,
+    comment TEXT,
+    superseded BOOLEAN NOT NULL DEFAULT FALSE,
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX IF NOT EXISTS idx_proposal_reviews_proposal ON proposal_reviews(proposal_id, created_at);
@@ -0,0 +1,2 @@
+-- Postgres cannot drop enum values; JSON_PATCH stays in proposal_type.
+DELETE FROM ai_proposals WHERE proposal_type = 'JSON_PATCH';
@@ -0,0 +1 @@
+ALTER TYPE proposal_type ADD VALUE IF NOT EXISTS 'JSON_PATCH';
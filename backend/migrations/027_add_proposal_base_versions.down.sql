@@ -0,0 +1,10 @@
+DROP INDEX IF EXISTS idx_ai_proposals_target;
+
+ALTER TABLE ai_proposals
+    DROP COLUMN IF EXISTS base_document,
+    DROP COLUMN IF EXISTS base_hash,
+    DROP COLUMN IF EXISTS target_id,
+    DROP COLUMN IF EXISTS target_type;
+
+-- Postgres cannot drop enum values; STALE stays in proposal_status.
+UPDATE ai_proposals SET status = 'PENDING' WHERE status = 'STALE';
@@ -0,0 +1,9 @@
+ALTER TYPE proposal_status ADD VALUE IF NOT EXISTS 'STALE';
+
+ALTER TABLE ai_proposals
+    ADD COLUMN IF NOT EXISTS target_type VARCHAR(50),
+    ADD COLUMN IF NOT EXISTS target_id UUID,
+    ADD COLUMN IF NOT EXISTS base_hash VARCHAR(64),
+    ADD COLUMN IF NOT EXISTS base_document JSONB;
+
+CREATE INDEX IF NOT EXISTS idx_ai_proposals_target ON ai_proposals(target_type, target_id);
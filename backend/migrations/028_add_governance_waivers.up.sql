@@ -0,0 +1,18 @@
+CREATE TABLE IF NOT EXISTS governance_waivers (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    project_id UUID NOT NULL REFERENCES projects(id) ON DELETE CASCADE,
+    roadmap_item_id UUID NOT NULL REFERENCES roadmap_items(id) ON DELETE CASCADE,
+    gate VARCHAR(50) NOT NULL,
+    rule VARCHAR(255) NOT NULL,
+    justification TEXT NOT NULL,
+    status VARCHAR(50) NOT NULL DEFAULT 'PENDING',
+    requested_by UUID NOT NULL,
+    decided_by UUID,
+    decision_comment TEXT,
+    decided_at TIMESTAMP WITH TIME ZONE,
+    expires_at TIMESTAMP WITH TIME ZONE NOT NULL,
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX IF NOT EXISTS idx_governance_waivers_project ON governance_waivers(project_id, created_at DESC);
+CREATE INDEX IF NOT EXISTS idx_governance_waivers_roadmap_item ON governance_waivers(roadmap_item_id);
@@ -0,0 +1 @@
+DROP TABLE IF EXISTS project_schema_lint_rulesets;
@@ -117,3 +117,70 @@ func handleImportProject(args []string) {
 func handleVerify(args []string) {
 	handleHandshake(nil)
 }
+
+// handleReport writes a spec drift check or alignment report in a CI format.
+// It exits with status 2 when the report has unwaived errors so pipelines can gate on it.
+func handleReport(args []string) {
+	fs := flag.NewFlagSet("report", flag.ExitOnError)
+	reportType := fs.String("type", "spec_drift_check", "Report type: spec_drift_check or alignment")
+	id := fs.String("id", "", "Drift check ID (defaults to the configured project for alignment)")
+	format := fs.String("format", "sarif", "Output format: sarif or junit")
+	artifact := fs.String("artifact", "", "File path findings are attributed to in SARIF output")
+	out := fs.String("out", "", "Output file (defaults to the server-suggested file name, - for stdout)")
+	noFail := fs.Bool("no-fail", false, "Exit 0 even when the report has errors")
+	fs.Parse(args)
+
+	if *id == "" && *reportType == "alignment" {
+		if cfg, err := loadConfig(); err == nil {
+			*id = cfg.ProjectID
+		}
+	}
+	if *id == "" {
+		fmt.Println("Error: --id is required")
+		fs.Usage()
+		os.Exit(1)
+	}
+
+	params := map[string]interface{}{
+		"name": "export_report",
+		"arguments": map[string]interface{}{
+			"report_type": *reportType,
+			"id":          *id,
+			"format":      *format,
+			"artifact":    *artifact,
+		},
+	}
+	result, err := callMCP("tools/call", params)
+	if err != nil {
+		fmt.Printf("Failed to export report: %v\n", err)
+		os.Exit(1)
+	}
+
+	var res struct {
+		FileName string `json:"file_name"`
+		Content  string `json:"content"`
+		Failed   bool   `json:"failed"`
+	}
+	if err := json.Unmarshal(result, &res); err != nil {
+		fmt.Printf("Failed to parse report: %v\n", err)
+		os.Exit(1)
+	}
+
+	path := *out
+	if path == "" {
+		path = res.FileName
+	}
+	if path == "-" {
+		fmt.Print(res.Content)
+	} else {
+		if err := os.WriteFile(path, []byte(res.Content), 0644); err != nil {
+			fmt.Printf("Failed to write report: %v\n", err)
+			os.Exit(1)
+		}
+		fmt.Printf("Report written to %s\n", path)
+	}
+
+	if res.Failed && !*noFail {
+		os.Exit(2)
+	}
+}
@@ -29,6 +29,8 @@ func main() {
 		handleImportProject(args)
 	case "verify":
 		handleVerify(args)
+	case "report":
+		handleReport(args)
 	case "help":
 		printHelp()
 	default:
@@ -49,5 +51,6 @@ func printHelp() {
 	fmt.Println("  post-snapshot   Post a changelog snapshot")
 	fmt.Println("  import-project  Trigger project import")
 	fmt.Println("  verify          Verify connection and auth")
+	fmt.Println("  report          Export a drift or alignment report as SARIF or JUnit XML")
 	fmt.Println("  help            Show this help message")
 }